@@ -0,0 +1,264 @@
+package kuma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
+)
+
+// AuditRecord is the record of a mutating socket command, which is passed
+// to the AuditSink.
+type AuditRecord struct {
+	// Time is the time, the command has been sent.
+	Time time.Time `json:"time"`
+
+	// User is the name of the logged in user.
+	User string `json:"user"`
+
+	// Command is the socket command, e.g. editMonitor.
+	Command string `json:"command"`
+
+	// Kind is the kind of the changed entity, empty if the command does not
+	// change a single entity, e.g. clearStatistics.
+	Kind ResourceKind `json:"kind,omitempty"`
+
+	// ID is the ID of the changed entity. For created entities, the ID is
+	// taken from the response of the server.
+	ID int64 `json:"id,omitempty"`
+
+	// Slug is the slug of the changed status page.
+	Slug string `json:"slug,omitempty"`
+
+	// Before is the entity from the cache before the command, nil if the
+	// entity has not been cached, e.g. because it is created by the command
+	// or because entities of the kind are not cached, like tags.
+	// Secret values are masked.
+	Before any `json:"before,omitempty"`
+
+	// After is the entity from the cache after the command, nil if the
+	// entity is not cached, e.g. because it has been deleted by the command.
+	// Secret values are masked.
+	After any `json:"after,omitempty"`
+
+	// Error is the error of the command, empty if the command succeeded.
+	Error string `json:"error,omitempty"`
+}
+
+// AuditSink receives the records of all mutating socket commands sent by the
+// client, regardless of whether the command succeeded or failed.
+type AuditSink interface {
+	Audit(ctx context.Context, record AuditRecord) error
+}
+
+// WithAuditSink sets the sink, which receives an AuditRecord for every
+// mutating socket command sent by the client. Errors of the sink are logged,
+// but do not fail the command.
+func WithAuditSink(sink AuditSink) Option {
+	return func(c *Client) {
+		c.auditSink = sink
+	}
+}
+
+// audit returns an invoker, which passes a record of every mutating command
+// sent with next to the audit sink.
+func (c *Client) audit(next Invoker) Invoker {
+	return func(ctx context.Context, command string, args []any) (AckResponse, error) {
+		if isReadCommand(command) {
+			return next(ctx, command, args)
+		}
+
+		kind, id, slug := CommandTarget(command, args)
+
+		record := AuditRecord{
+			Time:    time.Now(),
+			User:    c.username,
+			Command: command,
+			Kind:    kind,
+			ID:      id,
+			Slug:    slug,
+			Before:  c.auditSnapshot(kind, id, slug),
+		}
+
+		response, err := next(ctx, command, args)
+		if err != nil {
+			record.Error = err.Error()
+		} else {
+			if record.ID == 0 && kind != ResourceStatusPage {
+				record.ID = auditResponseID(response)
+			}
+
+			record.After = c.auditSnapshot(kind, record.ID, slug)
+		}
+
+		auditErr := c.auditSink.Audit(ctx, record)
+		if auditErr != nil {
+			c.socketioLogger.Errorf("audit %s: %s", command, auditErr)
+		}
+
+		return response, err
+	}
+}
+
+// auditResponseID returns the ID of the created entity from the response.
+func auditResponseID(response AckResponse) int64 {
+	for _, id := range []int64{response.MonitorID, response.MaintenanceID, response.ID, toInt64(response.Tag["id"])} {
+		if id != 0 {
+			return id
+		}
+	}
+
+	return 0
+}
+
+// auditSnapshot returns a copy of the cached entity, where all secret values
+// are masked, nil if the entity is not cached.
+func (c *Client) auditSnapshot(kind ResourceKind, id int64, slug string) any {
+	if id == 0 && slug == "" {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch kind {
+	case ResourceMonitor:
+		for _, mon := range c.monitorsLocked() {
+			if mon.ID == id {
+				return mon.Redacted()
+			}
+		}
+
+	case ResourceNotification:
+		for _, notif := range c.notificationsLocked() {
+			if notif.ID == id {
+				return notif.Redacted()
+			}
+		}
+
+	case ResourceProxy:
+		for _, p := range c.proxiesLocked() {
+			if p.ID == id {
+				return secret.Redact(p)
+			}
+		}
+
+	case ResourceDockerHost:
+		for _, host := range c.dockerHostsLocked() {
+			if host.ID == id {
+				return secret.Redact(host)
+			}
+		}
+
+	case ResourceRemoteBrowser:
+		for _, browser := range c.remoteBrowsersLocked() {
+			if browser.ID == id {
+				return browser.Redacted()
+			}
+		}
+
+	case ResourceMaintenance:
+		for _, m := range c.maintenancesLocked() {
+			if m.ID == id {
+				return secret.Redact(m)
+			}
+		}
+
+	case ResourceStatusPage:
+		for _, sp := range c.statusPagesLocked() {
+			if sp.Slug == slug {
+				return secret.Redact(sp)
+			}
+		}
+
+	default:
+	}
+
+	return nil
+}
+
+// JSONLAuditSink writes the audit records as JSON lines, e.g. to a file.
+type JSONLAuditSink struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewJSONLAuditSink returns an audit sink, which writes every record as a
+// single line of JSON to w. The sink is safe for concurrent use.
+func NewJSONLAuditSink(w io.Writer) *JSONLAuditSink {
+	return &JSONLAuditSink{
+		encoder: json.NewEncoder(w),
+	}
+}
+
+// Audit writes the record as a line of JSON.
+func (s *JSONLAuditSink) Audit(_ context.Context, record AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.encoder.Encode(record)
+	if err != nil {
+		return fmt.Errorf("write audit record: %w", err)
+	}
+
+	return nil
+}
+
+// SlogAuditSink logs the audit records with a slog.Logger.
+type SlogAuditSink struct {
+	logger *slog.Logger
+}
+
+// NewSlogAuditSink returns an audit sink, which logs every record with the
+// logger. Records of successful commands are logged with level info, records
+// of failed commands with level warn.
+func NewSlogAuditSink(logger *slog.Logger) *SlogAuditSink {
+	return &SlogAuditSink{
+		logger: logger,
+	}
+}
+
+// Audit logs the record.
+func (s *SlogAuditSink) Audit(ctx context.Context, record AuditRecord) error {
+	attrs := []slog.Attr{
+		slog.Time("audit_time", record.Time),
+		slog.String("user", record.User),
+		slog.String("command", record.Command),
+	}
+
+	if record.Kind != "" {
+		attrs = append(attrs, slog.String("kind", string(record.Kind)))
+	}
+
+	if record.ID != 0 {
+		attrs = append(attrs, slog.Int64("id", record.ID))
+	}
+
+	if record.Slug != "" {
+		attrs = append(attrs, slog.String("slug", record.Slug))
+	}
+
+	if record.Before != nil {
+		attrs = append(attrs, slog.Any("before", record.Before))
+	}
+
+	if record.After != nil {
+		attrs = append(attrs, slog.Any("after", record.After))
+	}
+
+	level := slog.LevelInfo
+	if record.Error != "" {
+		level = slog.LevelWarn
+
+		attrs = append(attrs, slog.String("error", record.Error))
+	}
+
+	s.logger.LogAttrs(ctx, level, "kuma audit", attrs...)
+
+	return nil
+}
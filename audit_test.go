@@ -0,0 +1,182 @@
+package kuma_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+type memoryAuditSink struct {
+	mu      sync.Mutex
+	records []kuma.AuditRecord
+}
+
+func (s *memoryAuditSink) Audit(_ context.Context, record kuma.AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records = append(s.records, record)
+
+	return nil
+}
+
+func (s *memoryAuditSink) Records() []kuma.AuditRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]kuma.AuditRecord(nil), s.records...)
+}
+
+func TestAuditSink(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 60*time.Second)
+	defer cancel()
+
+	sink := &memoryAuditSink{}
+
+	audited, err := kuma.New(ctx, serverURL, "admin", "admin1",
+		kuma.WithAuditSink(sink),
+		kuma.WithConnectTimeout(10*time.Second),
+	)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = audited.Disconnect()
+	}()
+
+	monitorID, err := audited.CreateMonitor(ctx, &monitor.HTTP{
+		Base: monitor.Base{
+			Name:          "Audit Monitor",
+			Interval:      60,
+			RetryInterval: 60,
+		},
+		HTTPDetails: monitor.HTTPDetails{
+			URL:    "https://example.com",
+			Method: "GET",
+		},
+	})
+	require.NoError(t, err)
+
+	var mon monitor.HTTP
+	err = audited.GetMonitorAs(ctx, monitorID, &mon)
+	require.NoError(t, err)
+
+	mon.Name = "Audit Monitor Renamed"
+	err = audited.UpdateMonitor(ctx, &mon)
+	require.NoError(t, err)
+
+	err = audited.DeleteMonitor(ctx, monitorID)
+	require.NoError(t, err)
+
+	err = audited.DeleteMonitor(ctx, monitorID)
+	require.Error(t, err)
+
+	records := sink.Records()
+	require.Len(t, records, 4)
+
+	for _, record := range records {
+		require.Equal(t, "admin", record.User)
+		require.Equal(t, kuma.ResourceMonitor, record.Kind)
+		require.Equal(t, monitorID, record.ID)
+		require.False(t, record.Time.IsZero())
+	}
+
+	create := records[0]
+	require.Equal(t, "add", create.Command)
+	require.Nil(t, create.Before)
+	require.NotNil(t, create.After)
+	require.Empty(t, create.Error)
+
+	update := records[1]
+	require.Equal(t, "editMonitor", update.Command)
+	require.Equal(t, "Audit Monitor", update.Before.(monitor.Base).Name)
+	require.Equal(t, "Audit Monitor Renamed", update.After.(monitor.Base).Name)
+
+	deleted := records[2]
+	require.Equal(t, "deleteMonitor", deleted.Command)
+	require.NotNil(t, deleted.Before)
+	require.Nil(t, deleted.After)
+
+	failed := records[3]
+	require.Equal(t, "deleteMonitor", failed.Command)
+	require.NotEmpty(t, failed.Error)
+}
+
+func TestJSONLAuditSink(t *testing.T) {
+	var buf bytes.Buffer
+
+	sink := kuma.NewJSONLAuditSink(&buf)
+
+	err := sink.Audit(t.Context(), kuma.AuditRecord{
+		Time:    time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		User:    "admin",
+		Command: "deleteTag",
+		Kind:    kuma.ResourceTag,
+		ID:      1,
+	})
+	require.NoError(t, err)
+
+	err = sink.Audit(t.Context(), kuma.AuditRecord{
+		Time:    time.Date(2026, 1, 2, 3, 4, 6, 0, time.UTC),
+		User:    "admin",
+		Command: "deleteStatusPage",
+		Kind:    kuma.ResourceStatusPage,
+		Slug:    "missing",
+		Error:   "deleteStatusPage: not found",
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	require.JSONEq(t,
+		`{"time":"2026-01-02T03:04:05Z","user":"admin","command":"deleteTag","kind":"tag","id":1}`,
+		lines[0],
+	)
+	require.JSONEq(t,
+		`{"time":"2026-01-02T03:04:06Z","user":"admin","command":"deleteStatusPage","kind":"status page",`+
+			`"slug":"missing","error":"deleteStatusPage: not found"}`,
+		lines[1],
+	)
+}
+
+func TestSlogAuditSink(t *testing.T) {
+	var buf bytes.Buffer
+
+	sink := kuma.NewSlogAuditSink(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	err := sink.Audit(t.Context(), kuma.AuditRecord{
+		Time:    time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		User:    "admin",
+		Command: "deleteMonitor",
+		Kind:    kuma.ResourceMonitor,
+		ID:      7,
+		Error:   "deleteMonitor: not found",
+	})
+	require.NoError(t, err)
+
+	entry := map[string]any{}
+	err = json.Unmarshal(buf.Bytes(), &entry)
+	require.NoError(t, err)
+
+	require.Equal(t, "WARN", entry["level"])
+	require.Equal(t, "kuma audit", entry["msg"])
+	require.Equal(t, "2026-01-02T03:04:05Z", entry["audit_time"])
+	require.Equal(t, "admin", entry["user"])
+	require.Equal(t, "deleteMonitor", entry["command"])
+	require.Equal(t, "monitor", entry["kind"])
+	require.InDelta(t, 7, entry["id"], 0)
+	require.Equal(t, "deleteMonitor: not found", entry["error"])
+}
@@ -0,0 +1,209 @@
+package kuma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// defaultBulkConcurrency is the default number of operations, which are
+// performed in parallel by bulk operations.
+const defaultBulkConcurrency = 10
+
+// Bulk performs operations on multiple monitors in parallel. Bulk operations
+// do not stop at the first error, instead the result of every item is
+// reported in a BulkReport.
+type Bulk struct {
+	client      *Client
+	concurrency int
+}
+
+// BulkOption is a functional option for configuring a Bulk.
+type BulkOption func(b *Bulk)
+
+// WithConcurrency sets the maximum number of operations, which are performed
+// in parallel. The default is 10.
+func WithConcurrency(n int) BulkOption {
+	return func(b *Bulk) {
+		if n > 0 {
+			b.concurrency = n
+		}
+	}
+}
+
+// Bulk returns helpers to perform operations on multiple monitors with
+// bounded parallelism.
+func (c *Client) Bulk(opts ...BulkOption) *Bulk {
+	b := &Bulk{
+		client:      c,
+		concurrency: defaultBulkConcurrency,
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// BulkResult is the result of a bulk operation for a single item.
+type BulkResult struct {
+	// ID is the ID of the item, e.g. the monitor ID.
+	ID int64
+
+	// Err is the error of the operation, nil if the operation succeeded.
+	// Items, which have not been processed because the context has been
+	// canceled, report the error of the context.
+	Err error
+}
+
+// BulkReport is the report of a bulk operation. It contains the results in
+// the order of the given IDs.
+type BulkReport struct {
+	Results []BulkResult
+}
+
+// Succeeded returns the IDs of the items, for which the operation succeeded.
+func (r BulkReport) Succeeded() []int64 {
+	ids := make([]int64, 0, len(r.Results))
+	for _, result := range r.Results {
+		if result.Err == nil {
+			ids = append(ids, result.ID)
+		}
+	}
+
+	return ids
+}
+
+// Failed returns the results of the items, for which the operation failed.
+func (r BulkReport) Failed() []BulkResult {
+	var failed []BulkResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// Err returns an error, which joins the errors of all failed items, or nil
+// if the operation succeeded for all items.
+func (r BulkReport) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	errs := make([]error, 0, len(failed))
+	for _, result := range failed {
+		errs = append(errs, result.Err)
+	}
+
+	return fmt.Errorf("%d of %d items failed: %w", len(failed), len(r.Results), errors.Join(errs...))
+}
+
+// PauseMonitors pauses the monitors with the given IDs.
+// The returned error is the error of the report, see BulkReport.Err.
+func (b *Bulk) PauseMonitors(ctx context.Context, monitorIDs []int64) (BulkReport, error) {
+	report := b.run(ctx, monitorIDs, b.client.PauseMonitor)
+
+	return report, wrapBulkErr("pause monitors", report)
+}
+
+// ResumeMonitors resumes the monitors with the given IDs.
+// The returned error is the error of the report, see BulkReport.Err.
+func (b *Bulk) ResumeMonitors(ctx context.Context, monitorIDs []int64) (BulkReport, error) {
+	report := b.run(ctx, monitorIDs, b.client.ResumeMonitor)
+
+	return report, wrapBulkErr("resume monitors", report)
+}
+
+// DeleteMonitors deletes the monitors with the given IDs.
+// The returned error is the error of the report, see BulkReport.Err.
+func (b *Bulk) DeleteMonitors(ctx context.Context, monitorIDs []int64) (BulkReport, error) {
+	report := b.run(ctx, monitorIDs, b.client.DeleteMonitor)
+
+	return report, wrapBulkErr("delete monitors", report)
+}
+
+// AddTagToMonitors adds the tag with the given value to the monitors with the
+// given IDs.
+// The returned error is the error of the report, see BulkReport.Err.
+func (b *Bulk) AddTagToMonitors(
+	ctx context.Context,
+	tagID int64,
+	value string,
+	monitorIDs []int64,
+) (BulkReport, error) {
+	report := b.run(ctx, monitorIDs, func(ctx context.Context, monitorID int64) error {
+		_, err := b.client.AddMonitorTag(ctx, tagID, monitorID, value)
+		return err
+	})
+
+	return report, wrapBulkErr("add tag to monitors", report)
+}
+
+// SetNotificationsForMonitors sets the notifications of the monitors with the
+// given IDs. This replaces all existing notifications of the monitors.
+// The returned error is the error of the report, see BulkReport.Err.
+func (b *Bulk) SetNotificationsForMonitors(
+	ctx context.Context,
+	notificationIDs []int64,
+	monitorIDs []int64,
+) (BulkReport, error) {
+	report := b.run(ctx, monitorIDs, func(ctx context.Context, monitorID int64) error {
+		mon, err := b.client.GetMonitor(ctx, monitorID)
+		if err != nil {
+			return err
+		}
+
+		mon.NotificationIDs = append([]int64{}, notificationIDs...)
+
+		return b.client.UpdateMonitor(ctx, &mon)
+	})
+
+	return report, wrapBulkErr("set notifications for monitors", report)
+}
+
+// run calls fn for every ID with bounded parallelism and collects the
+// results. After the context is canceled, the remaining IDs are not
+// processed anymore.
+func (b *Bulk) run(ctx context.Context, ids []int64, fn func(ctx context.Context, id int64) error) BulkReport {
+	results := make([]BulkResult, len(ids))
+
+	grp := errgroup.Group{}
+	grp.SetLimit(b.concurrency)
+
+	for i, id := range ids {
+		results[i].ID = id
+
+		grp.Go(func() error {
+			err := ctx.Err()
+			if err == nil {
+				err = fn(ctx, id)
+			}
+
+			results[i].Err = err
+
+			// Errors are reported per item, the other items are processed
+			// regardless.
+			return nil
+		})
+	}
+
+	_ = grp.Wait()
+
+	return BulkReport{Results: results}
+}
+
+func wrapBulkErr(op string, report BulkReport) error {
+	err := report.Err()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package kuma_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/notification"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+func TestClient_Bulk(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 60*time.Second)
+	defer cancel()
+
+	var monitorIDs []int64
+	for i := range 5 {
+		httpMonitor := monitor.HTTP{
+			Base: monitor.Base{
+				Name:          fmt.Sprintf("Bulk Test Monitor %d", i),
+				Interval:      60,
+				RetryInterval: 60,
+				MaxRetries:    1,
+				IsActive:      true,
+			},
+			HTTPDetails: monitor.HTTPDetails{
+				URL:                 "https://example.com",
+				Timeout:             48,
+				Method:              "GET",
+				MaxRedirects:        10,
+				AcceptedStatusCodes: []string{"200-299"},
+				AuthMethod:          monitor.AuthMethodNone,
+			},
+		}
+
+		id, err := client.CreateMonitor(ctx, &httpMonitor)
+		require.NoError(t, err)
+
+		monitorIDs = append(monitorIDs, id)
+	}
+
+	bulk := client.Bulk(kuma.WithConcurrency(2))
+
+	t.Run("pause_monitors", func(t *testing.T) {
+		report, err := bulk.PauseMonitors(ctx, monitorIDs)
+		require.NoError(t, err)
+		require.Equal(t, monitorIDs, report.Succeeded())
+
+		for _, id := range monitorIDs {
+			mon, err := client.GetMonitor(ctx, id)
+			require.NoError(t, err)
+			require.False(t, mon.IsActive)
+		}
+	})
+
+	t.Run("resume_monitors_partial_failure", func(t *testing.T) {
+		ids := append([]int64{999999}, monitorIDs...)
+
+		report, err := bulk.ResumeMonitors(ctx, ids)
+		require.Error(t, err)
+		require.Equal(t, monitorIDs, report.Succeeded())
+		require.Len(t, report.Failed(), 1)
+		require.Equal(t, int64(999999), report.Failed()[0].ID)
+
+		for _, id := range monitorIDs {
+			mon, err := client.GetMonitor(ctx, id)
+			require.NoError(t, err)
+			require.True(t, mon.IsActive)
+		}
+	})
+
+	t.Run("add_tag_to_monitors", func(t *testing.T) {
+		tagID, err := client.CreateTag(ctx, tag.Tag{Name: "bulk", Color: "#ff0000"})
+		require.NoError(t, err)
+
+		defer func() {
+			_ = client.DeleteTag(ctx, tagID)
+		}()
+
+		report, err := bulk.AddTagToMonitors(ctx, tagID, "drill", monitorIDs)
+		require.NoError(t, err)
+		require.Len(t, report.Succeeded(), len(monitorIDs))
+
+		monitors, err := client.FindMonitors(ctx, "tag:bulk=drill")
+		require.NoError(t, err)
+		require.Len(t, monitors, len(monitorIDs))
+	})
+
+	t.Run("set_notifications_for_monitors", func(t *testing.T) {
+		notificationID, err := client.CreateNotification(ctx, notification.Ntfy{
+			Base: notification.Base{Name: "Bulk Ntfy", IsActive: true},
+			NtfyDetails: notification.NtfyDetails{
+				AuthenticationMethod: "none",
+				Priority:             5,
+				ServerURL:            "https://ntfy.sh",
+				Topic:                "bulk-topic",
+			},
+		})
+		require.NoError(t, err)
+
+		defer func() {
+			_ = client.DeleteNotification(ctx, notificationID)
+		}()
+
+		report, err := bulk.SetNotificationsForMonitors(ctx, []int64{notificationID}, monitorIDs)
+		require.NoError(t, err)
+		require.Len(t, report.Succeeded(), len(monitorIDs))
+
+		for _, id := range monitorIDs {
+			mon, err := client.GetMonitor(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, []int64{notificationID}, mon.NotificationIDs)
+		}
+	})
+
+	t.Run("delete_monitors", func(t *testing.T) {
+		report, err := bulk.DeleteMonitors(ctx, monitorIDs)
+		require.NoError(t, err)
+		require.Len(t, report.Succeeded(), len(monitorIDs))
+
+		monitors, err := client.FindMonitors(ctx, `name~"Bulk Test Monitor *"`)
+		require.NoError(t, err)
+		require.Empty(t, monitors)
+	})
+}
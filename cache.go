@@ -0,0 +1,38 @@
+package kuma
+
+// CacheStats contains the number of entries in the local state cache of the
+// client, which is synchronized with the server.
+type CacheStats struct {
+	Monitors       int
+	Notifications  int
+	StatusPages    int
+	Maintenances   int
+	Proxies        int
+	DockerHosts    int
+	RemoteBrowsers int
+
+	// Heartbeats is the number of cached heartbeats of all monitors.
+	Heartbeats int
+}
+
+// CacheStats returns the number of entries in the local state cache.
+func (c *Client) CacheStats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	heartbeats := 0
+	for _, beats := range c.state.heartbeat.heartbeats {
+		heartbeats += len(beats)
+	}
+
+	return CacheStats{
+		Monitors:       len(c.state.monitors),
+		Notifications:  len(c.state.notifications),
+		StatusPages:    len(c.state.statusPages),
+		Maintenances:   len(c.state.maintenances),
+		Proxies:        len(c.state.proxies),
+		DockerHosts:    len(c.state.dockerHosts),
+		RemoteBrowsers: len(c.state.remoteBrowsers),
+		Heartbeats:     heartbeats,
+	}
+}
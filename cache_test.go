@@ -0,0 +1,28 @@
+package kuma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheStats(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	defer cancel()
+
+	monitors, err := client.GetMonitors(ctx)
+	require.NoError(t, err)
+
+	stats := client.CacheStats()
+	require.Equal(t, len(monitors), stats.Monitors)
+	require.Len(t, client.GetNotifications(ctx), stats.Notifications)
+	require.Len(t, client.GetProxyList(ctx), stats.Proxies)
+	require.Len(t, client.GetDockerHostList(ctx), stats.DockerHosts)
+	require.Len(t, client.GetRemoteBrowserList(ctx), stats.RemoteBrowsers)
+}
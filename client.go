@@ -3,6 +3,7 @@ package kuma
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -21,16 +22,15 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/breml/go-uptime-kuma-client/dockerhost"
+	"github.com/breml/go-uptime-kuma-client/heartbeat"
 	"github.com/breml/go-uptime-kuma-client/maintenance"
 	"github.com/breml/go-uptime-kuma-client/monitor"
 	"github.com/breml/go-uptime-kuma-client/notification"
 	"github.com/breml/go-uptime-kuma-client/proxy"
+	"github.com/breml/go-uptime-kuma-client/remotebrowser"
 	"github.com/breml/go-uptime-kuma-client/statuspage"
 )
 
-// ErrNotFound is returned when a requested resource is not found.
-var ErrNotFound = errors.New("not found")
-
 // Log level constants for configuring socket.io client logging verbosity.
 const (
 	LogLevelDebug = utils.DEBUG
@@ -80,12 +80,14 @@ type setupDatabaseResponse struct {
 }
 
 type state struct {
-	notifications []notification.Base
-	monitors      []monitor.Base
-	statusPages   map[int64]statuspage.StatusPage
-	maintenances  []maintenance.Maintenance
-	proxies       []proxy.Proxy
-	dockerHosts   []dockerhost.DockerHost
+	notifications  []notification.Base
+	monitors       []monitor.Base
+	statusPages    map[int64]statuspage.StatusPage
+	maintenances   []maintenance.Maintenance
+	proxies        []proxy.Proxy
+	dockerHosts    []dockerhost.DockerHost
+	remoteBrowsers []remotebrowser.RemoteBrowser
+	heartbeat      heartbeatState
 }
 
 // Client represents a connection to an Uptime Kuma server.
@@ -94,10 +96,27 @@ type Client struct {
 	socketioClientConnectTimeout time.Duration
 	socketioLogger               socketio.Logger
 	autosetup                    bool
-
-	mu      *sync.Mutex
-	updates signals.Signal[string]
-	state   state
+	secretResolver               SecretResolver
+	interceptors                 []Interceptor
+	plan                         *Plan
+	auditSink                    AuditSink
+	httpClient                   *http.Client
+	tlsConfig                    *tls.Config
+	headers                      http.Header
+	dialer                       Dialer
+
+	// username is the name of the logged in user, which is recorded in the
+	// audit records, see WithAuditSink.
+	username string
+
+	// password is the password of the logged in user, which is required by
+	// the server to confirm security relevant changes, see PatchSettings.
+	password string
+
+	mu              *sync.Mutex
+	updates         signals.Signal[string]
+	heartbeatEvents signals.Signal[heartbeat.Event]
+	state           state
 }
 
 // Option is a functional option for configuring a Client.
@@ -135,7 +154,7 @@ func WithConnectTimeout(timeout time.Duration) Option {
 // The function will wait for the server to restart after database configuration.
 //
 //nolint:revive // Complexity is necessary for complete database setup logic
-func setupDatabase(ctx context.Context, baseURL string) error {
+func setupDatabase(ctx context.Context, httpClient *http.Client, baseURL string) error {
 	// Convert socket.io URL to HTTP URL
 	httpURL := strings.Replace(baseURL, "ws://", "http://", 1)
 	httpURL = strings.Replace(httpURL, "wss://", "https://", 1)
@@ -163,7 +182,7 @@ func setupDatabase(ctx context.Context, baseURL string) error {
 		return fmt.Errorf("create entry-page request: %w", err)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		// Return connection errors as-is so caller can retry
 		return fmt.Errorf("entry-page request failed: %w", err)
@@ -212,7 +231,7 @@ func setupDatabase(ctx context.Context, baseURL string) error {
 
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err = http.DefaultClient.Do(req)
+	resp, err = httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("setup database: %w", err)
 	}
@@ -261,7 +280,7 @@ func setupDatabase(ctx context.Context, baseURL string) error {
 				continue
 			}
 
-			pollResp, err := http.DefaultClient.Do(pollReq)
+			pollResp, err := httpClient.Do(pollReq)
 			if err != nil {
 				pollCancel()
 				continue
@@ -295,8 +314,15 @@ func New(ctx context.Context, baseURL string, username string, password string,
 	c := &Client{
 		socketioLogger: &utils.DefaultLogger{Level: utils.NONE},
 
-		mu:      &sync.Mutex{},
-		updates: signals.New[string](),
+		mu:              &sync.Mutex{},
+		updates:         signals.New[string](),
+		heartbeatEvents: signals.New[heartbeat.Event](),
+		state: state{
+			heartbeat: newHeartbeatState(),
+		},
+
+		username: username,
+		password: password,
 	}
 
 	for _, opt := range opts {
@@ -318,18 +344,17 @@ func New(ctx context.Context, baseURL string, username string, password string,
 		connectTimeoutDone = ctxWithConnectTimeout.Done()
 	}
 
+	httpClient := c.newHTTPClient()
+
 	// Handle database setup for Uptime Kuma v2 if autosetup is enabled
 	if c.autosetup {
-		err := setupDatabase(ctxWithConnectTimeout, baseURL)
+		err := setupDatabase(ctxWithConnectTimeout, httpClient, baseURL)
 		if err != nil {
 			return nil, fmt.Errorf("database setup: %w", err)
 		}
 	}
 
-	client, err := socketio.NewClient(
-		socketio.WithRawURL(baseURL),
-		socketio.WithLogger(c.socketioLogger),
-	)
+	client, err := c.newSocketIOClient(baseURL, httpClient)
 	if err != nil {
 		return nil, fmt.Errorf("create socketio client: %w", err)
 	}
@@ -339,13 +364,14 @@ func New(ctx context.Context, baseURL string, username string, password string,
 	updateSeenMu := sync.Mutex{}
 	updateSeenMu.Lock()
 	updateSeen := map[string]struct{}{
-		"monitorList":      empty,
-		"maintenanceList":  empty,
-		"notificationList": empty,
-		"statusPageList":   empty,
-		"proxyList":        empty,
-		"dockerHostList":   empty,
-		"apiKeyList":       empty,
+		"monitorList":       empty,
+		"maintenanceList":   empty,
+		"notificationList":  empty,
+		"statusPageList":    empty,
+		"proxyList":         empty,
+		"dockerHostList":    empty,
+		"remoteBrowserList": empty,
+		"apiKeyList":        empty,
 	}
 	updateSeenMu.Unlock()
 
@@ -427,6 +453,8 @@ func New(ctx context.Context, baseURL string, username string, password string,
 			}
 		}
 
+		c.state.heartbeat.delete(monitorID)
+
 		c.updates.Emit(context.Background(), "deleteMonitorFromList")
 	})
 
@@ -471,6 +499,17 @@ func New(ctx context.Context, baseURL string, username string, password string,
 		c.updates.Emit(context.Background(), "dockerHostList")
 	})
 
+	client.On("remoteBrowserList", func(remoteBrowserList []remotebrowser.RemoteBrowser) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		c.state.remoteBrowsers = remoteBrowserList
+
+		c.updates.Emit(context.Background(), "remoteBrowserList")
+	})
+
+	c.handleHeartbeatEvents(client)
+
 	connect := make(chan struct{})
 	closeConnect := sync.OnceFunc(func() {
 		close(connect)
@@ -496,7 +535,8 @@ func New(ctx context.Context, baseURL string, username string, password string,
 	client.OnAny(func(s string, _ []any) {
 		if s != "notificationList" && s != "monitorList" && s != "statusPageList" && s != "maintenanceList" &&
 			s != "proxyList" &&
-			s != "dockerHostList" {
+			s != "dockerHostList" &&
+			s != "remoteBrowserList" {
 			c.updates.Emit(context.Background(), s)
 		}
 	})
@@ -555,8 +595,7 @@ func New(ctx context.Context, baseURL string, username string, password string,
 			default:
 			}
 
-			if (!strings.Contains(err.Error(), "Incorrect username or password") && !strings.Contains(err.Error(), "authIncorrectCreds")) ||
-				!wantSetup {
+			if !errors.Is(err, ErrInvalidCredentials) || !wantSetup {
 				return nil, fmt.Errorf("login: %w", err)
 			}
 		}
@@ -639,8 +678,13 @@ func (c *Client) Disconnect() error {
 	return nil
 }
 
-type ackResponse struct {
+// AckResponse is the acknowledgement, which is sent by Uptime Kuma in response
+// to a socket command. Depending on the command, only some of the fields
+// are set.
+type AckResponse struct {
 	Msg             string         `json:"msg"`
+	MsgI18n         bool           `json:"msgi18n"`
+	TokenRequired   bool           `json:"tokenRequired"`
 	OK              bool           `json:"ok"`
 	ID              int64          `json:"id"`
 	MonitorID       int64          `json:"monitorID"`
@@ -655,13 +699,33 @@ type ackResponse struct {
 	Config          map[string]any `json:"config"`
 	PublicGroupList []any          `json:"publicGroupList"`
 	Incident        map[string]any `json:"incident"`
+	Size            int64          `json:"size"`
 }
 
-func (c *Client) syncEmit(ctx context.Context, command string, args ...any) (ackResponse, error) {
-	res := make(chan ackResponse)
+// syncEmit sends the command through the interceptors and waits for the
+// acknowledgement of the server.
+func (c *Client) syncEmit(ctx context.Context, command string, args ...any) (AckResponse, error) {
+	return c.invoke(ctx, command, args, c.emit)
+}
+
+// syncEmitWithUpdateEvent sends the command through the interceptors and
+// waits for the acknowledgement of the server and for the update event.
+func (c *Client) syncEmitWithUpdateEvent(
+	ctx context.Context,
+	command string,
+	updateEvent string,
+	args ...any,
+) (AckResponse, error) {
+	return c.invoke(ctx, command, args, func(ctx context.Context, command string, args []any) (AckResponse, error) {
+		return c.emitWithUpdateEvent(ctx, command, updateEvent, args)
+	})
+}
+
+func (c *Client) emit(ctx context.Context, command string, args []any) (AckResponse, error) {
+	res := make(chan AckResponse)
 	defer close(res)
 
-	args = append(args, emit.WithAck(func(response ackResponse) {
+	args = append(args, emit.WithAck(func(response AckResponse) {
 		if ctx.Err() != nil {
 			return
 		}
@@ -671,28 +735,28 @@ func (c *Client) syncEmit(ctx context.Context, command string, args ...any) (ack
 
 	err := c.socketioClient.Emit(command, args...)
 	if err != nil {
-		return ackResponse{}, fmt.Errorf("%s: %w", command, err)
+		return AckResponse{}, fmt.Errorf("%s: %w", command, err)
 	}
 
 	select {
 	case response := <-res:
 		if !response.OK {
-			return ackResponse{}, fmt.Errorf("%s: %s", command, response.Msg)
+			return AckResponse{}, newServerError(command, response)
 		}
 
 		return response, nil
 
 	case <-ctx.Done():
-		return ackResponse{}, fmt.Errorf("%s: %w", command, ctx.Err())
+		return AckResponse{}, fmt.Errorf("%s: %w", command, ctx.Err())
 	}
 }
 
-func (c *Client) syncEmitWithUpdateEvent(
+func (c *Client) emitWithUpdateEvent(
 	ctx context.Context,
 	command string,
 	updateEvent string,
-	args ...any,
-) (ackResponse, error) {
+	args []any,
+) (AckResponse, error) {
 	done := make(chan struct{})
 	closeDone := sync.OnceFunc(func() {
 		close(done)
@@ -709,10 +773,10 @@ func (c *Client) syncEmitWithUpdateEvent(
 		}
 	}, listenerID.String())
 
-	res := make(chan ackResponse)
+	res := make(chan AckResponse)
 	defer close(res)
 
-	args = append(args, emit.WithAck(func(response ackResponse) {
+	args = append(args, emit.WithAck(func(response AckResponse) {
 		if ctx.Err() != nil {
 			return
 		}
@@ -721,10 +785,10 @@ func (c *Client) syncEmitWithUpdateEvent(
 	}))
 	err := c.socketioClient.Emit(command, args...)
 	if err != nil {
-		return ackResponse{}, fmt.Errorf("%s: %w", command, err)
+		return AckResponse{}, fmt.Errorf("%s: %w", command, err)
 	}
 
-	var response ackResponse
+	var response AckResponse
 	// Ensure, we have received both signals: done and ack
 	// Setting channel to nil blocks forever, thisway we ensure, that
 	// we also receive the second signal.
@@ -735,13 +799,13 @@ func (c *Client) syncEmitWithUpdateEvent(
 
 		case response = <-res:
 			if !response.OK {
-				return ackResponse{}, fmt.Errorf("%s: %s", command, response.Msg)
+				return AckResponse{}, newServerError(command, response)
 			}
 
 			res = nil
 
 		case <-ctx.Done():
-			return ackResponse{}, fmt.Errorf("%s: %w", command, ctx.Err())
+			return AckResponse{}, fmt.Errorf("%s: %w", command, ctx.Err())
 		}
 	}
 
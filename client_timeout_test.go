@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -27,12 +28,40 @@ import (
 // that triggers issue #271.
 type fakeSocketIOServer struct {
 	messages chan []byte
+
+	// loginAck is the JSON payload of the login acknowledgement. If empty,
+	// the login succeeds.
+	loginAck string
+
+	// readyEvents enables sending the ready events with empty lists after
+	// the login, such that kuma.New() succeeds.
+	readyEvents bool
+
+	// setupRequired simulates a fresh server, which announces the setup and
+	// rejects the login until the setup command has been received.
+	setupRequired bool
+	setupDone     atomic.Bool
+
+	// monitorList is the JSON payload of the monitorList ready event. If
+	// empty, no monitors are sent.
+	monitorList string
+
+	// events are sent after the ready events, e.g. heartbeatList.
+	events []string
+
+	// acks are the JSON payloads of the acknowledgements of other events
+	// than login by event name.
+	acks map[string]string
 }
 
 func (s *fakeSocketIOServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	sid := r.URL.Query().Get("sid")
 
 	switch {
+	case r.URL.Path == "/api/entry-page":
+		// The database is already set up, only the user is missing.
+		_, _ = w.Write([]byte(`{"type":"entryPage","entryPage":"dashboard"}`))
+
 	case r.Method == http.MethodGet && sid == "":
 		// Initial engine.io handshake: respond with PacketOpen ("0") + JSON.
 		type engineIOHandshake struct {
@@ -94,6 +123,10 @@ func (s *fakeSocketIOServer) handleClientMessage(body []byte) {
 		// Enqueue CONNECT ACK: engine.io "4" (Message) + socket.io "0" (Connect).
 		s.messages <- []byte("40")
 
+		if s.setupRequired {
+			s.messages <- []byte(`42["setup"]`)
+		}
+
 	case '2': // socket.io EVENT — the login request
 		// Extract the ack ID: digits immediately after the "2" type byte.
 		i := 1
@@ -102,10 +135,62 @@ func (s *fakeSocketIOServer) handleClientMessage(body []byte) {
 		}
 
 		ackID := string(socketIOData[1:i])
+
+		var event []json.RawMessage
+
+		var name string
+
+		if json.Unmarshal(socketIOData[i:], &event) == nil && len(event) > 0 {
+			_ = json.Unmarshal(event[0], &name)
+		}
+
+		if name == "setup" {
+			s.setupDone.Store(true)
+			s.messages <- fmt.Appendf(nil, `43%s[{"ok":true}]`, ackID)
+
+			return
+		}
+
+		if payload, ok := s.acks[name]; ok {
+			s.messages <- fmt.Appendf(nil, `43%s[%s]`, ackID, payload)
+
+			return
+		}
+
+		loginAck := s.loginAck
+		if loginAck == "" {
+			loginAck = `{"ok":true,"msg":"Logged in successfully."}`
+		}
+
+		if s.setupRequired && !s.setupDone.Load() {
+			loginAck = `{"ok":false,"msg":"authIncorrectCreds","msgi18n":true}`
+		}
+
 		// Enqueue login ACK: engine.io "4" + socket.io "3" (Ack) + ack ID + JSON payload.
-		ack := fmt.Sprintf(`43%s[{"ok":true,"msg":"Logged in successfully."}]`, ackID)
+		ack := fmt.Sprintf(`43%s[%s]`, ackID, loginAck)
 		s.messages <- []byte(ack)
 
+		if s.readyEvents && (!s.setupRequired || s.setupDone.Load()) {
+			monitorList := s.monitorList
+			if monitorList == "" {
+				monitorList = "{}"
+			}
+
+			for _, event := range append([]string{
+				`["monitorList",` + monitorList + `]`,
+				`["maintenanceList",{}]`,
+				`["notificationList",[]]`,
+				`["statusPageList",{}]`,
+				`["proxyList",[]]`,
+				`["dockerHostList",[]]`,
+				`["remoteBrowserList",[]]`,
+				`["apiKeyList",[]]`,
+			}, s.events...) {
+				// Enqueue event: engine.io "4" + socket.io "2" (Event) + JSON payload.
+				s.messages <- []byte("42" + event)
+			}
+		}
+
 	default:
 	}
 }
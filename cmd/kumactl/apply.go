@@ -0,0 +1,443 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/manifest"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+// errDifferences is returned by diff, if the manifest differs from the
+// state of the Uptime Kuma instance.
+var errDifferences = errors.New("differences found")
+
+// applyMode controls, how existing resources are handled by the applier.
+type applyMode int
+
+const (
+	// modeApply creates missing resources and updates existing ones.
+	modeApply applyMode = iota
+
+	// modeCreate creates missing resources and fails for existing ones.
+	modeCreate
+)
+
+// applier applies the resources of a manifest to an Uptime Kuma instance.
+type applier struct {
+	client *kuma.Client
+	state  *state
+	mode   applyMode
+	out    io.Writer
+
+	// ids contains the IDs of the existing resources and of the resources
+	// created by the applier.
+	ids manifest.IDs
+
+	// current contains the specs of the existing resources.
+	current map[resourceKey]map[string]any
+}
+
+type resourceKey struct {
+	kind manifest.Kind
+	name string
+}
+
+func (k resourceKey) String() string {
+	return string(k.kind) + "/" + k.name
+}
+
+func newApplier(client *kuma.Client, s *state, mode applyMode, out io.Writer) (*applier, error) {
+	current, err := specs(&s.manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &applier{
+		client:  client,
+		state:   s,
+		mode:    mode,
+		out:     out,
+		current: current,
+	}
+
+	// Copy the IDs, such that the state is not modified.
+	for _, kind := range manifest.Kinds() {
+		for id, name := range s.names[kind] {
+			a.ids.Set(kind, name, id)
+		}
+	}
+
+	return a, nil
+}
+
+// apply creates or updates all resources of the manifest. The resources are
+// applied in the order of manifest.Kinds, such that all references can be
+// resolved.
+//
+//nolint:gocognit,gocyclo,cyclop,funlen // One block per kind.
+func (a *applier) apply(ctx context.Context, m *manifest.Manifest) error {
+	err := m.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	desired, err := specs(m)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range m.Tags {
+		key := resourceKey{manifest.KindTag, res.Name}
+		t := res.Tag
+		t.Name = res.Name
+
+		err = a.upsert(ctx, key, desired[key], func(id int64) (int64, error) {
+			if id == 0 {
+				return a.client.CreateTag(ctx, t)
+			}
+
+			t.ID = id
+
+			return id, a.client.UpdateTag(ctx, t)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, res := range m.Proxies {
+		// Proxies are identified by their address, the name is only used for
+		// references within the manifest.
+		address := proxyName(res.Proxy.Protocol, res.Proxy.Host, res.Proxy.Port)
+		key := resourceKey{manifest.KindProxy, address}
+		config := res.Proxy
+
+		err = a.upsert(ctx, key, desired[resourceKey{manifest.KindProxy, res.Name}], func(id int64) (int64, error) {
+			if id == 0 {
+				return a.client.CreateProxy(ctx, config)
+			}
+
+			config.ID = id
+
+			return id, a.client.UpdateProxy(ctx, config)
+		})
+		if err != nil {
+			return err
+		}
+
+		id, _ := a.ids.Get(manifest.KindProxy, address)
+		a.ids.Set(manifest.KindProxy, res.Name, id)
+	}
+
+	for _, res := range m.DockerHosts {
+		key := resourceKey{manifest.KindDockerHost, res.Name}
+		config := res.DockerHost
+		config.Name = res.Name
+
+		err = a.upsert(ctx, key, desired[key], func(id int64) (int64, error) {
+			if id == 0 {
+				return a.client.CreateDockerHost(ctx, config)
+			}
+
+			config.ID = id
+
+			return id, a.client.UpdateDockerHost(ctx, config)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, res := range m.Notifications {
+		key := resourceKey{manifest.KindNotification, res.Name}
+
+		err = a.upsert(ctx, key, desired[key], func(id int64) (int64, error) {
+			n, err := res.Resolve(&a.ids)
+			if err != nil {
+				return 0, err
+			}
+
+			if id == 0 {
+				return a.client.CreateNotification(ctx, n)
+			}
+
+			return id, a.client.UpdateNotification(ctx, n)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	monitors, err := monitorOrder(m.Monitors)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range monitors {
+		key := resourceKey{manifest.KindMonitor, res.Name}
+
+		err = a.upsert(ctx, key, desired[key], func(id int64) (int64, error) {
+			mon, err := res.Resolve(&a.ids)
+			if err != nil {
+				return 0, err
+			}
+
+			if id == 0 {
+				id, err = a.client.CreateMonitor(ctx, mon)
+				if err != nil {
+					return 0, err
+				}
+			} else {
+				err = a.client.UpdateMonitor(ctx, mon)
+				if err != nil {
+					return 0, err
+				}
+			}
+
+			return id, a.syncMonitorTags(ctx, res, id)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, res := range m.StatusPages {
+		key := resourceKey{manifest.KindStatusPage, res.Name}
+
+		// The groups of existing status pages are not returned by the server,
+		// status pages with groups are always saved.
+		spec := desired[key]
+		if len(res.Groups) > 0 {
+			spec = nil
+		}
+
+		err = a.upsert(ctx, key, spec, func(id int64) (int64, error) {
+			page, err := res.Resolve(&a.ids)
+			if err != nil {
+				return 0, err
+			}
+
+			if id == 0 {
+				err = a.client.AddStatusPage(ctx, page.Title, page.Slug)
+				if err != nil {
+					return 0, err
+				}
+
+				created, err := a.client.GetStatusPage(ctx, page.Slug)
+				if err != nil {
+					return 0, err
+				}
+
+				id = created.ID
+			}
+
+			page.ID = id
+			_, err = a.client.SaveStatusPage(ctx, &page)
+
+			return id, err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, res := range m.Maintenances {
+		key := resourceKey{manifest.KindMaintenance, res.Name}
+
+		err = a.upsert(ctx, key, desired[key], func(id int64) (int64, error) {
+			monitorIDs, err := res.ResolveMonitors(&a.ids)
+			if err != nil {
+				return 0, err
+			}
+
+			statusPageIDs, err := res.ResolveStatusPages(&a.ids)
+			if err != nil {
+				return 0, err
+			}
+
+			mnt := res.Maintenance
+			mnt.Title = res.Name
+
+			if id == 0 {
+				created, err := a.client.CreateMaintenance(ctx, &mnt)
+				if err != nil {
+					return 0, err
+				}
+
+				id = created.ID
+			} else {
+				mnt.ID = id
+
+				err = a.client.UpdateMaintenance(ctx, &mnt)
+				if err != nil {
+					return 0, err
+				}
+			}
+
+			err = a.client.SetMonitorMaintenance(ctx, id, monitorIDs)
+			if err != nil {
+				return 0, err
+			}
+
+			return id, a.client.SetMaintenanceStatusPage(ctx, id, statusPageIDs)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// upsert creates the resource identified by key, if it does not exist, or
+// updates it, if its spec differs from the desired spec. A nil desired spec
+// always results in an update.
+func (a *applier) upsert(
+	ctx context.Context,
+	key resourceKey,
+	desired map[string]any,
+	save func(id int64) (int64, error),
+) error {
+	if ctx.Err() != nil {
+		return fmt.Errorf("%s: %w", key, ctx.Err())
+	}
+
+	id, err := a.ids.Get(key.kind, key.name)
+	exists := err == nil
+
+	action := "created"
+
+	switch {
+	case exists && a.mode == modeCreate:
+		return fmt.Errorf("%s: already exists", key)
+
+	case exists && desired != nil && reflect.DeepEqual(desired, a.current[key]):
+		_, err = fmt.Fprintf(a.out, "%s unchanged\n", key)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+
+		return nil
+
+	case exists:
+		action = "configured"
+
+	default:
+		id = 0
+	}
+
+	id, err = save(id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+
+	a.ids.Set(key.kind, key.name, id)
+
+	_, err = fmt.Fprintf(a.out, "%s %s\n", key, action)
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+
+	return nil
+}
+
+// monitorOrder returns the monitors ordered such that parent groups are
+// created before their children.
+func monitorOrder(monitors []manifest.Monitor) ([]manifest.Monitor, error) {
+	ordered := make([]manifest.Monitor, 0, len(monitors))
+	pending := slices.Clone(monitors)
+
+	for len(pending) > 0 {
+		var next []manifest.Monitor
+
+		for _, mon := range pending {
+			parentPending := slices.ContainsFunc(pending, func(other manifest.Monitor) bool {
+				return other.Name == mon.Parent
+			})
+
+			if parentPending {
+				next = append(next, mon)
+				continue
+			}
+
+			ordered = append(ordered, mon)
+		}
+
+		if len(next) == len(pending) {
+			return nil, fmt.Errorf("%s/%s: cyclic parent references", manifest.KindMonitor, next[0].Name)
+		}
+
+		pending = next
+	}
+
+	return ordered, nil
+}
+
+// syncMonitorTags adds the tags of the manifest to the monitor and removes
+// all other tags.
+func (a *applier) syncMonitorTags(ctx context.Context, res manifest.Monitor, monitorID int64) error {
+	desired, err := res.ResolveTags(&a.ids)
+	if err != nil {
+		return err
+	}
+
+	var current []tag.MonitorTag
+	if base, ok := a.state.monitors[res.Name]; ok && base.ID == monitorID {
+		current = base.Tags
+	}
+
+	same := func(t tag.MonitorTag) func(tag.MonitorTag) bool {
+		return func(other tag.MonitorTag) bool {
+			return other.TagID == t.TagID && other.Value == t.Value
+		}
+	}
+
+	for _, t := range current {
+		if !slices.ContainsFunc(desired, same(t)) {
+			err = a.client.DeleteMonitorTagWithValue(ctx, t.TagID, monitorID, t.Value)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, t := range desired {
+		if !slices.ContainsFunc(current, same(t)) {
+			_, err = a.client.AddMonitorTag(ctx, t.TagID, monitorID, t.Value)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// specs returns the specs of all resources of the manifest.
+func specs(m *manifest.Manifest) (map[resourceKey]map[string]any, error) {
+	docs, err := m.Documents()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[resourceKey]map[string]any, len(docs))
+	for _, doc := range docs {
+		spec := map[string]any{}
+
+		err = json.Unmarshal(doc.Spec, &spec)
+		if err != nil {
+			return nil, fmt.Errorf("%s/%s: %w", doc.Kind, doc.Metadata.Name, err)
+		}
+
+		result[resourceKey{doc.Kind, doc.Metadata.Name}] = spec
+	}
+
+	return result, nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/manifest"
+)
+
+func (a *app) newApplyCommand(mode applyMode) *cobra.Command {
+	var files []string
+
+	cmd := &cobra.Command{
+		Use:   "apply -f FILE...",
+		Short: "Create or update resources from manifests",
+		Long: "Create the resources of the manifests, which do not exist, and update the existing\n" +
+			"resources, which differ from the manifests. Resources are matched by name, proxies by\n" +
+			"their address. Resources, which are not contained in the manifests, are not modified.",
+		Example: "  kumactl apply -f kuma.yaml\n" +
+			"  kumactl export | kumactl --context staging apply -f -",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			m, err := loadManifests(files)
+			if err != nil {
+				return err
+			}
+
+			return a.withState(cmd.Context(), func(ctx context.Context, client *kuma.Client, s *state) error {
+				ap, err := newApplier(client, s, mode, a.stdout)
+				if err != nil {
+					return err
+				}
+
+				return ap.apply(ctx, m)
+			})
+		},
+	}
+
+	if mode == modeCreate {
+		cmd.Use = "create -f FILE..."
+		cmd.Short = "Create resources from manifests"
+		cmd.Long = "Create the resources of the manifests. Fails for resources, which already exist.\n" +
+			"Resources are matched by name, proxies by their address."
+		cmd.Example = "  kumactl create -f monitors.yaml"
+	}
+
+	addFileFlag(cmd, &files)
+
+	return cmd
+}
+
+func (a *app) newDiffCommand() *cobra.Command {
+	var files []string
+
+	cmd := &cobra.Command{
+		Use:   "diff -f FILE...",
+		Short: "Show the differences between manifests and Uptime Kuma",
+		Long: "Show the changes, which apply would make. Resources, which would be created, are\n" +
+			"prefixed with +, resources, which would be updated, with ~. The exit code is 1, if there\n" +
+			"are differences. The groups of status pages can not be compared and are not shown.",
+		Example: "  kumactl diff -f kuma.yaml",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			m, err := loadManifests(files)
+			if err != nil {
+				return err
+			}
+
+			return a.withState(cmd.Context(), func(_ context.Context, _ *kuma.Client, s *state) error {
+				return diff(a.stdout, s, m)
+			})
+		},
+	}
+
+	addFileFlag(cmd, &files)
+
+	return cmd
+}
+
+func addFileFlag(cmd *cobra.Command, files *[]string) {
+	cmd.Flags().StringArrayVarP(files, "filename", "f", nil, "manifest file, - reads from stdin")
+	_ = cmd.MarkFlagRequired("filename")
+}
+
+// loadManifests loads and merges the manifests from the given files. The
+// file name "-" reads the manifest from stdin.
+func loadManifests(files []string) (*manifest.Manifest, error) {
+	m := &manifest.Manifest{}
+
+	for _, file := range files {
+		var (
+			loaded *manifest.Manifest
+			err    error
+		)
+
+		if file == "-" {
+			loaded, err = manifest.Load(os.Stdin)
+		} else {
+			loaded, err = manifest.LoadFile(file)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		m.Merge(loaded)
+	}
+
+	err := m.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	return m, nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func (a *app) newConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the contexts of the config file",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "get-contexts",
+			Short: "List the contexts",
+			Args:  cobra.NoArgs,
+			RunE: func(*cobra.Command, []string) error {
+				cfg, err := loadConfig(a.configPath)
+				if err != nil {
+					return err
+				}
+
+				t := &table{header: []string{"CURRENT", "NAME", "URL", "USERNAME"}}
+				for _, c := range cfg.Contexts {
+					current := ""
+					if c.Name == cfg.CurrentContext {
+						current = "*"
+					}
+
+					t.add(current, c.Name, c.URL, c.Username)
+				}
+
+				return t.write(a.stdout)
+			},
+		},
+		&cobra.Command{
+			Use:   "current-context",
+			Short: "Show the current context",
+			Args:  cobra.NoArgs,
+			RunE: func(*cobra.Command, []string) error {
+				cfg, err := loadConfig(a.configPath)
+				if err != nil {
+					return err
+				}
+
+				if cfg.CurrentContext == "" {
+					return errors.New("current context is not set")
+				}
+
+				_, err = fmt.Fprintln(a.stdout, cfg.CurrentContext)
+				if err != nil {
+					return fmt.Errorf("current context: %w", err)
+				}
+
+				return nil
+			},
+		},
+		&cobra.Command{
+			Use:   "use-context NAME",
+			Short: "Set the current context",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(_ *cobra.Command, args []string) error {
+				cfg, err := loadConfig(a.configPath)
+				if err != nil {
+					return err
+				}
+
+				err = cfg.useContext(args[0])
+				if err != nil {
+					return err
+				}
+
+				err = cfg.save(a.configPath)
+				if err != nil {
+					return err
+				}
+
+				_, err = fmt.Fprintf(a.stdout, "switched to context %q\n", args[0])
+				if err != nil {
+					return fmt.Errorf("use context: %w", err)
+				}
+
+				return nil
+			},
+		},
+	)
+
+	return cmd
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/manifest"
+)
+
+func (a *app) newDeleteCommand() *cobra.Command {
+	var files []string
+
+	cmd := &cobra.Command{
+		Use:   "delete KIND NAME|ID... | delete -f FILE...",
+		Short: "Delete resources",
+		Long: "Delete the resources of a kind selected by name or ID, or the resources contained in\n" +
+			"manifests. Resources of manifests, which do not exist, are skipped.",
+		Example: "  kumactl delete monitor website\n" +
+			"  kumactl delete -f kuma.yaml",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(files) > 0 {
+				if len(args) > 0 {
+					return errors.New("resources can not be selected by arguments and -f at the same time")
+				}
+
+				m, err := loadManifests(files)
+				if err != nil {
+					return err
+				}
+
+				return a.withState(cmd.Context(), func(ctx context.Context, client *kuma.Client, s *state) error {
+					return a.deleteManifest(ctx, client, s, m)
+				})
+			}
+
+			if len(args) < 2 {
+				return errors.New("expected a kind and at least one name or ID")
+			}
+
+			kind, err := parseKind(args[0])
+			if err != nil {
+				return err
+			}
+
+			return a.withState(cmd.Context(), func(ctx context.Context, client *kuma.Client, s *state) error {
+				names, err := s.lookupAll(kind, args[1:])
+				if err != nil {
+					return err
+				}
+
+				for _, name := range names {
+					err = a.delete(ctx, client, s, resourceKey{kind, name})
+					if err != nil {
+						return err
+					}
+				}
+
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&files, "filename", "f", nil, "manifest file, - reads from stdin")
+
+	return cmd
+}
+
+// deleteManifest deletes the resources of the manifest in the reverse order
+// of manifest.Kinds, such that resources are deleted before the resources
+// they reference.
+func (a *app) deleteManifest(ctx context.Context, client *kuma.Client, s *state, m *manifest.Manifest) error {
+	docs, err := m.Documents()
+	if err != nil {
+		return err
+	}
+
+	slices.Reverse(docs)
+
+	for _, doc := range docs {
+		key := resourceKey{doc.Kind, doc.Metadata.Name}
+
+		if doc.Kind == manifest.KindProxy {
+			// Proxies are identified by their address.
+			i := slices.IndexFunc(m.Proxies, func(p manifest.Proxy) bool { return p.Name == doc.Metadata.Name })
+			key.name = proxyName(m.Proxies[i].Proxy.Protocol, m.Proxies[i].Proxy.Host, m.Proxies[i].Proxy.Port)
+		}
+
+		_, err = s.ids.Get(key.kind, key.name)
+		if err != nil {
+			_, err = fmt.Fprintf(a.stdout, "%s not found\n", key)
+			if err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+
+			continue
+		}
+
+		err = a.delete(ctx, client, s, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// delete deletes the existing resource identified by key.
+func (a *app) delete(ctx context.Context, client *kuma.Client, s *state, key resourceKey) error {
+	id, err := s.ids.Get(key.kind, key.name)
+	if err != nil {
+		return err
+	}
+
+	switch key.kind {
+	case manifest.KindMonitor:
+		err = client.DeleteMonitor(ctx, id)
+
+	case manifest.KindNotification:
+		err = client.DeleteNotification(ctx, id)
+
+	case manifest.KindStatusPage:
+		err = client.DeleteStatusPage(ctx, key.name)
+
+	case manifest.KindMaintenance:
+		err = client.DeleteMaintenance(ctx, id)
+
+	case manifest.KindTag:
+		err = client.DeleteTag(ctx, id)
+
+	case manifest.KindProxy:
+		err = client.DeleteProxy(ctx, id)
+
+	case manifest.KindDockerHost:
+		err = client.DeleteDockerHost(ctx, id)
+
+	default:
+		err = fmt.Errorf("unsupported kind %q", key.kind)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+
+	_, err = fmt.Fprintf(a.stdout, "%s deleted\n", key)
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (a *app) newPauseCommand(pause bool) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pause KIND NAME|ID...",
+		Short:   "Pause monitors or maintenances",
+		Example: "  kumactl pause monitor website",
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind, err := parseKind(args[0])
+			if err != nil {
+				return err
+			}
+
+			if kind != manifest.KindMonitor && kind != manifest.KindMaintenance {
+				return fmt.Errorf("%s can not be paused, only monitors and maintenances", kind)
+			}
+
+			return a.withState(cmd.Context(), func(ctx context.Context, client *kuma.Client, s *state) error {
+				for _, ref := range args[1:] {
+					name, id, err := s.lookup(kind, ref)
+					if err != nil {
+						return err
+					}
+
+					err = setPaused(ctx, client, kind, id, pause)
+					if err != nil {
+						return fmt.Errorf("%s/%s: %w", kind, name, err)
+					}
+
+					action := "paused"
+					if !pause {
+						action = "resumed"
+					}
+
+					_, err = fmt.Fprintf(a.stdout, "%s/%s %s\n", kind, name, action)
+					if err != nil {
+						return fmt.Errorf("%s/%s: %w", kind, name, err)
+					}
+				}
+
+				return nil
+			})
+		},
+	}
+
+	if !pause {
+		cmd.Use = "resume KIND NAME|ID..."
+		cmd.Short = "Resume monitors or maintenances"
+		cmd.Example = "  kumactl resume monitor website"
+	}
+
+	return cmd
+}
+
+func setPaused(ctx context.Context, client *kuma.Client, kind manifest.Kind, id int64, pause bool) error {
+	switch {
+	case kind == manifest.KindMonitor && pause:
+		return client.PauseMonitor(ctx, id)
+
+	case kind == manifest.KindMonitor:
+		return client.ResumeMonitor(ctx, id)
+
+	case pause:
+		return client.PauseMaintenance(ctx, id)
+
+	default:
+		return client.ResumeMaintenance(ctx, id)
+	}
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/manifest"
+)
+
+func (a *app) newGetCommand() *cobra.Command {
+	var (
+		selector    string
+		showSecrets bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "get KIND [NAME|ID...]",
+		Short: "List resources",
+		Long: "List the resources of a kind. The resources are selected by name or ID, by default\n" +
+			"all resources of the kind are listed. Secret values are masked, unless --show-secrets is set.",
+		Example: "  kumactl get monitors\n" +
+			"  kumactl get monitor website -o yaml\n" +
+			"  kumactl get monitors -l 'type=http and tag:env=prod'",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind, err := parseKind(args[0])
+			if err != nil {
+				return err
+			}
+
+			if selector != "" && kind != manifest.KindMonitor {
+				return errors.New("--selector is only supported for monitors")
+			}
+
+			return a.withState(cmd.Context(), func(ctx context.Context, client *kuma.Client, s *state) error {
+				names, err := s.lookupAll(kind, args[1:])
+				if err != nil {
+					return err
+				}
+
+				if selector != "" {
+					monitors, err := client.FindMonitors(ctx, selector)
+					if err != nil {
+						return err
+					}
+
+					for _, mon := range monitors {
+						names = append(names, mon.Name)
+					}
+				}
+
+				m := s.filter(kind, names)
+				if selector != "" && len(names) == 0 {
+					m = &manifest.Manifest{}
+				}
+
+				if !showSecrets {
+					m = redact(m)
+				}
+
+				return a.write(s.resourceTable(kind, m), m)
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "monitor selector expression, see kuma.Client.FindMonitors")
+	cmd.Flags().BoolVar(&showSecrets, "show-secrets", false, "show secret values like passwords and tokens")
+
+	return cmd
+}
+
+func (a *app) newDescribeCommand() *cobra.Command {
+	var showSecrets bool
+
+	cmd := &cobra.Command{
+		Use:   "describe KIND NAME|ID",
+		Short: "Show the details of a resource",
+		Long: "Show the details of a resource including its references to other resources.\n" +
+			"Secret values are masked, unless --show-secrets is set.",
+		Example: "  kumactl describe monitor website",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind, err := parseKind(args[0])
+			if err != nil {
+				return err
+			}
+
+			return a.withState(cmd.Context(), func(_ context.Context, _ *kuma.Client, s *state) error {
+				name, id, err := s.lookup(kind, args[1])
+				if err != nil {
+					return err
+				}
+
+				m := s.filter(kind, []string{name})
+				if !showSecrets {
+					m = redact(m)
+				}
+
+				if a.output != formatTable {
+					return a.write(nil, m)
+				}
+
+				details := &table{header: []string{"Kind:", string(kind)}}
+				details.add("Name:", name)
+				details.add("ID:", strconv.FormatInt(id, 10))
+
+				if kind == manifest.KindMonitor {
+					details.add("Path:", dash(s.monitors[name].PathName))
+				}
+
+				err = details.write(a.stdout)
+				if err != nil {
+					return err
+				}
+
+				_, err = fmt.Fprintln(a.stdout)
+				if err != nil {
+					return fmt.Errorf("describe: %w", err)
+				}
+
+				return m.Encode(a.stdout)
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&showSecrets, "show-secrets", false, "show secret values like passwords and tokens")
+
+	return cmd
+}
+
+func (a *app) newExportCommand() *cobra.Command {
+	var redactSecrets bool
+
+	cmd := &cobra.Command{
+		Use:   "export [KIND...]",
+		Short: "Export resources as manifest",
+		Long: "Export the resources of the given kinds, by default of all kinds, as manifest, which\n" +
+			"can be applied with apply. The output is YAML, unless -o json is set.\n" +
+			"The groups of status pages are not returned by Uptime Kuma and are not exported.",
+		Example: "  kumactl export > kuma.yaml\n" +
+			"  kumactl export monitors notifications --redact",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kinds := make([]manifest.Kind, 0, len(args))
+			for _, arg := range args {
+				kind, err := parseKind(arg)
+				if err != nil {
+					return err
+				}
+
+				kinds = append(kinds, kind)
+			}
+
+			return a.withState(cmd.Context(), func(_ context.Context, _ *kuma.Client, s *state) error {
+				m := &s.manifest
+				if len(kinds) > 0 {
+					m = &manifest.Manifest{}
+					for _, kind := range kinds {
+						m.Merge(s.filter(kind, nil))
+					}
+				}
+
+				if redactSecrets {
+					m = redact(m)
+				}
+
+				if a.output == formatJSON {
+					return a.write(nil, m)
+				}
+
+				return m.Encode(a.stdout)
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&redactSecrets, "redact", false, "mask secret values like passwords and tokens")
+
+	return cmd
+}
+
+// write writes the resources of the manifest in the selected output format.
+// For the table format, t is written.
+func (a *app) write(t *table, m *manifest.Manifest) error {
+	switch a.output {
+	case formatJSON:
+		docs, err := m.Documents()
+		if err != nil {
+			return err
+		}
+
+		return writeJSON(a.stdout, docs)
+
+	case formatYAML:
+		return m.Encode(a.stdout)
+
+	default:
+		return t.write(a.stdout)
+	}
+}
+
+// lookupAll returns the names of the resources of the given kind, which
+// are referenced by name or ID.
+func (s *state) lookupAll(kind manifest.Kind, refs []string) ([]string, error) {
+	names := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		name, _, err := s.lookup(kind, ref)
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
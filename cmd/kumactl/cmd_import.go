@@ -0,0 +1,153 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/breml/go-uptime-kuma-client/importer"
+	"github.com/breml/go-uptime-kuma-client/manifest"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+// Sources supported by the import command.
+const (
+	sourceGatus       = "gatus"
+	sourceUptimeRobot = "uptimerobot"
+	sourceBlackbox    = "blackbox"
+)
+
+func (a *app) newImportCommand() *cobra.Command {
+	var targetsFile string
+
+	cmd := &cobra.Command{
+		Use:   "import gatus|uptimerobot|blackbox FILE",
+		Short: "Convert the configuration of other monitoring tools to a manifest",
+		Long: "Convert the configuration of Gatus, an UptimeRobot export or a blackbox_exporter\n" +
+			"configuration to a manifest, which is written to stdout. Grouped checks are converted\n" +
+			"to monitors within group monitors. Settings, which could not be converted, are\n" +
+			"reported on stderr. For blackbox_exporter, the targets are read from the Prometheus\n" +
+			"configuration given by --targets.",
+		Example: "  kumactl import gatus config.yaml > kuma.yaml\n" +
+			"  kumactl import blackbox blackbox.yml --targets prometheus.yml | kumactl apply -f -",
+		Args:      cobra.ExactArgs(2),
+		ValidArgs: []string{sourceGatus, sourceUptimeRobot, sourceBlackbox},
+		RunE: func(_ *cobra.Command, args []string) error {
+			result, err := importFile(args[0], args[1], targetsFile)
+			if err != nil {
+				return err
+			}
+
+			for _, item := range result.Report.Items {
+				_, err = fmt.Fprintln(a.stderr, item)
+				if err != nil {
+					return fmt.Errorf("write report: %w", err)
+				}
+			}
+
+			m, err := importManifest(result)
+			if err != nil {
+				return err
+			}
+
+			return m.Encode(a.stdout)
+		},
+	}
+
+	cmd.Flags().StringVar(&targetsFile, "targets", "", "Prometheus configuration with the blackbox_exporter targets")
+
+	return cmd
+}
+
+func importFile(source string, path string, targetsFile string) (importer.Result, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return importer.Result{}, fmt.Errorf("import: %w", err)
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	switch source {
+	case sourceGatus:
+		return importer.FromGatus(f)
+
+	case sourceUptimeRobot:
+		return importer.FromUptimeRobot(f)
+
+	case sourceBlackbox:
+		if targetsFile == "" {
+			return importer.Result{}, fmt.Errorf("import: --targets is required for %s", sourceBlackbox)
+		}
+
+		targets, err := os.Open(targetsFile)
+		if err != nil {
+			return importer.Result{}, fmt.Errorf("import: %w", err)
+		}
+
+		defer func() {
+			_ = targets.Close()
+		}()
+
+		blackboxTargets, err := importer.ParseBlackboxTargets(targets)
+		if err != nil {
+			return importer.Result{}, err
+		}
+
+		return importer.FromBlackbox(f, blackboxTargets)
+
+	default:
+		return importer.Result{}, fmt.Errorf(
+			"import: unknown source %q, expected one of: %s, %s, %s",
+			source, sourceGatus, sourceUptimeRobot, sourceBlackbox,
+		)
+	}
+}
+
+// importManifest converts the result of an import into a manifest. For
+// every group of the source configuration, a group monitor is added.
+func importManifest(result importer.Result) (*manifest.Manifest, error) {
+	m := &manifest.Manifest{}
+
+	var groups []string
+
+	for _, item := range result.Items {
+		if item.Group != "" && !slices.Contains(groups, item.Group) {
+			groups = append(groups, item.Group)
+			m.Monitors = append(m.Monitors, manifest.Monitor{
+				Name: item.Group,
+				Monitor: &monitor.Group{
+					Base: monitor.Base{
+						Name:          item.Group,
+						Interval:      60,
+						RetryInterval: 60,
+						IsActive:      true,
+					},
+				},
+			})
+		}
+
+		base := monitor.Base{}
+
+		err := convertMonitor(item.Monitor, &base)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", item.Source, err)
+		}
+
+		m.Monitors = append(m.Monitors, manifest.Monitor{
+			Name:    base.Name,
+			Monitor: item.Monitor,
+			Parent:  item.Group,
+		})
+	}
+
+	err := m.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("import: %w", err)
+	}
+
+	return m, nil
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/heartbeat"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+const (
+	defaultRefresh = 2 * time.Second
+
+	// maxTransitions is the number of status transitions shown per monitor.
+	maxTransitions = 3
+
+	// clearScreen moves the cursor to the top left corner and clears the
+	// terminal.
+	clearScreen = "\x1b[H\x1b[2J"
+)
+
+func (a *app) newTopCommand() *cobra.Command {
+	var (
+		tags     []string
+		selector string
+		refresh  time.Duration
+		once     bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "top",
+		Short: "Show the live status of the monitors",
+		Long: "Show the status of the monitors grouped by their parent group, updated live from the\n" +
+			"heartbeat and uptime events of Uptime Kuma. For every monitor the status, the time and\n" +
+			"response time of the last check, the uptime of the last 24 hours and the recent status\n" +
+			"transitions are shown. Groups are shown, if at least one of their monitors is selected.",
+		Example: "  kumactl top\n" +
+			"  kumactl top --tag env=prod --tag critical\n" +
+			"  kumactl top -l 'type=http' --once",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			expr := tagSelector(tags)
+			if selector != "" {
+				expr = joinSelectors(expr, "("+selector+")")
+			}
+
+			return a.withClient(cmd.Context(), func(ctx context.Context, client *kuma.Client) error {
+				if once {
+					return a.writeTop(ctx, client, expr, false)
+				}
+
+				return a.runTop(ctx, client, expr, refresh)
+			})
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&tags, "tag", nil, "show monitors with the tag NAME or NAME=VALUE, can be repeated")
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "monitor selector expression, see kuma.Client.FindMonitors")
+	cmd.Flags().DurationVar(&refresh, "refresh", defaultRefresh, "interval for refreshing the view")
+	cmd.Flags().BoolVar(&once, "once", false, "print the status once and exit")
+
+	return cmd
+}
+
+// runTop redraws the view on every event and in the refresh interval, until
+// ctx is done.
+func (a *app) runTop(ctx context.Context, client *kuma.Client, selector string, refresh time.Duration) error {
+	events := make(chan struct{}, 1)
+	client.SubscribeHeartbeats(ctx, func(heartbeat.Event) {
+		select {
+		case events <- struct{}{}:
+		default:
+		}
+	})
+
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+
+	clearTerm := isTerminal(a.stdout)
+
+	for {
+		err := a.writeTop(ctx, client, selector, clearTerm)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-events:
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *app) writeTop(ctx context.Context, client *kuma.Client, selector string, clearTerm bool) error {
+	monitors, err := client.GetMonitors(ctx)
+	if err != nil {
+		return err
+	}
+
+	selected, err := client.FindMonitors(ctx, selector)
+	if err != nil {
+		return err
+	}
+
+	tree, err := monitor.NewTree(monitors)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[int64]bool, len(selected))
+	for _, mon := range selected {
+		ids[mon.ID] = true
+	}
+
+	rows := topRows(tree, ids, func(id int64) monitorStats {
+		return newMonitorStats(ctx, client, id)
+	})
+
+	var buf strings.Builder
+	if clearTerm {
+		buf.WriteString(clearScreen)
+	}
+
+	err = writeTop(&buf, time.Now(), rows)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(a.stdout, buf.String())
+	if err != nil {
+		return fmt.Errorf("top: %w", err)
+	}
+
+	return nil
+}
+
+// monitorStats are the statistics of a monitor shown by top.
+type monitorStats struct {
+	last *heartbeat.Heartbeat
+
+	uptime    float64
+	hasUptime bool
+
+	// transitions are the recent status changes, newest first.
+	transitions []heartbeat.Heartbeat
+}
+
+func newMonitorStats(ctx context.Context, client *kuma.Client, monitorID int64) monitorStats {
+	stats := monitorStats{}
+
+	heartbeats := client.GetHeartbeats(ctx, monitorID)
+	if len(heartbeats) > 0 {
+		stats.last = &heartbeats[len(heartbeats)-1]
+	}
+
+	stats.uptime, stats.hasUptime = client.GetUptime(ctx, monitorID, heartbeat.Period24h)
+
+	important := client.GetImportantHeartbeats(ctx, monitorID)
+	for i := len(important) - 1; i >= 0 && len(stats.transitions) < maxTransitions; i-- {
+		stats.transitions = append(stats.transitions, important[i])
+	}
+
+	return stats
+}
+
+type topRow struct {
+	node  *monitor.TreeNode
+	stats monitorStats
+}
+
+// topRows returns the rows of the view in the order of the monitor tree. A
+// monitor is included, if it is selected or if it is the parent group of a
+// selected monitor.
+func topRows(tree *monitor.Tree, selected map[int64]bool, stats func(id int64) monitorStats) []topRow {
+	var rows []topRow
+
+	for _, root := range tree.Roots {
+		for node := range root.All() {
+			include := selected[node.Monitor.ID]
+			for child := range node.Descendants() {
+				if include {
+					break
+				}
+
+				include = selected[child.Monitor.ID]
+			}
+
+			if include {
+				rows = append(rows, topRow{node: node, stats: stats(node.Monitor.ID)})
+			}
+		}
+	}
+
+	return rows
+}
+
+// writeTop writes the summary and the table of the monitors.
+func writeTop(w io.Writer, now time.Time, rows []topRow) error {
+	counts := map[string]int{}
+	t := &table{header: []string{"NAME", "STATUS", "LAST CHECK", "PING", "UPTIME 24H", "TRANSITIONS"}}
+
+	for _, row := range rows {
+		status := rowStatus(row)
+		counts[status]++
+
+		lastCheck, ping := "-", "-"
+		if row.stats.last != nil {
+			lastCheck = age(now, row.stats.last.Time)
+
+			if row.stats.last.Ping != nil {
+				ping = strconv.FormatFloat(*row.stats.last.Ping, 'f', 0, 64) + "ms"
+			}
+		}
+
+		uptime := "-"
+		if row.stats.hasUptime {
+			uptime = strconv.FormatFloat(row.stats.uptime*100, 'f', 2, 64) + "%"
+		}
+
+		transitions := make([]string, 0, len(row.stats.transitions))
+		for _, hb := range row.stats.transitions {
+			transitions = append(transitions, hb.Status.String()+" "+age(now, hb.Time))
+		}
+
+		t.add(
+			strings.Repeat("  ", row.node.Depth())+row.node.Monitor.Name,
+			status,
+			lastCheck,
+			ping,
+			uptime,
+			dash(strings.Join(transitions, ", ")),
+		)
+	}
+
+	_, err := fmt.Fprintf(w, "%s  monitors: %d  up: %d  down: %d  pending: %d  maintenance: %d  paused: %d\n\n",
+		now.Format(time.DateTime), len(rows), counts[heartbeat.StatusUp.String()], counts[heartbeat.StatusDown.String()],
+		counts[heartbeat.StatusPending.String()], counts[heartbeat.StatusMaintenance.String()], counts["paused"])
+	if err != nil {
+		return fmt.Errorf("top: %w", err)
+	}
+
+	return t.write(w)
+}
+
+func rowStatus(row topRow) string {
+	switch {
+	case !row.node.Monitor.IsActive:
+		return "paused"
+
+	case row.stats.last == nil:
+		return "-"
+
+	default:
+		return row.stats.last.Status.String()
+	}
+}
+
+// age returns the duration since t in a short form, e.g. "5m ago".
+func age(now time.Time, t time.Time) string {
+	d := now.Sub(t)
+
+	switch {
+	case d < time.Minute:
+		return strconv.Itoa(max(int(d.Seconds()), 0)) + "s ago"
+
+	case d < time.Hour:
+		return strconv.Itoa(int(d.Minutes())) + "m ago"
+
+	case d < 48*time.Hour:
+		return strconv.Itoa(int(d.Hours())) + "h ago"
+
+	default:
+		return strconv.Itoa(int(d.Hours()/24)) + "d ago"
+	}
+}
+
+// tagSelector returns the selector expression for monitors with all the
+// given tags. A tag is given as NAME or NAME=VALUE.
+func tagSelector(tags []string) string {
+	var expr string
+
+	for _, t := range tags {
+		name, value, hasValue := strings.Cut(t, "=")
+
+		term := "tag:" + strconv.Quote(name)
+		if hasValue {
+			term += "=" + strconv.Quote(value)
+		}
+
+		expr = joinSelectors(expr, term)
+	}
+
+	return expr
+}
+
+func joinSelectors(a string, b string) string {
+	if a == "" {
+		return b
+	}
+
+	return a + " and " + b
+}
+
+// isTerminal reports whether w is a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
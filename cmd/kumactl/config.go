@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+)
+
+// Environment variables, which override the settings of the selected context.
+const (
+	envConfig   = "KUMACTL_CONFIG"
+	envURL      = "KUMA_URL"
+	envUsername = "KUMA_USERNAME"
+	envPassword = "KUMA_PASSWORD"
+)
+
+var errNoContext = errors.New("no context configured, add a context to the config file or set " + envURL)
+
+// Config is the content of the kumactl config file.
+type Config struct {
+	// CurrentContext is the name of the context used, if no context is
+	// selected with --context.
+	CurrentContext string    `yaml:"current-context,omitempty"`
+	Contexts       []Context `yaml:"contexts"`
+}
+
+// Context contains the connection details for an Uptime Kuma instance.
+type Context struct {
+	Name     string `yaml:"name"`
+	URL      string `yaml:"url"`
+	Username string `yaml:"username"`
+
+	// Password is the password or a secret reference, e.g. "env:KUMA_PASSWORD"
+	// or "file:/run/secrets/kuma", see kuma.DefaultSecretSchemes.
+	Password string `yaml:"password,omitempty"`
+
+	// Autosetup enables the automatic database setup of new Uptime Kuma v2
+	// instances.
+	Autosetup bool `yaml:"autosetup,omitempty"`
+}
+
+// defaultConfigPath returns the path of the config file, which is used, if
+// --config is not set.
+func defaultConfigPath() string {
+	path, ok := os.LookupEnv(envConfig)
+	if ok {
+		return path
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "kumactl.yaml"
+	}
+
+	return filepath.Join(dir, "kumactl", "config.yaml")
+}
+
+// loadConfig reads the config file at path. A missing config file results in
+// an empty config, such that kumactl can be used with environment variables
+// only.
+func loadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Config{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+
+	cfg := &Config{}
+
+	err = yaml.Unmarshal(data, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("load config %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// save writes the config file to path.
+func (c *Config) save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("save config: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return fmt.Errorf("save config: %w", err)
+	}
+
+	err = os.WriteFile(path, data, 0o600)
+	if err != nil {
+		return fmt.Errorf("save config: %w", err)
+	}
+
+	return nil
+}
+
+// useContext sets the current context.
+func (c *Config) useContext(name string) error {
+	if !slices.ContainsFunc(c.Contexts, func(ctx Context) bool { return ctx.Name == name }) {
+		return fmt.Errorf("context %q not found", name)
+	}
+
+	c.CurrentContext = name
+
+	return nil
+}
+
+// context returns the context with the given name. If name is empty, the
+// current context is returned. The settings of the context are overridden
+// by the environment variables KUMA_URL, KUMA_USERNAME and KUMA_PASSWORD.
+func (c *Config) context(name string) (Context, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+
+	var ctx Context
+
+	switch {
+	case name != "":
+		i := slices.IndexFunc(c.Contexts, func(ctx Context) bool { return ctx.Name == name })
+		if i < 0 {
+			return Context{}, fmt.Errorf("context %q not found", name)
+		}
+
+		ctx = c.Contexts[i]
+
+	case len(c.Contexts) == 1:
+		ctx = c.Contexts[0]
+
+	case len(c.Contexts) > 1:
+		return Context{}, errors.New("multiple contexts configured, select one with --context or use-context")
+
+	default:
+	}
+
+	value, ok := os.LookupEnv(envURL)
+	if ok {
+		ctx.URL = value
+	}
+
+	value, ok = os.LookupEnv(envUsername)
+	if ok {
+		ctx.Username = value
+	}
+
+	value, ok = os.LookupEnv(envPassword)
+	if ok {
+		ctx.Password = value
+	}
+
+	if ctx.URL == "" {
+		return Context{}, errNoContext
+	}
+
+	return ctx, nil
+}
+
+// connect connects to the Uptime Kuma instance of the context.
+func (c Context) connect(ctx context.Context, opts ...kuma.Option) (*kuma.Client, error) {
+	password, err := kuma.DefaultSecretSchemes().ResolveSecret(ctx, c.Password)
+	if err != nil {
+		return nil, fmt.Errorf("context %q: password: %w", c.Name, err)
+	}
+
+	if c.Autosetup {
+		opts = append(opts, kuma.WithAutosetup())
+	}
+
+	client, err := kuma.New(ctx, c.URL, c.Username, password, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s: %w", c.URL, err)
+	}
+
+	return client, nil
+}
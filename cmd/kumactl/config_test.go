@@ -0,0 +1,180 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	cfg, err := loadConfig(filepath.Join(dir, "missing.yaml"))
+	require.NoError(t, err)
+	require.Empty(t, cfg.Contexts)
+
+	path := filepath.Join(dir, "config.yaml")
+	err = os.WriteFile(path, []byte(`
+current-context: prod
+contexts:
+  - name: prod
+    url: https://kuma.example.com
+    username: admin
+    password: env:KUMA_PROD_PASSWORD
+  - name: staging
+    url: https://kuma.staging.example.com
+    username: admin
+    autosetup: true
+`), 0o600)
+	require.NoError(t, err)
+
+	cfg, err = loadConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, "prod", cfg.CurrentContext)
+	require.Len(t, cfg.Contexts, 2)
+	require.True(t, cfg.Contexts[1].Autosetup)
+
+	err = os.WriteFile(path, []byte("contexts: {"), 0o600)
+	require.NoError(t, err)
+
+	_, err = loadConfig(path)
+	require.Error(t, err)
+}
+
+func TestConfig_Context(t *testing.T) {
+	prod := Context{Name: "prod", URL: "https://kuma.example.com", Username: "admin"}
+	staging := Context{Name: "staging", URL: "https://kuma.staging.example.com", Username: "ops"}
+
+	tests := []struct {
+		name    string
+		config  Config
+		context string
+		env     map[string]string
+
+		want    Context
+		wantErr bool
+	}{
+		{
+			name:   "current context",
+			config: Config{CurrentContext: "staging", Contexts: []Context{prod, staging}},
+			want:   staging,
+		},
+		{
+			name:    "selected context",
+			config:  Config{CurrentContext: "staging", Contexts: []Context{prod, staging}},
+			context: "prod",
+			want:    prod,
+		},
+		{
+			name:   "single context",
+			config: Config{Contexts: []Context{prod}},
+			want:   prod,
+		},
+		{
+			name:    "multiple contexts without current context",
+			config:  Config{Contexts: []Context{prod, staging}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown context",
+			config:  Config{Contexts: []Context{prod}},
+			context: "dev",
+			wantErr: true,
+		},
+		{
+			name:    "no context",
+			wantErr: true,
+		},
+		{
+			name: "environment only",
+			env: map[string]string{
+				envURL:      "http://localhost:3001",
+				envUsername: "admin",
+				envPassword: "secret",
+			},
+			want: Context{URL: "http://localhost:3001", Username: "admin", Password: "secret"},
+		},
+		{
+			name:   "environment overrides context",
+			config: Config{Contexts: []Context{prod}},
+			env: map[string]string{
+				envPassword: "file:/run/secrets/kuma",
+			},
+			want: Context{
+				Name:     "prod",
+				URL:      "https://kuma.example.com",
+				Username: "admin",
+				Password: "file:/run/secrets/kuma",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, env := range []string{envURL, envUsername, envPassword} {
+				t.Setenv(env, "")
+				require.NoError(t, os.Unsetenv(env))
+			}
+
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+
+			got, err := tc.config.context(tc.context)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestConfigCommand(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kumactl", "config.yaml")
+
+	cfg := &Config{
+		Contexts: []Context{
+			{Name: "prod", URL: "https://kuma.example.com", Username: "admin"},
+			{Name: "staging", URL: "https://kuma.staging.example.com", Username: "ops"},
+		},
+	}
+	err := cfg.save(path)
+	require.NoError(t, err)
+
+	run := func(args ...string) (string, error) {
+		stdout := &bytes.Buffer{}
+		cmd := newRootCommand(stdout, &bytes.Buffer{})
+		cmd.SetArgs(append([]string{"--config", path}, args...))
+
+		err := cmd.Execute()
+
+		return stdout.String(), err
+	}
+
+	_, err = run("config", "current-context")
+	require.Error(t, err)
+
+	_, err = run("config", "use-context", "dev")
+	require.Error(t, err)
+
+	out, err := run("config", "use-context", "staging")
+	require.NoError(t, err)
+	require.Equal(t, "switched to context \"staging\"\n", out)
+
+	out, err = run("config", "current-context")
+	require.NoError(t, err)
+	require.Equal(t, "staging\n", out)
+
+	out, err = run("config", "get-contexts")
+	require.NoError(t, err)
+	require.Equal(t, ""+
+		"CURRENT   NAME      URL                                USERNAME\n"+
+		"          prod      https://kuma.example.com           admin\n"+
+		"*         staging   https://kuma.staging.example.com   ops\n", out)
+}
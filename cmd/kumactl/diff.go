@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/breml/go-uptime-kuma-client/manifest"
+)
+
+// diff writes the differences between the resources of the manifest and the
+// resources of the state to out. Resources, which only exist in the state,
+// are not reported. It returns errDifferences, if there are differences.
+func diff(out io.Writer, s *state, m *manifest.Manifest) error {
+	desired, err := specs(m)
+	if err != nil {
+		return err
+	}
+
+	current, err := specs(&s.manifest)
+	if err != nil {
+		return err
+	}
+
+	docs, err := m.Documents()
+	if err != nil {
+		return err
+	}
+
+	p := &diffPrinter{w: out}
+
+	for _, doc := range docs {
+		key := resourceKey{doc.Kind, doc.Metadata.Name}
+		want := desired[key]
+
+		switch doc.Kind {
+		case manifest.KindProxy:
+			// Proxies are identified by their address.
+			i := slices.IndexFunc(m.Proxies, func(p manifest.Proxy) bool { return p.Name == doc.Metadata.Name })
+			key.name = proxyName(m.Proxies[i].Proxy.Protocol, m.Proxies[i].Proxy.Host, m.Proxies[i].Proxy.Port)
+
+		case manifest.KindStatusPage:
+			// The groups of existing status pages are not returned by the
+			// server and can not be compared.
+			want = maps.Clone(want)
+			delete(want, "groups")
+
+		default:
+		}
+
+		have, exists := current[key]
+		if !exists {
+			p.resource("+", key)
+			for _, name := range slices.Sorted(maps.Keys(want)) {
+				p.property("+", name, want[name])
+			}
+
+			continue
+		}
+
+		changed := changedProperties(have, want)
+		if len(changed) == 0 {
+			continue
+		}
+
+		p.resource("~", key)
+
+		for _, name := range changed {
+			if value, ok := have[name]; ok {
+				p.property("-", name, value)
+			}
+
+			if value, ok := want[name]; ok {
+				p.property("+", name, value)
+			}
+		}
+	}
+
+	if p.err != nil {
+		return fmt.Errorf("write diff: %w", p.err)
+	}
+
+	if p.differences {
+		return errDifferences
+	}
+
+	return nil
+}
+
+// changedProperties returns the sorted names of the properties, which differ
+// between have and want.
+func changedProperties(have map[string]any, want map[string]any) []string {
+	var changed []string
+
+	for _, name := range slices.Sorted(maps.Keys(want)) {
+		if !reflect.DeepEqual(have[name], want[name]) {
+			changed = append(changed, name)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(have)) {
+		_, ok := want[name]
+		if !ok {
+			changed = append(changed, name)
+		}
+	}
+
+	slices.Sort(changed)
+
+	return changed
+}
+
+// diffPrinter writes the lines of a diff and keeps the first write error.
+type diffPrinter struct {
+	w           io.Writer
+	err         error
+	differences bool
+}
+
+func (p *diffPrinter) resource(op string, key resourceKey) {
+	p.differences = true
+	p.printf("%s %s\n", op, key)
+}
+
+func (p *diffPrinter) property(op string, name string, value any) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		data = []byte(fmt.Sprint(value))
+	}
+
+	p.printf("%s   %s: %s\n", op, name, data)
+}
+
+func (p *diffPrinter) printf(format string, args ...any) {
+	if p.err != nil {
+		return
+	}
+
+	_, p.err = fmt.Fprintf(p.w, format, args...)
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/importer"
+	"github.com/breml/go-uptime-kuma-client/manifest"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func TestParseKind(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    manifest.Kind
+		wantErr bool
+	}{
+		{name: "monitors", want: manifest.KindMonitor},
+		{name: "Notification", want: manifest.KindNotification},
+		{name: "status-pages", want: manifest.KindStatusPage},
+		{name: "dockerhost", want: manifest.KindDockerHost},
+		{name: "heartbeats", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseKind(tc.name)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestWriteYAML(t *testing.T) {
+	out := &bytes.Buffer{}
+
+	err := writeYAML(out, map[string]any{"name": "website", "tags": []string{"prod"}, "interval": 60})
+	require.NoError(t, err)
+	require.Equal(t, "interval: 60\nname: website\ntags:\n  - prod\n", out.String())
+}
+
+func TestMonitorOrder(t *testing.T) {
+	monitors := []manifest.Monitor{
+		{Name: "api", Parent: "backend"},
+		{Name: "backend", Parent: "production"},
+		{Name: "website"},
+		{Name: "production"},
+	}
+
+	ordered, err := monitorOrder(monitors)
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(ordered))
+	for _, mon := range ordered {
+		names = append(names, mon.Name)
+	}
+
+	require.Equal(t, []string{"website", "production", "backend", "api"}, names)
+
+	_, err = monitorOrder([]manifest.Monitor{
+		{Name: "a", Parent: "b"},
+		{Name: "b", Parent: "a"},
+	})
+	require.Error(t, err)
+}
+
+func TestChangedProperties(t *testing.T) {
+	have := map[string]any{"interval": 60.0, "url": "https://example.com", "description": "old"}
+	want := map[string]any{"interval": 30.0, "url": "https://example.com", "method": "GET"}
+
+	require.Equal(t, []string{"description", "interval", "method"}, changedProperties(have, want))
+	require.Empty(t, changedProperties(want, want))
+}
+
+func TestImportManifest(t *testing.T) {
+	website := &monitor.HTTP{
+		Base:        monitor.Base{Name: "website", Interval: 60, RetryInterval: 60, IsActive: true},
+		HTTPDetails: monitor.HTTPDetails{URL: "https://example.com", Method: "GET"},
+	}
+	database := &monitor.TCPPort{
+		Base:           monitor.Base{Name: "database", Interval: 60, RetryInterval: 60, IsActive: true},
+		TCPPortDetails: monitor.TCPPortDetails{Hostname: "db.example.com", Port: 5432},
+	}
+	router := &monitor.Ping{
+		Base:        monitor.Base{Name: "router", Interval: 60, RetryInterval: 60, IsActive: true},
+		PingDetails: monitor.PingDetails{Hostname: "10.0.0.1"},
+	}
+
+	m, err := importManifest(importer.Result{
+		Items: []importer.Item{
+			{Source: "core/website", Group: "core", Monitor: website},
+			{Source: "core/database", Group: "core", Monitor: database},
+			{Source: "router", Monitor: router},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, m.Monitors, 4)
+
+	require.Equal(t, "core", m.Monitors[0].Name)
+	require.Equal(t, "group", m.Monitors[0].Monitor.Type())
+	require.Equal(t, manifest.Monitor{Name: "website", Monitor: website, Parent: "core"}, m.Monitors[1])
+	require.Equal(t, manifest.Monitor{Name: "database", Monitor: database, Parent: "core"}, m.Monitors[2])
+	require.Equal(t, manifest.Monitor{Name: "router", Monitor: router}, m.Monitors[3])
+}
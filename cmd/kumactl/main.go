@@ -0,0 +1,162 @@
+// Command kumactl manages the resources of an Uptime Kuma instance from the
+// command line.
+//
+// The connection details are read from a config file with one or more
+// contexts, by default $XDG_CONFIG_HOME/kumactl/config.yaml:
+//
+//	current-context: prod
+//	contexts:
+//	  - name: prod
+//	    url: https://kuma.example.com
+//	    username: admin
+//	    password: env:KUMA_PROD_PASSWORD
+//
+// Resources are read and written in the manifest format of the manifest
+// package, where resources reference each other by name:
+//
+//	kumactl get monitors
+//	kumactl export > kuma.yaml
+//	kumactl diff -f kuma.yaml
+//	kumactl apply -f kuma.yaml
+//
+// During incidents, top shows the live status of the monitors in the
+// terminal:
+//
+//	kumactl top --tag env=prod
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+)
+
+const defaultTimeout = 30 * time.Second
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
+	err := newRootCommand(os.Stdout, os.Stderr).ExecuteContext(ctx)
+
+	stop()
+
+	switch {
+	case errors.Is(err, errDifferences):
+		os.Exit(1)
+
+	case err != nil:
+		_, _ = fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+
+	default:
+	}
+}
+
+// app holds the global flags and the output streams of kumactl.
+type app struct {
+	configPath  string
+	contextName string
+	output      string
+	timeout     time.Duration
+
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func newRootCommand(stdout io.Writer, stderr io.Writer) *cobra.Command {
+	a := &app{
+		stdout: stdout,
+		stderr: stderr,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "kumactl",
+		Short: "kumactl manages Uptime Kuma resources",
+		Long: "kumactl manages the monitors, notifications, status pages, maintenances, tags,\n" +
+			"proxies and docker hosts of an Uptime Kuma instance.",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(*cobra.Command, []string) error {
+			return validateFormat(a.output)
+		},
+	}
+
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&a.configPath, "config", defaultConfigPath(), "path of the config file (env "+envConfig+")")
+	flags.StringVar(&a.contextName, "context", "", "name of the context to use, defaults to the current context")
+	flags.StringVarP(&a.output, "output", "o", formatTable, "output format, one of: table, json, yaml")
+	flags.DurationVar(&a.timeout, "timeout", defaultTimeout, "timeout for the connection to Uptime Kuma")
+
+	cmd.AddCommand(
+		a.newGetCommand(),
+		a.newDescribeCommand(),
+		a.newApplyCommand(modeCreate),
+		a.newApplyCommand(modeApply),
+		a.newDiffCommand(),
+		a.newDeleteCommand(),
+		a.newPauseCommand(true),
+		a.newPauseCommand(false),
+		a.newTopCommand(),
+		a.newExportCommand(),
+		a.newImportCommand(),
+		a.newConfigCommand(),
+	)
+
+	return cmd
+}
+
+// withClient connects to the Uptime Kuma instance of the selected context
+// and calls fn with the client.
+func (a *app) withClient(ctx context.Context, fn func(ctx context.Context, client *kuma.Client) error) error {
+	cfg, err := loadConfig(a.configPath)
+	if err != nil {
+		return err
+	}
+
+	kumaCtx, err := cfg.context(a.contextName)
+	if err != nil {
+		return err
+	}
+
+	client, err := kumaCtx.connect(ctx,
+		kuma.WithConnectTimeout(a.timeout),
+		kuma.WithSecretResolver(kuma.DefaultSecretSchemes()),
+	)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = client.Disconnect()
+	}()
+
+	return fn(ctx, client)
+}
+
+// withState connects to the Uptime Kuma instance and calls fn with the
+// client and the current state of the instance.
+func (a *app) withState(
+	ctx context.Context,
+	fn func(ctx context.Context, client *kuma.Client, s *state) error,
+) error {
+	return a.withClient(ctx, func(ctx context.Context, client *kuma.Client) error {
+		s, err := fetchState(ctx, client)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, client, s)
+	})
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats.
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+func outputFormats() []string {
+	return []string{formatTable, formatJSON, formatYAML}
+}
+
+func validateFormat(format string) error {
+	if !slices.Contains(outputFormats(), format) {
+		return fmt.Errorf("unsupported output format %q, expected one of: %s", format, strings.Join(outputFormats(), ", "))
+	}
+
+	return nil
+}
+
+// table is a list of rows with a header, printed with aligned columns.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func (t *table) add(columns ...string) {
+	t.rows = append(t.rows, columns)
+}
+
+func (t *table) write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	_, err := fmt.Fprintln(tw, strings.Join(t.header, "\t"))
+	if err != nil {
+		return fmt.Errorf("write table: %w", err)
+	}
+
+	for _, row := range t.rows {
+		_, err = fmt.Fprintln(tw, strings.Join(row, "\t"))
+		if err != nil {
+			return fmt.Errorf("write table: %w", err)
+		}
+	}
+
+	err = tw.Flush()
+	if err != nil {
+		return fmt.Errorf("write table: %w", err)
+	}
+
+	return nil
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	err := enc.Encode(v)
+	if err != nil {
+		return fmt.Errorf("write json: %w", err)
+	}
+
+	return nil
+}
+
+// writeYAML writes v as YAML. v is encoded as JSON first, such that the
+// JSON names of the fields are used and the order of the fields is kept.
+func writeYAML(w io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("write yaml: %w", err)
+	}
+
+	var node yaml.Node
+
+	err = yaml.Unmarshal(data, &node)
+	if err != nil {
+		return fmt.Errorf("write yaml: %w", err)
+	}
+
+	resetStyle(&node)
+
+	buf := bytes.Buffer{}
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+
+	err = enc.Encode(&node)
+	if err != nil {
+		return fmt.Errorf("write yaml: %w", err)
+	}
+
+	err = enc.Close()
+	if err != nil {
+		return fmt.Errorf("write yaml: %w", err)
+	}
+
+	_, err = w.Write(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("write yaml: %w", err)
+	}
+
+	return nil
+}
+
+// resetStyle resets the flow style of the nodes decoded from JSON to the
+// default block style. Strings keep their quotes only where required.
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+
+	return "no"
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/breml/go-uptime-kuma-client/manifest"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/notification"
+)
+
+// kindNames maps the names of the kinds accepted on the command line to the
+// kinds of the manifest format.
+func kindNames() map[string]manifest.Kind {
+	return map[string]manifest.Kind{
+		"monitor":       manifest.KindMonitor,
+		"monitors":      manifest.KindMonitor,
+		"mon":           manifest.KindMonitor,
+		"notification":  manifest.KindNotification,
+		"notifications": manifest.KindNotification,
+		"statuspage":    manifest.KindStatusPage,
+		"statuspages":   manifest.KindStatusPage,
+		"status-page":   manifest.KindStatusPage,
+		"status-pages":  manifest.KindStatusPage,
+		"maintenance":   manifest.KindMaintenance,
+		"maintenances":  manifest.KindMaintenance,
+		"tag":           manifest.KindTag,
+		"tags":          manifest.KindTag,
+		"proxy":         manifest.KindProxy,
+		"proxies":       manifest.KindProxy,
+		"dockerhost":    manifest.KindDockerHost,
+		"dockerhosts":   manifest.KindDockerHost,
+		"docker-host":   manifest.KindDockerHost,
+		"docker-hosts":  manifest.KindDockerHost,
+	}
+}
+
+// parseKind returns the kind for the name given on the command line. The
+// name is case insensitive and accepts the singular and the plural form.
+func parseKind(name string) (manifest.Kind, error) {
+	kind, ok := kindNames()[strings.ToLower(name)]
+	if !ok {
+		return "", fmt.Errorf(
+			"unknown resource kind %q, expected one of: monitor, notification, statuspage, maintenance, tag, proxy, dockerhost",
+			name,
+		)
+	}
+
+	return kind, nil
+}
+
+// resourceTable returns the table of the resources of the manifest, which
+// is expected to contain resources of a single kind only.
+//
+//nolint:funlen // One case per kind.
+func (s *state) resourceTable(kind manifest.Kind, m *manifest.Manifest) *table {
+	id := func(name string) string {
+		id, err := s.ids.Get(kind, name)
+		if err != nil {
+			return "-"
+		}
+
+		return strconv.FormatInt(id, 10)
+	}
+
+	t := &table{}
+
+	switch kind {
+	case manifest.KindMonitor:
+		t.header = []string{"ID", "NAME", "TYPE", "ACTIVE", "PARENT", "TARGET"}
+		for _, res := range m.Monitors {
+			t.add(id(res.Name), res.Name, res.Monitor.Type(), yesNo(monitorActive(res.Monitor)),
+				dash(res.Parent), dash(monitorTarget(res.Monitor)))
+		}
+
+	case manifest.KindNotification:
+		t.header = []string{"ID", "NAME", "TYPE", "ACTIVE", "DEFAULT"}
+		for _, res := range m.Notifications {
+			base := notification.Base{}
+			_ = res.Notification.As(&base)
+			t.add(id(res.Name), res.Name, res.Notification.Type(), yesNo(base.IsActive), yesNo(base.IsDefault))
+		}
+
+	case manifest.KindStatusPage:
+		t.header = []string{"ID", "NAME", "TITLE", "PUBLISHED"}
+		for _, res := range m.StatusPages {
+			t.add(id(res.Name), res.Name, res.StatusPage.Title, yesNo(res.StatusPage.Published))
+		}
+
+	case manifest.KindMaintenance:
+		t.header = []string{"ID", "NAME", "STRATEGY", "ACTIVE", "STATUS"}
+		for _, res := range m.Maintenances {
+			t.add(id(res.Name), res.Name, res.Maintenance.Strategy, yesNo(res.Maintenance.Active),
+				dash(res.Maintenance.Status))
+		}
+
+	case manifest.KindTag:
+		t.header = []string{"ID", "NAME", "COLOR"}
+		for _, res := range m.Tags {
+			t.add(id(res.Name), res.Name, res.Tag.Color)
+		}
+
+	case manifest.KindProxy:
+		t.header = []string{"ID", "NAME", "AUTH", "ACTIVE", "DEFAULT"}
+		for _, res := range m.Proxies {
+			t.add(id(res.Name), res.Name, yesNo(res.Proxy.Auth), yesNo(res.Proxy.Active), yesNo(res.Proxy.Default))
+		}
+
+	case manifest.KindDockerHost:
+		t.header = []string{"ID", "NAME", "TYPE", "DAEMON"}
+		for _, res := range m.DockerHosts {
+			t.add(id(res.Name), res.Name, res.DockerHost.DockerType, res.DockerHost.DockerDaemon)
+		}
+
+	default:
+	}
+
+	return t
+}
+
+func monitorActive(mon monitor.Monitor) bool {
+	base := monitor.Base{}
+
+	err := convertMonitor(mon, &base)
+	if err != nil {
+		return false
+	}
+
+	return base.IsActive
+}
+
+// monitorTarget returns the target of the monitor, e.g. the URL of a HTTP
+// monitor or the hostname of a ping monitor.
+func monitorTarget(mon monitor.Monitor) string {
+	var target struct {
+		URL             string `json:"url"`
+		Hostname        string `json:"hostname"`
+		Port            *int64 `json:"port"`
+		DockerContainer string `json:"docker_container"`
+	}
+
+	err := convertMonitor(mon, &target)
+	if err != nil {
+		return ""
+	}
+
+	switch {
+	case target.URL != "":
+		return target.URL
+
+	case target.Hostname != "" && target.Port != nil && *target.Port != 0:
+		return target.Hostname + ":" + strconv.FormatInt(*target.Port, 10)
+
+	case target.Hostname != "":
+		return target.Hostname
+
+	default:
+		return target.DockerContainer
+	}
+}
+
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return s
+}
+
+// convertMonitor converts the monitor into target by a JSON round trip.
+func convertMonitor(mon monitor.Monitor, target any) error {
+	data, err := json.Marshal(mon)
+	if err != nil {
+		return fmt.Errorf("encode monitor: %w", err)
+	}
+
+	err = json.Unmarshal(data, target)
+	if err != nil {
+		return fmt.Errorf("decode monitor: %w", err)
+	}
+
+	return nil
+}
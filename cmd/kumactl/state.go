@@ -0,0 +1,341 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/dockerhost"
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
+	"github.com/breml/go-uptime-kuma-client/maintenance"
+	"github.com/breml/go-uptime-kuma-client/manifest"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/notification"
+	"github.com/breml/go-uptime-kuma-client/proxy"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+// state is the current state of an Uptime Kuma instance in the form of a
+// manifest, where all references between resources are names.
+type state struct {
+	manifest manifest.Manifest
+
+	// ids maps the names of the resources to their IDs.
+	ids manifest.IDs
+
+	// names maps the IDs of the resources to their names.
+	names map[manifest.Kind]map[int64]string
+
+	// monitors contains the monitors as returned by the server, indexed by
+	// name. It provides the runtime information, which is not part of the
+	// manifest, e.g. the path name.
+	monitors map[string]monitor.Base
+}
+
+// fetchState reads all resources from the Uptime Kuma instance.
+//
+//nolint:gocognit,gocyclo,cyclop,funlen // Sequential conversion of all kinds of resources.
+func fetchState(ctx context.Context, client *kuma.Client) (*state, error) {
+	s := &state{
+		names:    map[manifest.Kind]map[int64]string{},
+		monitors: map[string]monitor.Base{},
+	}
+
+	tags, err := client.GetTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch tags: %w", err)
+	}
+
+	for _, t := range tags {
+		s.add(manifest.KindTag, t.Name, t.ID)
+		s.manifest.Tags = append(s.manifest.Tags, manifest.Tag{Name: t.Name, Tag: tag.Tag{Name: t.Name, Color: t.Color}})
+	}
+
+	for _, p := range client.GetProxyList(ctx) {
+		name := proxyName(p.Protocol, p.Host, p.Port)
+		s.add(manifest.KindProxy, name, p.ID)
+		s.manifest.Proxies = append(s.manifest.Proxies, manifest.Proxy{
+			Name: name,
+			Proxy: proxy.Config{
+				Protocol: p.Protocol,
+				Host:     p.Host,
+				Port:     p.Port,
+				Auth:     p.Auth,
+				Username: p.Username,
+				Password: p.Password,
+				Active:   p.Active,
+				Default:  p.Default,
+			},
+		})
+	}
+
+	for _, d := range client.GetDockerHostList(ctx) {
+		s.add(manifest.KindDockerHost, d.Name, d.ID)
+		s.manifest.DockerHosts = append(s.manifest.DockerHosts, manifest.DockerHost{
+			Name: d.Name,
+			DockerHost: dockerhost.Config{
+				Name:         d.Name,
+				DockerDaemon: d.DockerDaemon,
+				DockerType:   d.DockerType,
+			},
+		})
+	}
+
+	for _, base := range client.GetNotifications(ctx) {
+		s.add(manifest.KindNotification, base.Name, base.ID)
+
+		var n notification.Notification = base
+
+		typed, ok := notification.New(base.Type())
+		if ok {
+			err = base.As(typed)
+			if err != nil {
+				return nil, fmt.Errorf("notification %q: %w", base.Name, err)
+			}
+
+			n = typed
+		}
+
+		s.manifest.Notifications = append(s.manifest.Notifications, manifest.Notification{
+			Name:         base.Name,
+			Notification: n,
+		})
+	}
+
+	monitors, err := client.GetMonitors(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch monitors: %w", err)
+	}
+
+	for _, base := range monitors {
+		s.add(manifest.KindMonitor, base.Name, base.ID)
+		s.monitors[base.Name] = base
+	}
+
+	for _, base := range monitors {
+		res, err := s.monitor(base)
+		if err != nil {
+			return nil, err
+		}
+
+		s.manifest.Monitors = append(s.manifest.Monitors, res)
+	}
+
+	statusPages, err := client.GetStatusPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch status pages: %w", err)
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(statusPages)) {
+		page := statusPages[id]
+		s.add(manifest.KindStatusPage, page.Slug, page.ID)
+
+		// The public group list is not returned by the server, the status page
+		// is listed without groups.
+		page.PublicGroupList = nil
+		s.manifest.StatusPages = append(s.manifest.StatusPages, manifest.StatusPage{
+			Name:       page.Slug,
+			StatusPage: page,
+		})
+	}
+
+	maintenances, err := client.GetMaintenances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch maintenances: %w", err)
+	}
+
+	for _, m := range maintenances {
+		res, err := s.maintenance(ctx, client, m)
+		if err != nil {
+			return nil, err
+		}
+
+		s.add(manifest.KindMaintenance, m.Title, m.ID)
+		s.manifest.Maintenances = append(s.manifest.Maintenances, res)
+	}
+
+	return s, nil
+}
+
+func (s *state) add(kind manifest.Kind, name string, id int64) {
+	s.ids.Set(kind, name, id)
+
+	if s.names[kind] == nil {
+		s.names[kind] = map[int64]string{}
+	}
+
+	s.names[kind][id] = name
+}
+
+// monitor converts the monitor returned by the server into a manifest
+// monitor with the references replaced by names.
+func (s *state) monitor(base monitor.Base) (manifest.Monitor, error) {
+	res := manifest.Monitor{Name: base.Name, Monitor: &base}
+
+	typed, ok := monitor.New(base.Type())
+	if ok {
+		err := base.As(typed)
+		if err != nil {
+			return manifest.Monitor{}, fmt.Errorf("monitor %q: %w", base.Name, err)
+		}
+
+		res.Monitor = typed
+	}
+
+	if base.Parent != nil {
+		res.Parent = s.names[manifest.KindMonitor][*base.Parent]
+	}
+
+	if base.ProxyID != nil {
+		res.Proxy = s.names[manifest.KindProxy][*base.ProxyID]
+	}
+
+	docker, ok := res.Monitor.(*monitor.Docker)
+	if ok {
+		res.DockerHost = s.names[manifest.KindDockerHost][docker.DockerHost]
+	}
+
+	for _, id := range base.NotificationIDs {
+		res.Notifications = append(res.Notifications, s.names[manifest.KindNotification][id])
+	}
+
+	for _, t := range base.Tags {
+		res.Tags = append(res.Tags, manifest.TagRef{Name: t.Name, Value: t.Value})
+	}
+
+	return res, nil
+}
+
+func (s *state) maintenance(
+	ctx context.Context,
+	client *kuma.Client,
+	m maintenance.Maintenance,
+) (manifest.Maintenance, error) {
+	res := manifest.Maintenance{Name: m.Title, Maintenance: m}
+
+	monitorIDs, err := client.GetMonitorMaintenance(ctx, m.ID)
+	if err != nil {
+		return manifest.Maintenance{}, fmt.Errorf("maintenance %q: %w", m.Title, err)
+	}
+
+	for _, id := range monitorIDs {
+		res.Monitors = append(res.Monitors, s.names[manifest.KindMonitor][id])
+	}
+
+	statusPageIDs, err := client.GetMaintenanceStatusPage(ctx, m.ID)
+	if err != nil {
+		return manifest.Maintenance{}, fmt.Errorf("maintenance %q: %w", m.Title, err)
+	}
+
+	for _, id := range statusPageIDs {
+		res.StatusPages = append(res.StatusPages, s.names[manifest.KindStatusPage][id])
+	}
+
+	return res, nil
+}
+
+// lookup returns the name and the ID of the resource of the given kind. ref
+// is either the name or the ID of the resource.
+func (s *state) lookup(kind manifest.Kind, ref string) (string, int64, error) {
+	id, err := s.ids.Get(kind, ref)
+	if err == nil {
+		return ref, id, nil
+	}
+
+	id, parseErr := strconv.ParseInt(ref, 10, 64)
+	if parseErr == nil {
+		name, ok := s.names[kind][id]
+		if ok {
+			return name, id, nil
+		}
+	}
+
+	return "", 0, fmt.Errorf("%s %q not found", kind, ref)
+}
+
+// filter returns a manifest, which only contains the resources of the given
+// kind with the given names. If kind is empty, resources of all kinds are
+// included. If names is empty, all resources of the kind are included.
+func (s *state) filter(kind manifest.Kind, names []string) *manifest.Manifest {
+	keep := func(k manifest.Kind, name string) bool {
+		return (kind == "" || kind == k) && (len(names) == 0 || slices.Contains(names, name))
+	}
+
+	m := &manifest.Manifest{}
+
+	for _, res := range s.manifest.Monitors {
+		if keep(manifest.KindMonitor, res.Name) {
+			m.Monitors = append(m.Monitors, res)
+		}
+	}
+
+	for _, res := range s.manifest.Notifications {
+		if keep(manifest.KindNotification, res.Name) {
+			m.Notifications = append(m.Notifications, res)
+		}
+	}
+
+	for _, res := range s.manifest.StatusPages {
+		if keep(manifest.KindStatusPage, res.Name) {
+			m.StatusPages = append(m.StatusPages, res)
+		}
+	}
+
+	for _, res := range s.manifest.Maintenances {
+		if keep(manifest.KindMaintenance, res.Name) {
+			m.Maintenances = append(m.Maintenances, res)
+		}
+	}
+
+	for _, res := range s.manifest.Tags {
+		if keep(manifest.KindTag, res.Name) {
+			m.Tags = append(m.Tags, res)
+		}
+	}
+
+	for _, res := range s.manifest.Proxies {
+		if keep(manifest.KindProxy, res.Name) {
+			m.Proxies = append(m.Proxies, res)
+		}
+	}
+
+	for _, res := range s.manifest.DockerHosts {
+		if keep(manifest.KindDockerHost, res.Name) {
+			m.DockerHosts = append(m.DockerHosts, res)
+		}
+	}
+
+	return m
+}
+
+// redact returns a copy of the manifest, where all secret values are
+// masked.
+func redact(m *manifest.Manifest) *manifest.Manifest {
+	redacted := *m
+
+	redacted.Monitors = slices.Clone(m.Monitors)
+	for i := range redacted.Monitors {
+		redacted.Monitors[i].Monitor = secret.Redact(redacted.Monitors[i].Monitor)
+	}
+
+	redacted.Notifications = slices.Clone(m.Notifications)
+	for i := range redacted.Notifications {
+		redacted.Notifications[i].Notification = secret.Redact(redacted.Notifications[i].Notification)
+	}
+
+	redacted.Proxies = slices.Clone(m.Proxies)
+	for i := range redacted.Proxies {
+		redacted.Proxies[i].Proxy = secret.Redact(redacted.Proxies[i].Proxy)
+	}
+
+	return &redacted
+}
+
+// proxyName returns the name of a proxy. Proxies do not have a name in
+// Uptime Kuma, they are identified by their address.
+func proxyName(protocol string, host string, port int) string {
+	return protocol + "://" + host + ":" + strconv.Itoa(port)
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/heartbeat"
+	"github.com/breml/go-uptime-kuma-client/internal/ptr"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+func TestTagSelector(t *testing.T) {
+	prod := monitor.Base{Tags: []tag.MonitorTag{{Name: "env", Value: "prod"}, {Name: "on call"}}}
+	staging := monitor.Base{Tags: []tag.MonitorTag{{Name: "env", Value: "staging"}, {Name: "on call"}}}
+
+	tests := []struct {
+		name string
+		tags []string
+
+		wantProd    bool
+		wantStaging bool
+	}{
+		{name: "no tags", wantProd: true, wantStaging: true},
+		{name: "tag with value", tags: []string{"env=prod"}, wantProd: true},
+		{name: "tag without value", tags: []string{"on call"}, wantProd: true, wantStaging: true},
+		{name: "multiple tags", tags: []string{"on call", "env=staging"}, wantStaging: true},
+		{name: "unknown tag", tags: []string{"critical"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, err := monitor.ParseSelector(tagSelector(tc.tags))
+			require.NoError(t, err)
+
+			require.Equal(t, tc.wantProd, selector(prod))
+			require.Equal(t, tc.wantStaging, selector(staging))
+		})
+	}
+}
+
+func TestWriteTop(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tree, err := monitor.NewTree([]monitor.Base{
+		{ID: 1, Name: "production", IsActive: true},
+		{ID: 2, Name: "website", Parent: ptr.To(int64(1)), IsActive: true},
+		{ID: 3, Name: "database", Parent: ptr.To(int64(1)), IsActive: false},
+		{ID: 4, Name: "staging", IsActive: true},
+		{ID: 5, Name: "api", Parent: ptr.To(int64(4)), IsActive: true},
+		{ID: 6, Name: "router", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	stats := map[int64]monitorStats{
+		2: {
+			last: &heartbeat.Heartbeat{
+				MonitorID: 2,
+				Status:    heartbeat.StatusUp,
+				Time:      now.Add(-15 * time.Second),
+				Ping:      ptr.To(42.4),
+			},
+			uptime:    0.99512,
+			hasUptime: true,
+			transitions: []heartbeat.Heartbeat{
+				{Status: heartbeat.StatusUp, Time: now.Add(-5 * time.Minute)},
+				{Status: heartbeat.StatusDown, Time: now.Add(-3 * time.Hour)},
+			},
+		},
+		6: {
+			last: &heartbeat.Heartbeat{MonitorID: 6, Status: heartbeat.StatusDown, Time: now.Add(-time.Minute)},
+		},
+	}
+
+	// The staging group is not shown, since none of its monitors is
+	// selected.
+	selected := map[int64]bool{2: true, 3: true, 6: true}
+
+	rows := topRows(tree, selected, func(id int64) monitorStats { return stats[id] })
+
+	out := &bytes.Buffer{}
+	err = writeTop(out, now, rows)
+	require.NoError(t, err)
+
+	require.Equal(t, ""+
+		"2025-03-01 12:00:00  monitors: 4  up: 1  down: 1  pending: 0  maintenance: 0  paused: 1\n"+
+		"\n"+
+		"NAME         STATUS   LAST CHECK   PING   UPTIME 24H   TRANSITIONS\n"+
+		"production   -        -            -      -            -\n"+
+		"  database   paused   -            -      -            -\n"+
+		"  website    up       15s ago      42ms   99.51%       up 5m ago, down 3h ago\n"+
+		"router       down     1m ago       -      -            -\n", out.String())
+}
+
+func TestAge(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	require.Equal(t, "0s ago", age(now, now.Add(time.Second)))
+	require.Equal(t, "59s ago", age(now, now.Add(-59*time.Second)))
+	require.Equal(t, "10m ago", age(now, now.Add(-10*time.Minute)))
+	require.Equal(t, "47h ago", age(now, now.Add(-47*time.Hour)))
+	require.Equal(t, "3d ago", age(now, now.Add(-72*time.Hour)))
+}
@@ -0,0 +1,93 @@
+package kuma
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetDatabaseSize returns the size of the Uptime Kuma database in bytes.
+func (c *Client) GetDatabaseSize(ctx context.Context) (int64, error) {
+	resp, err := c.syncEmit(ctx, "getDatabaseSize")
+	if err != nil {
+		return 0, fmt.Errorf("get database size: %w", err)
+	}
+
+	return resp.Size, nil
+}
+
+// ShrinkDatabase reclaims the unused space of the database, e.g. with VACUUM
+// for SQLite. Depending on the size of the database, this may take a long
+// time, the deadline of ctx should be chosen accordingly.
+func (c *Client) ShrinkDatabase(ctx context.Context) error {
+	_, err := c.syncEmit(ctx, "shrinkDatabase")
+	if err != nil {
+		return fmt.Errorf("shrink database: %w", err)
+	}
+
+	return nil
+}
+
+// ClearStatistics deletes the heartbeats and the uptime statistics of all
+// monitors. The cached heartbeats of all monitors are dropped.
+func (c *Client) ClearStatistics(ctx context.Context) error {
+	_, err := c.syncEmit(ctx, "clearStatistics")
+	if err != nil {
+		return fmt.Errorf("clear statistics: %w", err)
+	}
+
+	// In dry-run mode, the cache is kept as sent by the server.
+	if c.plan != nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.state.heartbeat = newHeartbeatState()
+
+	return nil
+}
+
+// ClearEvents clears the events of the monitor, that is, the messages of its
+// heartbeats and their important flag, which marks a status change. The
+// cached heartbeats of the monitor are dropped.
+func (c *Client) ClearEvents(ctx context.Context, monitorID int64) error {
+	_, err := c.syncEmit(ctx, "clearEvents", monitorID)
+	if err != nil {
+		return fmt.Errorf("clear events of monitor %d: %w", monitorID, err)
+	}
+
+	// In dry-run mode, the cache is kept as sent by the server.
+	if c.plan != nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.state.heartbeat.heartbeats, monitorID)
+	delete(c.state.heartbeat.importantHeartbeats, monitorID)
+
+	return nil
+}
+
+// ClearHeartbeats deletes the heartbeats of the monitor. The cached
+// heartbeats and statistics of the monitor are dropped.
+func (c *Client) ClearHeartbeats(ctx context.Context, monitorID int64) error {
+	_, err := c.syncEmit(ctx, "clearHeartbeats", monitorID)
+	if err != nil {
+		return fmt.Errorf("clear heartbeats of monitor %d: %w", monitorID, err)
+	}
+
+	// In dry-run mode, the cache is kept as sent by the server.
+	if c.plan != nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.state.heartbeat.delete(monitorID)
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package kuma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/heartbeat"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func TestClient_Database(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 60*time.Second)
+	defer cancel()
+
+	httpMonitor := monitor.HTTP{
+		Base: monitor.Base{
+			Name:          "database-test",
+			Interval:      20,
+			RetryInterval: 20,
+			IsActive:      true,
+		},
+		HTTPDetails: monitor.HTTPDetails{
+			URL:                 "http://localhost:3001",
+			Timeout:             16,
+			Method:              "GET",
+			MaxRedirects:        10,
+			AcceptedStatusCodes: []string{"200-299"},
+			AuthMethod:          monitor.AuthMethodNone,
+		},
+	}
+
+	monitorID, err := client.CreateMonitor(ctx, &httpMonitor)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.DeleteMonitor(ctx, monitorID)
+	}()
+
+	waitForHeartbeat := func(t *testing.T) {
+		t.Helper()
+
+		require.Eventually(t, func() bool {
+			return len(client.GetHeartbeats(ctx, monitorID)) > 0
+		}, 30*time.Second, 100*time.Millisecond)
+	}
+
+	t.Run("get_database_size", func(t *testing.T) {
+		size, err := client.GetDatabaseSize(ctx)
+		require.NoError(t, err)
+		require.Positive(t, size)
+	})
+
+	t.Run("shrink_database", func(t *testing.T) {
+		err := client.ShrinkDatabase(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("clear_events", func(t *testing.T) {
+		waitForHeartbeat(t)
+
+		err := client.ClearEvents(ctx, monitorID)
+		require.NoError(t, err)
+		require.Empty(t, client.GetHeartbeats(ctx, monitorID))
+		require.Empty(t, client.GetImportantHeartbeats(ctx, monitorID))
+	})
+
+	t.Run("clear_heartbeats", func(t *testing.T) {
+		err := client.PauseMonitor(ctx, monitorID)
+		require.NoError(t, err)
+
+		err = client.ResumeMonitor(ctx, monitorID)
+		require.NoError(t, err)
+
+		waitForHeartbeat(t)
+
+		err = client.ClearHeartbeats(ctx, monitorID)
+		require.NoError(t, err)
+		require.Empty(t, client.GetHeartbeats(ctx, monitorID))
+	})
+
+	t.Run("clear_statistics", func(t *testing.T) {
+		err := client.ClearStatistics(ctx)
+		require.NoError(t, err)
+		require.Empty(t, client.GetHeartbeats(ctx, monitorID))
+
+		_, ok := client.GetUptime(ctx, monitorID, heartbeat.Period24h)
+		require.False(t, ok)
+	})
+}
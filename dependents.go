@@ -0,0 +1,356 @@
+package kuma
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+// ErrInUse is returned by deletes with WithDeleteRefuse, if the resource is
+// still referenced by monitors.
+var ErrInUse = errors.New("in use")
+
+// ResourceKind is the kind of a resource, e.g. of a resource referenced by
+// monitors, see Dependents, or of a resource changed by a mutation, see
+// AuditRecord.
+type ResourceKind string
+
+// Resource kinds, which can be referenced by monitors.
+const (
+	// ResourceNotification is referenced by the NotificationIDs of monitors.
+	ResourceNotification ResourceKind = "notification"
+
+	// ResourceProxy is referenced by the ProxyID of monitors.
+	ResourceProxy ResourceKind = "proxy"
+
+	// ResourceDockerHost is referenced by the DockerHost of docker monitors.
+	ResourceDockerHost ResourceKind = "docker host"
+
+	// ResourceTag is referenced by the Tags of monitors.
+	ResourceTag ResourceKind = "tag"
+)
+
+// Resource kinds, which are not referenced by monitors.
+const (
+	// ResourceMonitor is a monitor.
+	ResourceMonitor ResourceKind = "monitor"
+
+	// ResourceMaintenance is a maintenance window.
+	ResourceMaintenance ResourceKind = "maintenance"
+
+	// ResourceStatusPage is a status page, which is identified by its slug.
+	ResourceStatusPage ResourceKind = "status page"
+
+	// ResourceRemoteBrowser is a remote browser.
+	ResourceRemoteBrowser ResourceKind = "remote browser"
+
+	// ResourceSettings are the settings of the server.
+	ResourceSettings ResourceKind = "settings"
+)
+
+// Dependents returns the monitors, which reference the resource of the given
+// kind and ID. The dependents are computed from the cached state.
+func (c *Client) Dependents(_ context.Context, kind ResourceKind, id int64) ([]monitor.Base, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var dependents []monitor.Base
+
+	for _, mon := range c.monitorsLocked() {
+		references, err := referencesResource(mon, kind, id)
+		if err != nil {
+			return nil, fmt.Errorf("dependents of %s %d: %w", kind, id, err)
+		}
+
+		if references {
+			dependents = append(dependents, mon)
+		}
+	}
+
+	slices.SortFunc(dependents, func(a monitor.Base, b monitor.Base) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return dependents, nil
+}
+
+// referencesResource reports, whether the monitor references the resource of
+// the given kind and ID.
+func referencesResource(mon monitor.Base, kind ResourceKind, id int64) (bool, error) {
+	switch kind {
+	case ResourceNotification:
+		return slices.Contains(mon.NotificationIDs, id), nil
+
+	case ResourceProxy:
+		return mon.ProxyID != nil && *mon.ProxyID == id, nil
+
+	case ResourceDockerHost:
+		if mon.Type() != "docker" {
+			return false, nil
+		}
+
+		docker := monitor.Docker{}
+
+		err := mon.As(&docker)
+		if err != nil {
+			return false, err
+		}
+
+		return docker.DockerHost == id, nil
+
+	case ResourceTag:
+		return slices.ContainsFunc(mon.Tags, func(t tag.MonitorTag) bool {
+			return t.TagID == id
+		}), nil
+
+	default:
+		return false, fmt.Errorf("unsupported resource kind %q", kind)
+	}
+}
+
+// DeleteOption is a functional option for DeleteNotification, DeleteProxy,
+// DeleteDockerHost and DeleteTag, which controls, how monitors referencing
+// the deleted resource are handled. Without option, the resource is deleted
+// regardless of its dependents, see Dependents.
+type DeleteOption func(d *deleteOptions)
+
+type deletePolicy int
+
+const (
+	deleteForce deletePolicy = iota
+	deleteRefuse
+	deleteCascade
+	deleteReassign
+)
+
+type deleteOptions struct {
+	policy     deletePolicy
+	reassignTo int64
+}
+
+// WithDeleteRefuse refuses to delete a resource, which is still referenced
+// by monitors. The returned error wraps ErrInUse.
+func WithDeleteRefuse() DeleteOption {
+	return func(d *deleteOptions) {
+		d.policy = deleteRefuse
+	}
+}
+
+// WithDeleteCascade removes the references of the monitors to the resource,
+// before the resource is deleted: the notification is removed from the
+// NotificationIDs, the tag is removed from the monitor and the ProxyID is
+// cleared. The monitors themselves are retained. Only for docker hosts, the
+// referencing docker monitors are deleted, because a docker monitor can not
+// exist without docker host.
+func WithDeleteCascade() DeleteOption {
+	return func(d *deleteOptions) {
+		d.policy = deleteCascade
+	}
+}
+
+// WithDeleteReassign updates the monitors, which reference the resource, to
+// reference the resource with the given ID instead, before the resource is
+// deleted. For tags, the value of the tag is retained.
+func WithDeleteReassign(id int64) DeleteOption {
+	return func(d *deleteOptions) {
+		d.policy = deleteReassign
+		d.reassignTo = id
+	}
+}
+
+// handleDependents handles the monitors, which reference the resource to be
+// deleted, according to the delete options.
+func (c *Client) handleDependents(ctx context.Context, kind ResourceKind, id int64, opts []DeleteOption) error {
+	d := deleteOptions{}
+	for _, opt := range opts {
+		opt(&d)
+	}
+
+	if d.policy == deleteForce {
+		return nil
+	}
+
+	dependents, err := c.Dependents(ctx, kind, id)
+	if err != nil {
+		return err
+	}
+
+	switch d.policy {
+	case deleteRefuse:
+		if len(dependents) > 0 {
+			ids := make([]int64, 0, len(dependents))
+			for _, mon := range dependents {
+				ids = append(ids, mon.ID)
+			}
+
+			return fmt.Errorf("%w: referenced by monitors %v", ErrInUse, ids)
+		}
+
+	case deleteCascade:
+		for _, mon := range dependents {
+			err = c.cascade(ctx, kind, mon, id)
+			if err != nil {
+				return fmt.Errorf("cascade to monitor %d: %w", mon.ID, err)
+			}
+		}
+
+	case deleteReassign:
+		if d.reassignTo == id {
+			return fmt.Errorf("reassign to %s %d: same %s", kind, d.reassignTo, kind)
+		}
+
+		err = c.checkExists(ctx, kind, d.reassignTo)
+		if err != nil {
+			return fmt.Errorf("reassign to %s %d: %w", kind, d.reassignTo, err)
+		}
+
+		for _, mon := range dependents {
+			err = c.reassign(ctx, kind, mon, id, d.reassignTo)
+			if err != nil {
+				return fmt.Errorf("reassign monitor %d: %w", mon.ID, err)
+			}
+		}
+
+	default:
+	}
+
+	return nil
+}
+
+// checkExists returns ErrNotFound, if the resource does not exist.
+func (c *Client) checkExists(ctx context.Context, kind ResourceKind, id int64) error {
+	var err error
+
+	switch kind {
+	case ResourceNotification:
+		_, err = c.GetNotification(ctx, id)
+
+	case ResourceProxy:
+		_, err = c.GetProxy(ctx, id)
+
+	case ResourceDockerHost:
+		_, err = c.GetDockerHost(ctx, id)
+
+	case ResourceTag:
+		_, err = c.GetTag(ctx, id)
+
+	default:
+		err = fmt.Errorf("unsupported resource kind %q", kind)
+	}
+
+	return err
+}
+
+// cascade removes the reference of the monitor to the resource with the given
+// ID. Docker monitors are deleted together with their docker host.
+func (c *Client) cascade(ctx context.Context, kind ResourceKind, mon monitor.Base, id int64) error {
+	switch kind {
+	case ResourceDockerHost:
+		return c.DeleteMonitor(ctx, mon.ID)
+
+	case ResourceTag:
+		for _, t := range mon.Tags {
+			if t.TagID != id {
+				continue
+			}
+
+			err := c.DeleteMonitorTagWithValue(ctx, id, mon.ID, t.Value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	default:
+	}
+
+	// Retrieve the full monitor, the monitor list does not contain all
+	// fields required by the server for an update.
+	full, err := c.GetMonitor(ctx, mon.ID)
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case ResourceNotification:
+		full.NotificationIDs = slices.DeleteFunc(full.NotificationIDs, func(notificationID int64) bool {
+			return notificationID == id
+		})
+
+	case ResourceProxy:
+		full.ProxyID = nil
+
+	default:
+	}
+
+	return c.UpdateMonitor(ctx, &full)
+}
+
+// reassign updates the monitor to reference the resource with the ID to
+// instead of the resource with the ID from.
+func (c *Client) reassign(ctx context.Context, kind ResourceKind, mon monitor.Base, from int64, to int64) error {
+	switch kind {
+	case ResourceTag:
+		for _, t := range mon.Tags {
+			if t.TagID != from {
+				continue
+			}
+
+			exists := slices.ContainsFunc(mon.Tags, func(other tag.MonitorTag) bool {
+				return other.TagID == to && other.Value == t.Value
+			})
+			if exists {
+				continue
+			}
+
+			_, err := c.AddMonitorTag(ctx, to, mon.ID, t.Value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case ResourceDockerHost:
+		docker := monitor.Docker{}
+
+		err := c.GetMonitorAs(ctx, mon.ID, &docker)
+		if err != nil {
+			return err
+		}
+
+		docker.DockerHost = to
+
+		return c.UpdateMonitor(ctx, &docker)
+
+	default:
+	}
+
+	// Retrieve the full monitor, the monitor list does not contain all
+	// fields required by the server for an update.
+	full, err := c.GetMonitor(ctx, mon.ID)
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case ResourceNotification:
+		full.NotificationIDs = slices.DeleteFunc(full.NotificationIDs, func(id int64) bool {
+			return id == from || id == to
+		})
+		full.NotificationIDs = append(full.NotificationIDs, to)
+
+	case ResourceProxy:
+		full.ProxyID = &to
+
+	default:
+	}
+
+	return c.UpdateMonitor(ctx, &full)
+}
@@ -0,0 +1,195 @@
+package kuma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/dockerhost"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/notification"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+func TestDependents(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 60*time.Second)
+	defer cancel()
+
+	hostID, err := client.CreateDockerHost(ctx, dockerhost.Config{
+		Name:         "Dependents Docker",
+		DockerDaemon: "unix:///var/run/docker.sock",
+		DockerType:   "socket",
+	})
+	require.NoError(t, err)
+
+	otherHostID, err := client.CreateDockerHost(ctx, dockerhost.Config{
+		Name:         "Dependents Docker Other",
+		DockerDaemon: "tcp://192.168.1.100:2375",
+		DockerType:   "tcp",
+	})
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.DeleteDockerHost(ctx, otherHostID)
+	}()
+
+	notificationID, err := client.CreateNotification(ctx, notification.Webhook{
+		Base: notification.Base{
+			IsActive: true,
+			Name:     "Dependents Webhook",
+		},
+		WebhookDetails: notification.WebhookDetails{
+			WebhookURL:         "https://example.com/webhook",
+			HTTPMethod:         "post",
+			WebhookContentType: "json",
+		},
+	})
+	require.NoError(t, err)
+
+	otherNotificationID, err := client.CreateNotification(ctx, notification.Webhook{
+		Base: notification.Base{
+			IsActive: true,
+			Name:     "Dependents Webhook Other",
+		},
+		WebhookDetails: notification.WebhookDetails{
+			WebhookURL:         "https://example.com/other",
+			HTTPMethod:         "post",
+			WebhookContentType: "json",
+		},
+	})
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.DeleteNotification(ctx, otherNotificationID)
+	}()
+
+	tagID, err := client.CreateTag(ctx, tag.Tag{Name: "dependents", Color: "#ff0000"})
+	require.NoError(t, err)
+
+	otherTagID, err := client.CreateTag(ctx, tag.Tag{Name: "dependents-other", Color: "#00ff00"})
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.DeleteTag(ctx, otherTagID)
+	}()
+
+	monitorID, err := client.CreateMonitor(ctx, &monitor.Docker{
+		Base: monitor.Base{
+			Name:            "Dependents Docker Monitor",
+			Interval:        60,
+			RetryInterval:   60,
+			NotificationIDs: []int64{notificationID},
+			IsActive:        false,
+		},
+		DockerDetails: monitor.DockerDetails{
+			DockerHost:      hostID,
+			DockerContainer: "my-container",
+		},
+	})
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.DeleteMonitor(ctx, monitorID)
+	}()
+
+	_, err = client.AddMonitorTag(ctx, tagID, monitorID, "prod")
+	require.NoError(t, err)
+
+	dependentIDs := func(t *testing.T, kind kuma.ResourceKind, id int64) []int64 {
+		t.Helper()
+
+		dependents, err := client.Dependents(ctx, kind, id)
+		require.NoError(t, err)
+
+		ids := make([]int64, 0, len(dependents))
+		for _, mon := range dependents {
+			ids = append(ids, mon.ID)
+		}
+
+		return ids
+	}
+
+	t.Run("dependents", func(t *testing.T) {
+		require.Equal(t, []int64{monitorID}, dependentIDs(t, kuma.ResourceDockerHost, hostID))
+		require.Equal(t, []int64{monitorID}, dependentIDs(t, kuma.ResourceNotification, notificationID))
+		require.Equal(t, []int64{monitorID}, dependentIDs(t, kuma.ResourceTag, tagID))
+		require.Empty(t, dependentIDs(t, kuma.ResourceDockerHost, otherHostID))
+	})
+
+	t.Run("refuse", func(t *testing.T) {
+		err := client.DeleteDockerHost(ctx, hostID, kuma.WithDeleteRefuse())
+		require.ErrorIs(t, err, kuma.ErrInUse)
+
+		_, err = client.GetDockerHost(ctx, hostID)
+		require.NoError(t, err)
+	})
+
+	t.Run("reassign_docker_host", func(t *testing.T) {
+		err := client.DeleteDockerHost(ctx, hostID, kuma.WithDeleteReassign(otherHostID))
+		require.NoError(t, err)
+
+		var docker monitor.Docker
+		err = client.GetMonitorAs(ctx, monitorID, &docker)
+		require.NoError(t, err)
+		require.Equal(t, otherHostID, docker.DockerHost)
+	})
+
+	t.Run("reassign_notification", func(t *testing.T) {
+		err := client.DeleteNotification(ctx, notificationID, kuma.WithDeleteReassign(otherNotificationID))
+		require.NoError(t, err)
+
+		mon, err := client.GetMonitor(ctx, monitorID)
+		require.NoError(t, err)
+		require.Equal(t, []int64{otherNotificationID}, mon.NotificationIDs)
+	})
+
+	t.Run("reassign_tag", func(t *testing.T) {
+		err := client.DeleteTag(ctx, tagID, kuma.WithDeleteReassign(otherTagID))
+		require.NoError(t, err)
+
+		tags, err := client.GetMonitorTags(ctx, monitorID)
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		require.Equal(t, otherTagID, tags[0].TagID)
+		require.Equal(t, "prod", tags[0].Value)
+	})
+
+	t.Run("reassign_to_missing", func(t *testing.T) {
+		err := client.DeleteDockerHost(ctx, otherHostID, kuma.WithDeleteReassign(999999))
+		require.ErrorIs(t, err, kuma.ErrNotFound)
+	})
+
+	t.Run("cascade_notification", func(t *testing.T) {
+		err := client.DeleteNotification(ctx, otherNotificationID, kuma.WithDeleteCascade())
+		require.NoError(t, err)
+
+		mon, err := client.GetMonitor(ctx, monitorID)
+		require.NoError(t, err)
+		require.Empty(t, mon.NotificationIDs)
+	})
+
+	t.Run("cascade_tag", func(t *testing.T) {
+		err := client.DeleteTag(ctx, otherTagID, kuma.WithDeleteCascade())
+		require.NoError(t, err)
+
+		mon, err := client.GetMonitor(ctx, monitorID)
+		require.NoError(t, err)
+		require.Empty(t, mon.Tags)
+	})
+
+	t.Run("cascade", func(t *testing.T) {
+		err := client.DeleteDockerHost(ctx, otherHostID, kuma.WithDeleteCascade())
+		require.NoError(t, err)
+
+		_, err = client.GetMonitor(ctx, monitorID)
+		require.Error(t, err)
+		require.Empty(t, dependentIDs(t, kuma.ResourceTag, otherTagID))
+	})
+}
@@ -0,0 +1,155 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Client is a minimal client for the Docker Engine API, which lists the
+// containers of a Docker daemon.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// NewClient returns a client for the Docker daemon at the given address.
+// The address has the same format as the DockerDaemon of a
+// dockerhost.DockerHost, e.g. "unix:///var/run/docker.sock" or
+// "tcp://host:2375". The schemes http and https are accepted as well.
+func NewClient(daemon string) (*Client, error) {
+	u, err := url.Parse(daemon)
+	if err != nil {
+		return nil, fmt.Errorf("parse docker daemon %q: %w", daemon, err)
+	}
+
+	c := &Client{
+		httpClient: &http.Client{},
+	}
+
+	switch u.Scheme {
+	case "unix":
+		if u.Path == "" {
+			return nil, fmt.Errorf("docker daemon %q: missing socket path", daemon)
+		}
+
+		socket := u.Path
+		dialer := &net.Dialer{Timeout: 30 * time.Second}
+		c.httpClient.Transport = &http.Transport{
+			DialContext: func(ctx context.Context, _ string, _ string) (net.Conn, error) {
+				return dialer.DialContext(ctx, "unix", socket)
+			},
+		}
+
+		// The host is not used for unix sockets, but required for valid
+		// request URLs.
+		c.baseURL = "http://docker"
+
+	case "tcp", "http":
+		if u.Host == "" {
+			return nil, fmt.Errorf("docker daemon %q: missing host", daemon)
+		}
+
+		c.baseURL = "http://" + u.Host
+
+	case "https":
+		if u.Host == "" {
+			return nil, fmt.Errorf("docker daemon %q: missing host", daemon)
+		}
+
+		c.baseURL = "https://" + u.Host
+
+	default:
+		return nil, fmt.Errorf("docker daemon %q: unsupported scheme %q", daemon, u.Scheme)
+	}
+
+	return c, nil
+}
+
+// Container is a container as returned by the container list of the Docker
+// Engine API.
+type Container struct {
+	ID     string            `json:"Id"`
+	Names  []string          `json:"Names"`
+	Image  string            `json:"Image"`
+	State  string            `json:"State"`
+	Labels map[string]string `json:"Labels"`
+}
+
+// Name returns the name of the container without the leading slash.
+func (c Container) Name() string {
+	if len(c.Names) == 0 {
+		return c.ID
+	}
+
+	return strings.TrimPrefix(c.Names[0], "/")
+}
+
+// Containers returns all containers, which have the label LabelType set,
+// including stopped containers.
+func (c *Client) Containers(ctx context.Context) ([]Container, error) {
+	filters, err := json.Marshal(map[string][]string{"label": {LabelType}})
+	if err != nil {
+		return nil, fmt.Errorf("list containers: %w", err)
+	}
+
+	query := url.Values{
+		"all":     {"true"},
+		"filters": {string(filters)},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/containers/json?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("list containers: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("list containers: %w", err)
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("list containers: %w", responseError(resp))
+	}
+
+	var containers []Container
+
+	err = json.NewDecoder(resp.Body).Decode(&containers)
+	if err != nil {
+		return nil, fmt.Errorf("list containers: decode response: %w", err)
+	}
+
+	return containers, nil
+}
+
+// responseError returns the error message of a failed Docker Engine API
+// request.
+func responseError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+
+	var msg struct {
+		Message string `json:"message"`
+	}
+
+	err := json.Unmarshal(body, &msg)
+	if err == nil && msg.Message != "" {
+		return fmt.Errorf("%s: %s", resp.Status, msg.Message)
+	}
+
+	if len(body) > 0 {
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return errors.New(resp.Status)
+}
@@ -0,0 +1,168 @@
+package docker_test
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/discovery/docker"
+)
+
+// stubDocker is a stub of the Docker Engine API, which serves the container
+// list over a unix socket.
+type stubDocker struct {
+	mu         sync.Mutex
+	containers []docker.Container
+	status     int
+
+	daemon string
+}
+
+func newStubDocker(t *testing.T, containers ...docker.Container) *stubDocker {
+	t.Helper()
+
+	// The path of unix sockets is limited to about 100 characters, which
+	// is exceeded by t.TempDir for long test names.
+	dir, err := os.MkdirTemp("", "docker")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	socket := filepath.Join(dir, "docker.sock")
+
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	stub := &stubDocker{
+		containers: containers,
+		status:     http.StatusOK,
+		daemon:     "unix://" + socket,
+	}
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(stub.serveHTTP))
+	server.Listener = listener
+	server.Start()
+	t.Cleanup(server.Close)
+
+	return stub
+}
+
+func (s *stubDocker) setContainers(containers ...docker.Container) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.containers = containers
+}
+
+func (s *stubDocker) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Method != http.MethodGet || r.URL.Path != "/containers/json" {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"page not found"}`))
+
+		return
+	}
+
+	if s.status != http.StatusOK {
+		w.WriteHeader(s.status)
+		_, _ = w.Write([]byte(`{"message":"server error"}`))
+
+		return
+	}
+
+	var filters map[string][]string
+
+	err := json.Unmarshal([]byte(r.URL.Query().Get("filters")), &filters)
+	if err != nil || len(filters["label"]) != 1 || r.URL.Query().Get("all") != "true" {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"unexpected query"}`))
+
+		return
+	}
+
+	containers := []docker.Container{}
+	for _, c := range s.containers {
+		if _, ok := c.Labels[filters["label"][0]]; ok {
+			containers = append(containers, c)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(containers)
+}
+
+func TestNewClient(t *testing.T) {
+	tests := []struct {
+		name    string
+		daemon  string
+		wantErr bool
+	}{
+		{name: "unix socket", daemon: "unix:///var/run/docker.sock"},
+		{name: "tcp", daemon: "tcp://192.168.1.100:2375"},
+		{name: "https", daemon: "https://docker.example.com:2376"},
+		{name: "unix socket without path", daemon: "unix://", wantErr: true},
+		{name: "tcp without host", daemon: "tcp://", wantErr: true},
+		{name: "unsupported scheme", daemon: "npipe:////./pipe/docker_engine", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := docker.NewClient(tc.daemon)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClient_Containers(t *testing.T) {
+	stub := newStubDocker(t,
+		docker.Container{
+			ID:     "abc",
+			Names:  []string{"/web"},
+			State:  "running",
+			Labels: map[string]string{docker.LabelType: "http", docker.LabelURL: "http://web:8080"},
+		},
+		docker.Container{
+			ID:     "def",
+			Names:  []string{"/db"},
+			State:  "running",
+			Labels: map[string]string{"com.example": "db"},
+		},
+	)
+
+	client, err := docker.NewClient(stub.daemon)
+	require.NoError(t, err)
+
+	containers, err := client.Containers(t.Context())
+	require.NoError(t, err)
+	require.Len(t, containers, 1)
+	require.Equal(t, "abc", containers[0].ID)
+	require.Equal(t, "web", containers[0].Name())
+	require.Equal(t, "http://web:8080", containers[0].Labels[docker.LabelURL])
+}
+
+func TestClient_Containers_Error(t *testing.T) {
+	stub := newStubDocker(t)
+	stub.status = http.StatusInternalServerError
+
+	client, err := docker.NewClient(stub.daemon)
+	require.NoError(t, err)
+
+	_, err = client.Containers(t.Context())
+	require.ErrorContains(t, err, "500 Internal Server Error: server error")
+}
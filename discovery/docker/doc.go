@@ -0,0 +1,36 @@
+// Package docker discovers monitors from the labels of Docker containers.
+//
+// The Syncer reads the containers of a Docker daemon through the Docker
+// Engine API and keeps a monitor for every container with the label
+// kuma.monitor.type in sync. Monitors are created for new containers,
+// updated, if the labels change, and deleted, if the container is removed.
+//
+// Supported Labels:
+//   - kuma.monitor.type: the monitor type, "http" or "docker"
+//   - kuma.monitor.url: the URL of a http monitor
+//   - kuma.monitor.name: the name of the monitor, by default the container name
+//   - kuma.monitor.interval: the check interval, e.g. "30" or "1m"
+//   - kuma.tags: the tags of the monitor, e.g. "env=prod,team=web,public"
+//
+// The managed monitors are marked with an owner tag, whose value is the
+// name of the container. Monitors without the owner tag are never modified.
+//
+// Example usage:
+//
+//	host, err := client.GetDockerHost(ctx, dockerHostID)
+//	if err != nil {
+//	    return err
+//	}
+//
+//	dockerClient, err := docker.NewClient(host.DockerDaemon)
+//	if err != nil {
+//	    return err
+//	}
+//
+//	syncer := docker.NewSyncer(dockerClient, client, docker.Config{DockerHostID: host.ID})
+//	err = syncer.Run(ctx, time.Minute, func(result docker.Result, err error) {
+//	    if err != nil {
+//	        log.Println(err)
+//	    }
+//	})
+package docker
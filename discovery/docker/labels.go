@@ -0,0 +1,159 @@
+package docker
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Container labels, which control the discovered monitors.
+const (
+	// LabelType is the type of the monitor, either "http" or "docker".
+	// Containers without this label are ignored.
+	LabelType = "kuma.monitor.type"
+
+	// LabelURL is the URL of a http monitor.
+	LabelURL = "kuma.monitor.url"
+
+	// LabelName is the name of the monitor, by default the container name.
+	LabelName = "kuma.monitor.name"
+
+	// LabelInterval is the check interval of the monitor, either in seconds
+	// or as Go duration, e.g. "30" or "1m".
+	LabelInterval = "kuma.monitor.interval"
+
+	// LabelTags is a comma separated list of tags of the monitor, where each
+	// tag is a name with an optional value, e.g. "env=prod,team=web,public".
+	LabelTags = "kuma.tags"
+)
+
+// Monitor types, which are supported in LabelType.
+const (
+	TypeHTTP   = "http"
+	TypeDocker = "docker"
+)
+
+// Target is the monitor requested by the labels of a container.
+type Target struct {
+	// Container is the name of the container.
+	Container string
+
+	// Type is the monitor type, either TypeHTTP or TypeDocker.
+	Type string
+
+	// Name is the name of the monitor.
+	Name string
+
+	// URL is the URL of a http monitor.
+	URL string
+
+	// Interval is the check interval in seconds. Zero, if the label is not
+	// set.
+	Interval int64
+
+	// Tags are the tags of the monitor.
+	Tags []Tag
+}
+
+// Tag is a tag with an optional value, which is assigned to a monitor.
+type Tag struct {
+	Name  string
+	Value string
+}
+
+// ParseTarget returns the target requested by the labels of the container.
+// It returns false, if the container does not have the label LabelType.
+func ParseTarget(c Container) (Target, bool, error) {
+	typ, ok := c.Labels[LabelType]
+	if !ok {
+		return Target{}, false, nil
+	}
+
+	t := Target{
+		Container: c.Name(),
+		Type:      strings.ToLower(strings.TrimSpace(typ)),
+		Name:      strings.TrimSpace(c.Labels[LabelName]),
+		URL:       strings.TrimSpace(c.Labels[LabelURL]),
+	}
+
+	if t.Name == "" {
+		t.Name = t.Container
+	}
+
+	switch t.Type {
+	case TypeHTTP:
+		if t.URL == "" {
+			return Target{}, true, fmt.Errorf("container %s: label %s is required for type %s", t.Container, LabelURL, TypeHTTP)
+		}
+
+	case TypeDocker:
+		t.URL = ""
+
+	default:
+		return Target{}, true, fmt.Errorf("container %s: unsupported monitor type %q in label %s", t.Container, typ, LabelType)
+	}
+
+	if value, ok := c.Labels[LabelInterval]; ok {
+		interval, err := parseInterval(value)
+		if err != nil {
+			return Target{}, true, fmt.Errorf("container %s: label %s: %w", t.Container, LabelInterval, err)
+		}
+
+		t.Interval = interval
+	}
+
+	tags, err := parseTags(c.Labels[LabelTags])
+	if err != nil {
+		return Target{}, true, fmt.Errorf("container %s: label %s: %w", t.Container, LabelTags, err)
+	}
+
+	t.Tags = tags
+
+	return t, true, nil
+}
+
+// parseInterval parses an interval in seconds or as Go duration.
+func parseInterval(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		d, durationErr := time.ParseDuration(value)
+		if durationErr != nil {
+			return 0, fmt.Errorf("invalid interval %q", value)
+		}
+
+		seconds = int64(d / time.Second)
+	}
+
+	if seconds <= 0 {
+		return 0, fmt.Errorf("interval %q must be positive", value)
+	}
+
+	return seconds, nil
+}
+
+// parseTags parses a comma separated list of tags with optional values.
+func parseTags(value string) ([]Tag, error) {
+	var tags []Tag
+
+	for item := range strings.SplitSeq(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		name, tagValue, _ := strings.Cut(item, "=")
+
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, errors.New("tag without name")
+		}
+
+		tags = append(tags, Tag{Name: name, Value: strings.TrimSpace(tagValue)})
+	}
+
+	return tags, nil
+}
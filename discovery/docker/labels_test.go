@@ -0,0 +1,109 @@
+package docker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/discovery/docker"
+)
+
+func TestParseTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		want    docker.Target
+		wantOK  bool
+		wantErr string
+	}{
+		{
+			name:   "no labels",
+			labels: nil,
+		},
+		{
+			name: "http",
+			labels: map[string]string{
+				docker.LabelType:     "HTTP",
+				docker.LabelURL:      "https://web.example.com/health",
+				docker.LabelInterval: "1m",
+				docker.LabelTags:     "env=prod, team=web,, public",
+			},
+			want: docker.Target{
+				Container: "web",
+				Type:      docker.TypeHTTP,
+				Name:      "web",
+				URL:       "https://web.example.com/health",
+				Interval:  60,
+				Tags: []docker.Tag{
+					{Name: "env", Value: "prod"},
+					{Name: "team", Value: "web"},
+					{Name: "public"},
+				},
+			},
+			wantOK: true,
+		},
+		{
+			name: "docker with name",
+			labels: map[string]string{
+				docker.LabelType:     "docker",
+				docker.LabelName:     "Web Container",
+				docker.LabelURL:      "ignored",
+				docker.LabelInterval: "30",
+			},
+			want: docker.Target{
+				Container: "web",
+				Type:      docker.TypeDocker,
+				Name:      "Web Container",
+				Interval:  30,
+			},
+			wantOK: true,
+		},
+		{
+			name:    "http without url",
+			labels:  map[string]string{docker.LabelType: "http"},
+			wantOK:  true,
+			wantErr: "label kuma.monitor.url is required",
+		},
+		{
+			name:    "unsupported type",
+			labels:  map[string]string{docker.LabelType: "ping"},
+			wantOK:  true,
+			wantErr: `unsupported monitor type "ping"`,
+		},
+		{
+			name:    "invalid interval",
+			labels:  map[string]string{docker.LabelType: "docker", docker.LabelInterval: "often"},
+			wantOK:  true,
+			wantErr: `invalid interval "often"`,
+		},
+		{
+			name:    "negative interval",
+			labels:  map[string]string{docker.LabelType: "docker", docker.LabelInterval: "-5"},
+			wantOK:  true,
+			wantErr: "must be positive",
+		},
+		{
+			name:    "tag without name",
+			labels:  map[string]string{docker.LabelType: "docker", docker.LabelTags: "env=prod,=web"},
+			wantOK:  true,
+			wantErr: "tag without name",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := docker.Container{ID: "abc", Names: []string{"/web"}, Labels: tc.labels}
+
+			got, ok, err := docker.ParseTarget(c)
+			require.Equal(t, tc.wantOK, ok)
+
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
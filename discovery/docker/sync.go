@@ -0,0 +1,482 @@
+package docker
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+// Defaults of the discovered monitors, which follow the defaults of the
+// Uptime Kuma web interface.
+const (
+	defaultInterval     = 60
+	defaultHTTPTimeout  = 48
+	defaultMaxRedirects = 10
+	defaultStatusCodes  = "200-299"
+)
+
+// Defaults of the syncer configuration.
+const (
+	DefaultOwnerTag = "docker-discovery"
+	DefaultTagColor = "#2563EB"
+)
+
+var errNoTagID = errors.New("no tag ID in response")
+
+// KumaClient is the subset of the methods of kuma.Client, which is used by
+// the syncer.
+type KumaClient interface {
+	GetMonitors(ctx context.Context) ([]monitor.Base, error)
+	CreateMonitor(ctx context.Context, mon monitor.Monitor) (int64, error)
+	UpdateMonitor(ctx context.Context, mon monitor.Monitor) error
+	DeleteMonitor(ctx context.Context, monitorID int64) error
+	GetTags(ctx context.Context) ([]tag.Tag, error)
+	CreateTag(ctx context.Context, t tag.Tag) (int64, error)
+	AddMonitorTag(ctx context.Context, tagID int64, monitorID int64, value string) (*tag.MonitorTag, error)
+	DeleteMonitorTagWithValue(ctx context.Context, tagID int64, monitorID int64, value string) error
+}
+
+// Config is the configuration of a Syncer.
+type Config struct {
+	// DockerHostID is the ID of the Docker host in Uptime Kuma, which
+	// refers to the same Docker daemon as the Docker client. It is required
+	// for monitors of type docker.
+	DockerHostID int64
+
+	// OwnerTag is the name of the tag, which marks the monitors managed by
+	// the syncer. The value of the tag is the name of the container.
+	// Syncers for different Docker daemons must use different owner tags.
+	// Defaults to DefaultOwnerTag.
+	OwnerTag string
+
+	// TagColor is the color of the tags created by the syncer. Defaults to
+	// DefaultTagColor.
+	TagColor string
+}
+
+// Syncer keeps the monitors in Uptime Kuma in sync with the labels of the
+// containers of a Docker daemon.
+type Syncer struct {
+	docker *Client
+	kuma   KumaClient
+	config Config
+}
+
+// NewSyncer returns a syncer, which reads the containers with docker and
+// manages the monitors with kuma.
+func NewSyncer(docker *Client, kuma KumaClient, config Config) *Syncer {
+	if config.OwnerTag == "" {
+		config.OwnerTag = DefaultOwnerTag
+	}
+
+	if config.TagColor == "" {
+		config.TagColor = DefaultTagColor
+	}
+
+	return &Syncer{
+		docker: docker,
+		kuma:   kuma,
+		config: config,
+	}
+}
+
+// Result is the result of a sync.
+type Result struct {
+	// Created, Updated and Deleted are the names of the containers, whose
+	// monitors have been created, updated or deleted.
+	Created []string
+	Updated []string
+	Deleted []string
+
+	// Errors are the errors of the containers with invalid labels. The
+	// monitors of these containers are left untouched.
+	Errors []error
+}
+
+// Sync creates a monitor for every container with the label LabelType,
+// updates the managed monitors, whose labels have changed, and deletes the
+// managed monitors, whose containers are gone.
+//
+// The tags of the managed monitors are controlled by the label LabelTags,
+// tags added in Uptime Kuma are removed on the next sync. Missing tags are
+// created. All other settings of the managed monitors, which are not
+// controlled by labels, are retained.
+func (s *Syncer) Sync(ctx context.Context) (Result, error) {
+	var result Result
+
+	containers, err := s.docker.Containers(ctx)
+	if err != nil {
+		return result, fmt.Errorf("sync: %w", err)
+	}
+
+	targets := map[string]Target{}
+	invalid := map[string]bool{}
+
+	for _, c := range containers {
+		t, ok, err := ParseTarget(c)
+		if !ok {
+			continue
+		}
+
+		if err == nil && t.Type == TypeDocker && s.config.DockerHostID == 0 {
+			err = fmt.Errorf("container %s: type %s requires a docker host ID", t.Container, TypeDocker)
+		}
+
+		if err != nil {
+			result.Errors = append(result.Errors, err)
+			invalid[c.Name()] = true
+
+			continue
+		}
+
+		targets[t.Container] = t
+	}
+
+	monitors, err := s.kuma.GetMonitors(ctx)
+	if err != nil {
+		return result, fmt.Errorf("sync: %w", err)
+	}
+
+	tags, err := s.kuma.GetTags(ctx)
+	if err != nil {
+		return result, fmt.Errorf("sync: %w", err)
+	}
+
+	tagIDs := make(map[string]int64, len(tags))
+	for _, t := range tags {
+		tagIDs[t.Name] = t.ID
+	}
+
+	managed := map[string]monitor.Base{}
+
+	for _, mon := range sortedByID(monitors) {
+		container, ok := s.owner(mon)
+		if !ok {
+			continue
+		}
+
+		_, duplicate := managed[container]
+		if duplicate || (targets[container].Container == "" && !invalid[container]) {
+			// Monitors of removed containers and duplicates are deleted.
+			err = s.kuma.DeleteMonitor(ctx, mon.ID)
+			if err != nil {
+				return result, fmt.Errorf("sync: container %s: %w", container, err)
+			}
+
+			if !duplicate {
+				result.Deleted = append(result.Deleted, container)
+			}
+
+			continue
+		}
+
+		managed[container] = mon
+	}
+
+	for _, name := range sortedKeys(targets) {
+		t := targets[name]
+
+		existing, exists := managed[name]
+		if !exists {
+			err = s.create(ctx, t, tagIDs)
+			if err != nil {
+				return result, fmt.Errorf("sync: container %s: %w", name, err)
+			}
+
+			result.Created = append(result.Created, name)
+
+			continue
+		}
+
+		updated, err := s.update(ctx, t, existing, tagIDs)
+		if err != nil {
+			return result, fmt.Errorf("sync: container %s: %w", name, err)
+		}
+
+		if updated {
+			result.Updated = append(result.Updated, name)
+		}
+	}
+
+	return result, nil
+}
+
+// Run syncs the monitors every interval until the context is canceled.
+// The result of every sync is passed to fn, if fn is not nil. Errors of
+// individual syncs do not stop Run. Run returns the error of the context.
+func (s *Syncer) Run(ctx context.Context, interval time.Duration, fn func(Result, error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := s.Sync(ctx)
+		if fn != nil {
+			fn(result, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err() //nolint:wrapcheck // The context error is returned as is.
+
+		case <-ticker.C:
+		}
+	}
+}
+
+// owner returns the container name of a monitor managed by the syncer.
+func (s *Syncer) owner(mon monitor.Base) (string, bool) {
+	for _, t := range mon.Tags {
+		if t.Name == s.config.OwnerTag {
+			return t.Value, true
+		}
+	}
+
+	return "", false
+}
+
+func (s *Syncer) create(ctx context.Context, t Target, tagIDs map[string]int64) error {
+	mon, err := s.monitor(t, monitor.Base{})
+	if err != nil {
+		return err
+	}
+
+	id, err := s.kuma.CreateMonitor(ctx, mon)
+	if err != nil {
+		return err //nolint:wrapcheck // Wrapped by Sync.
+	}
+
+	return s.syncTags(ctx, t, id, nil, tagIDs)
+}
+
+// update updates the monitor, if the target differs from the existing
+// monitor. It reports, whether the monitor or its tags have been changed.
+func (s *Syncer) update(ctx context.Context, t Target, existing monitor.Base, tagIDs map[string]int64) (bool, error) {
+	mon, err := s.monitor(t, existing)
+	if err != nil {
+		return false, err
+	}
+
+	// Monitors, whose type has been changed to an unsupported type, are
+	// always updated.
+	current, err := concrete(existing.Type(), existing)
+	changed := err != nil || !reflect.DeepEqual(mon, current)
+
+	if changed {
+		err = s.kuma.UpdateMonitor(ctx, mon)
+		if err != nil {
+			return false, err //nolint:wrapcheck // Wrapped by Sync.
+		}
+	}
+
+	tagsChanged := !sameTags(s.desiredTags(t), existing.Tags)
+	if tagsChanged {
+		err = s.syncTags(ctx, t, existing.ID, existing.Tags, tagIDs)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return changed || tagsChanged, nil
+}
+
+// monitor returns the monitor for the target. The settings of the existing
+// monitor, which are not controlled by labels, are retained, if it has the
+// same type as the target.
+func (s *Syncer) monitor(t Target, existing monitor.Base) (monitor.Monitor, error) {
+	mon, err := concrete(t.Type, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	switch m := mon.(type) {
+	case *monitor.HTTP:
+		m.URL = t.URL
+		s.apply(t, &m.Base)
+
+	case *monitor.Docker:
+		m.DockerHost = s.config.DockerHostID
+		m.DockerContainer = t.Container
+		s.apply(t, &m.Base)
+
+	default:
+	}
+
+	return mon, nil
+}
+
+// concrete returns the existing monitor converted to the monitor type typ.
+// If the existing monitor has a different type, a new monitor with the
+// defaults and the ID of the existing monitor is returned. Tags are not
+// part of the returned monitor, they are synced separately.
+func concrete(typ string, existing monitor.Base) (monitor.Monitor, error) {
+	base := monitor.Base{
+		ID:            existing.ID,
+		Interval:      defaultInterval,
+		RetryInterval: defaultInterval,
+		IsActive:      true,
+	}
+
+	sameType := existing.ID != 0 && existing.Type() == typ
+
+	switch typ {
+	case TypeHTTP:
+		mon := &monitor.HTTP{
+			Base: base,
+			HTTPDetails: monitor.HTTPDetails{
+				Timeout:             defaultHTTPTimeout,
+				Method:              "GET",
+				MaxRedirects:        defaultMaxRedirects,
+				AcceptedStatusCodes: []string{defaultStatusCodes},
+				AuthMethod:          monitor.AuthMethodNone,
+			},
+		}
+
+		if sameType {
+			err := existing.As(mon)
+			if err != nil {
+				return nil, fmt.Errorf("convert monitor %d: %w", existing.ID, err)
+			}
+
+			mon.Tags = nil
+		}
+
+		return mon, nil
+
+	case TypeDocker:
+		mon := &monitor.Docker{Base: base}
+
+		if sameType {
+			err := existing.As(mon)
+			if err != nil {
+				return nil, fmt.Errorf("convert monitor %d: %w", existing.ID, err)
+			}
+
+			mon.Tags = nil
+		}
+
+		return mon, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported monitor type %q", typ)
+	}
+}
+
+// apply sets the settings controlled by the labels.
+func (*Syncer) apply(t Target, base *monitor.Base) {
+	base.Name = t.Name
+
+	if t.Interval > 0 {
+		base.Interval = t.Interval
+		base.RetryInterval = t.Interval
+	}
+}
+
+// desiredTags returns the tags of the target including the owner tag.
+func (s *Syncer) desiredTags(t Target) []Tag {
+	return append([]Tag{{Name: s.config.OwnerTag, Value: t.Container}}, t.Tags...)
+}
+
+// syncTags adds the desired tags to the monitor and removes all others.
+func (s *Syncer) syncTags(
+	ctx context.Context,
+	t Target,
+	monitorID int64,
+	current []tag.MonitorTag,
+	tagIDs map[string]int64,
+) error {
+	desired := s.desiredTags(t)
+
+	for _, mt := range current {
+		if !slices.Contains(desired, Tag{Name: mt.Name, Value: mt.Value}) {
+			err := s.kuma.DeleteMonitorTagWithValue(ctx, mt.TagID, monitorID, mt.Value)
+			if err != nil {
+				return fmt.Errorf("remove tag %s: %w", mt.Name, err)
+			}
+		}
+	}
+
+	for _, dt := range desired {
+		exists := slices.ContainsFunc(current, func(mt tag.MonitorTag) bool {
+			return mt.Name == dt.Name && mt.Value == dt.Value
+		})
+		if exists {
+			continue
+		}
+
+		tagID, err := s.ensureTag(ctx, dt.Name, tagIDs)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.kuma.AddMonitorTag(ctx, tagID, monitorID, dt.Value)
+		if err != nil {
+			return fmt.Errorf("add tag %s: %w", dt.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// ensureTag returns the ID of the tag with the given name and creates the
+// tag, if it does not exist.
+func (s *Syncer) ensureTag(ctx context.Context, name string, tagIDs map[string]int64) (int64, error) {
+	id, ok := tagIDs[name]
+	if ok {
+		return id, nil
+	}
+
+	id, err := s.kuma.CreateTag(ctx, tag.Tag{Name: name, Color: s.config.TagColor})
+	if err != nil {
+		return 0, fmt.Errorf("create tag %s: %w", name, err)
+	}
+
+	if id == 0 {
+		return 0, fmt.Errorf("create tag %s: %w", name, errNoTagID)
+	}
+
+	tagIDs[name] = id
+
+	return id, nil
+}
+
+// sameTags reports, whether the monitor tags match the desired tags.
+func sameTags(desired []Tag, current []tag.MonitorTag) bool {
+	if len(desired) != len(current) {
+		return false
+	}
+
+	for _, dt := range desired {
+		found := slices.ContainsFunc(current, func(mt tag.MonitorTag) bool {
+			return mt.Name == dt.Name && mt.Value == dt.Value
+		})
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedByID(monitors []monitor.Base) []monitor.Base {
+	return slices.SortedFunc(slices.Values(monitors), func(a monitor.Base, b monitor.Base) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+}
+
+func sortedKeys(targets map[string]Target) []string {
+	keys := make([]string, 0, len(targets))
+	for k := range targets {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
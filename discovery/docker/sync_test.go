@@ -0,0 +1,319 @@
+package docker_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/discovery/docker"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+var _ docker.KumaClient = (*kuma.Client)(nil)
+
+// fakeKuma is an in-memory implementation of docker.KumaClient.
+type fakeKuma struct {
+	nextID   int64
+	monitors map[int64]map[string]any
+	tags     []tag.Tag
+	monTags  map[int64][]tag.MonitorTag
+	updates  int
+}
+
+func newFakeKuma() *fakeKuma {
+	return &fakeKuma{
+		monitors: map[int64]map[string]any{},
+		monTags:  map[int64][]tag.MonitorTag{},
+	}
+}
+
+func (f *fakeKuma) GetMonitors(_ context.Context) ([]monitor.Base, error) {
+	var monitors []monitor.Base
+
+	for id, raw := range f.monitors {
+		raw["tags"] = f.monTags[id]
+
+		data, err := json.Marshal(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		var mon monitor.Base
+
+		err = json.Unmarshal(data, &mon)
+		if err != nil {
+			return nil, err
+		}
+
+		monitors = append(monitors, mon)
+	}
+
+	return monitors, nil
+}
+
+func (f *fakeKuma) CreateMonitor(_ context.Context, mon monitor.Monitor) (int64, error) {
+	f.nextID++
+
+	raw, err := toMap(mon)
+	if err != nil {
+		return 0, err
+	}
+
+	raw["id"] = f.nextID
+	f.monitors[f.nextID] = raw
+
+	return f.nextID, nil
+}
+
+func (f *fakeKuma) UpdateMonitor(_ context.Context, mon monitor.Monitor) error {
+	_, ok := f.monitors[mon.GetID()]
+	if !ok {
+		return fmt.Errorf("monitor %d not found", mon.GetID())
+	}
+
+	raw, err := toMap(mon)
+	if err != nil {
+		return err
+	}
+
+	f.monitors[mon.GetID()] = raw
+	f.updates++
+
+	return nil
+}
+
+func (f *fakeKuma) DeleteMonitor(_ context.Context, monitorID int64) error {
+	delete(f.monitors, monitorID)
+	delete(f.monTags, monitorID)
+
+	return nil
+}
+
+func (f *fakeKuma) GetTags(_ context.Context) ([]tag.Tag, error) {
+	return slices.Clone(f.tags), nil
+}
+
+func (f *fakeKuma) CreateTag(_ context.Context, t tag.Tag) (int64, error) {
+	t.ID = int64(len(f.tags) + 1)
+	f.tags = append(f.tags, t)
+
+	return t.ID, nil
+}
+
+func (f *fakeKuma) AddMonitorTag(_ context.Context, tagID int64, monitorID int64, value string) (*tag.MonitorTag, error) {
+	mt := tag.MonitorTag{TagID: tagID, MonitorID: monitorID, Value: value, Name: f.tags[tagID-1].Name}
+	f.monTags[monitorID] = append(f.monTags[monitorID], mt)
+
+	return &mt, nil
+}
+
+func (f *fakeKuma) DeleteMonitorTagWithValue(_ context.Context, tagID int64, monitorID int64, value string) error {
+	f.monTags[monitorID] = slices.DeleteFunc(f.monTags[monitorID], func(mt tag.MonitorTag) bool {
+		return mt.TagID == tagID && mt.Value == value
+	})
+
+	return nil
+}
+
+// monitor returns the monitor with the given name.
+func (f *fakeKuma) monitor(t *testing.T, name string) monitor.Base {
+	t.Helper()
+
+	monitors, err := f.GetMonitors(t.Context())
+	require.NoError(t, err)
+
+	i := slices.IndexFunc(monitors, func(mon monitor.Base) bool { return mon.Name == name })
+	require.GreaterOrEqual(t, i, 0, "monitor %s not found", name)
+
+	return monitors[i]
+}
+
+func toMap(mon monitor.Monitor) (map[string]any, error) {
+	data, err := json.Marshal(mon)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := map[string]any{}
+
+	err = json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return raw, nil
+}
+
+func tagPairs(tags []tag.MonitorTag) []string {
+	pairs := make([]string, 0, len(tags))
+	for _, t := range tags {
+		pairs = append(pairs, t.Name+"="+t.Value)
+	}
+
+	slices.Sort(pairs)
+
+	return pairs
+}
+
+func TestSyncer_Sync(t *testing.T) {
+	web := docker.Container{
+		ID:    "1",
+		Names: []string{"/web"},
+		Labels: map[string]string{
+			docker.LabelType: "http",
+			docker.LabelURL:  "https://web.example.com",
+			docker.LabelTags: "env=prod",
+		},
+	}
+	worker := docker.Container{
+		ID:     "2",
+		Names:  []string{"/worker"},
+		Labels: map[string]string{docker.LabelType: "docker", docker.LabelInterval: "30"},
+	}
+
+	stub := newStubDocker(t, web, worker)
+
+	dockerClient, err := docker.NewClient(stub.daemon)
+	require.NoError(t, err)
+
+	fake := newFakeKuma()
+
+	// A monitor, which is not managed by the syncer.
+	_, err = fake.CreateMonitor(t.Context(), &monitor.HTTP{
+		Base:        monitor.Base{Name: "manual"},
+		HTTPDetails: monitor.HTTPDetails{URL: "https://manual.example.com"},
+	})
+	require.NoError(t, err)
+
+	syncer := docker.NewSyncer(dockerClient, fake, docker.Config{DockerHostID: 7})
+
+	t.Run("create", func(t *testing.T) {
+		result, err := syncer.Sync(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, []string{"web", "worker"}, result.Created)
+		require.Empty(t, result.Updated)
+		require.Empty(t, result.Deleted)
+		require.Empty(t, result.Errors)
+
+		mon := fake.monitor(t, "web")
+		require.Equal(t, "http", mon.Type())
+		require.Equal(t, []string{"docker-discovery=web", "env=prod"}, tagPairs(mon.Tags))
+
+		httpMon := monitor.HTTP{}
+		require.NoError(t, mon.As(&httpMon))
+		require.Equal(t, "https://web.example.com", httpMon.URL)
+		require.Equal(t, int64(60), httpMon.Interval)
+
+		mon = fake.monitor(t, "worker")
+		dockerMon := monitor.Docker{}
+		require.NoError(t, mon.As(&dockerMon))
+		require.Equal(t, int64(7), dockerMon.DockerHost)
+		require.Equal(t, "worker", dockerMon.DockerContainer)
+		require.Equal(t, int64(30), dockerMon.Interval)
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		result, err := syncer.Sync(t.Context())
+		require.NoError(t, err)
+		require.Empty(t, result.Created)
+		require.Empty(t, result.Updated)
+		require.Empty(t, result.Deleted)
+		require.Zero(t, fake.updates)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		web.Labels = map[string]string{
+			docker.LabelType: "http",
+			docker.LabelURL:  "https://www.example.com",
+			docker.LabelTags: "env=staging,public",
+		}
+		stub.setContainers(web, worker)
+
+		result, err := syncer.Sync(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, []string{"web"}, result.Updated)
+		require.Equal(t, 1, fake.updates)
+
+		mon := fake.monitor(t, "web")
+		require.Equal(t, []string{"docker-discovery=web", "env=staging", "public="}, tagPairs(mon.Tags))
+
+		httpMon := monitor.HTTP{}
+		require.NoError(t, mon.As(&httpMon))
+		require.Equal(t, "https://www.example.com", httpMon.URL)
+	})
+
+	t.Run("invalid labels", func(t *testing.T) {
+		worker.Labels = map[string]string{docker.LabelType: "ping"}
+		stub.setContainers(web, worker)
+
+		result, err := syncer.Sync(t.Context())
+		require.NoError(t, err)
+		require.Len(t, result.Errors, 1)
+		require.Empty(t, result.Deleted)
+
+		// The monitor of the container with invalid labels is retained.
+		fake.monitor(t, "worker")
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		stub.setContainers(web)
+
+		result, err := syncer.Sync(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, []string{"worker"}, result.Deleted)
+
+		monitors, err := fake.GetMonitors(t.Context())
+		require.NoError(t, err)
+		require.Len(t, monitors, 2)
+		fake.monitor(t, "manual")
+	})
+}
+
+func TestSyncer_Sync_DockerWithoutHost(t *testing.T) {
+	stub := newStubDocker(t, docker.Container{
+		ID:     "1",
+		Names:  []string{"/worker"},
+		Labels: map[string]string{docker.LabelType: "docker"},
+	})
+
+	dockerClient, err := docker.NewClient(stub.daemon)
+	require.NoError(t, err)
+
+	fake := newFakeKuma()
+
+	result, err := docker.NewSyncer(dockerClient, fake, docker.Config{}).Sync(t.Context())
+	require.NoError(t, err)
+	require.Empty(t, result.Created)
+	require.Len(t, result.Errors, 1)
+	require.ErrorContains(t, result.Errors[0], "requires a docker host ID")
+}
+
+func TestSyncer_Run(t *testing.T) {
+	stub := newStubDocker(t)
+
+	dockerClient, err := docker.NewClient(stub.daemon)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	syncs := 0
+	err = docker.NewSyncer(dockerClient, newFakeKuma(), docker.Config{}).Run(ctx, time.Millisecond,
+		func(_ docker.Result, err error) {
+			require.NoError(t, err)
+
+			syncs++
+			if syncs == 3 {
+				cancel()
+			}
+		},
+	)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 3, syncs)
+}
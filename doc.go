@@ -11,8 +11,12 @@
 //   - notification/ - Notification provider types
 //   - tag/          - Tag management
 //   - proxy/        - Proxy configuration
+//   - remotebrowser/ - Remote browsers for real browser monitors
 //   - maintenance/  - Maintenance windows
 //   - statuspage/   - Public status pages
+//   - heartbeat/    - Heartbeats and live monitor events
+//   - discovery/docker/ - Monitors discovered from Docker container labels
+//   - otelkuma/     - OpenTelemetry tracing and metrics
 //
 // The Client type maintains a local state cache synchronized via Socket.IO
 // events, ensuring consistency with the Uptime Kuma server.
@@ -48,4 +52,34 @@
 //	base, _ := client.GetMonitor(ctx, id)
 //	var httpMon monitor.HTTP
 //	base.As(&httpMon)
+//
+// # Errors
+//
+// Errors returned by the server are mapped to sentinel errors like
+// ErrNotFound, ErrInvalidCredentials or ErrValidation, which can be checked
+// with errors.Is. The message of the server is available with errors.As
+// and a *ServerError.
+//
+// # Dry Run
+//
+// With WithDryRun, mutating calls are recorded into a Plan instead of being
+// sent to the server, while reads are still answered by the server:
+//
+//	client, err := kuma.New(ctx, url, "username", "password", kuma.WithDryRun())
+//	...
+//	fmt.Println(client.Plan())
+//
+// # Audit
+//
+// With WithAuditSink, every mutating call is recorded as an AuditRecord
+// including the user and snapshots of the changed entity before and after
+// the call. NewJSONLAuditSink and NewSlogAuditSink provide sinks for JSON
+// lines and for log/slog.
+//
+// # Transport
+//
+// Uptime Kuma behind a reverse proxy, with a private CA or with client
+// certificates is supported by WithTLSConfig, WithHeaders, WithDialer and
+// WithHTTPClient. The options apply to the HTTP requests of the database
+// setup as well as to the socket.io polling and websocket transport.
 package kuma
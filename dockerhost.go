@@ -13,8 +13,10 @@ func (c *Client) GetDockerHostList(_ context.Context) []dockerhost.DockerHost {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	hosts := make([]dockerhost.DockerHost, len(c.state.dockerHosts))
-	copy(hosts, c.state.dockerHosts)
+	cached := c.dockerHostsLocked()
+
+	hosts := make([]dockerhost.DockerHost, len(cached))
+	copy(hosts, cached)
 
 	return hosts
 }
@@ -24,7 +26,7 @@ func (c *Client) GetDockerHost(_ context.Context, id int64) (*dockerhost.DockerH
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	for _, h := range c.state.dockerHosts {
+	for _, h := range c.dockerHostsLocked() {
 		if h.GetID() == id {
 			return &h, nil
 		}
@@ -57,9 +59,15 @@ func (c *Client) UpdateDockerHost(ctx context.Context, config dockerhost.Config)
 	return nil
 }
 
-// DeleteDockerHost deletes a Docker host by ID.
-func (c *Client) DeleteDockerHost(ctx context.Context, id int64) error {
-	_, err := c.syncEmitWithUpdateEvent(ctx, "deleteDockerHost", "dockerHostList", id)
+// DeleteDockerHost deletes a Docker host by ID. The options control, how
+// docker monitors referencing the Docker host are handled, see DeleteOption.
+func (c *Client) DeleteDockerHost(ctx context.Context, id int64, opts ...DeleteOption) error {
+	err := c.handleDependents(ctx, ResourceDockerHost, id, opts)
+	if err != nil {
+		return fmt.Errorf("delete docker host %d: %w", id, err)
+	}
+
+	_, err = c.syncEmitWithUpdateEvent(ctx, "deleteDockerHost", "dockerHostList", id)
 	if err != nil {
 		return fmt.Errorf("delete docker host %d: %w", id, err)
 	}
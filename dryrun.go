@@ -0,0 +1,1238 @@
+package kuma
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/breml/go-uptime-kuma-client/dockerhost"
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
+	"github.com/breml/go-uptime-kuma-client/maintenance"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/notification"
+	"github.com/breml/go-uptime-kuma-client/proxy"
+	"github.com/breml/go-uptime-kuma-client/remotebrowser"
+	"github.com/breml/go-uptime-kuma-client/settings"
+	"github.com/breml/go-uptime-kuma-client/statuspage"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+// statusPageSlugRegexp is the pattern for status page slugs enforced by the
+// server.
+var statusPageSlugRegexp = regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`)
+
+// WithDryRun enables the dry-run mode. In dry-run mode, mutating commands,
+// e.g. CreateMonitor, UpdateMonitor or DeleteMonitor, are validated and
+// recorded into the Plan of the client instead of being sent to the server.
+// Created entities get synthetic, negative IDs, such that dependent calls
+// still work. The cache of the client is kept as sent by the server, the
+// changes of the plan are merged, when the cache is read.
+//
+// Reads are sent to the server, except for entities changed by the plan,
+// which are answered from the cache. The setup of a fresh server with
+// WithAutosetup is sent to the server as well.
+func WithDryRun() Option {
+	return func(c *Client) {
+		c.plan = newPlan()
+	}
+}
+
+// PlannedCall is a mutating socket command, which has been recorded in
+// dry-run mode instead of being sent to the server.
+type PlannedCall struct {
+	// Command is the socket command, e.g. editMonitor.
+	Command string `json:"command"`
+
+	// Args are the arguments of the command, as they would have been sent to
+	// the server, where the values of secret fields and passwords are masked.
+	Args []any `json:"args"`
+
+	// ID is the synthetic ID of the created entity, 0 if the command does
+	// not create an entity.
+	ID int64 `json:"id,omitempty"`
+}
+
+func (p PlannedCall) String() string {
+	args, err := json.Marshal(p.Args)
+	if err != nil {
+		args = []byte(fmt.Sprintf("%v", p.Args))
+	}
+
+	return p.Command + " " + string(args)
+}
+
+// Plan is the list of mutating socket commands, which have been recorded in
+// dry-run mode, see WithDryRun.
+type Plan struct {
+	mu     sync.Mutex
+	calls  []PlannedCall
+	lastID int64
+
+	// Entities changed by the plan. A nil value marks a deleted entity.
+	monitors     map[int64]map[string]any
+	tags         map[int64]map[string]any
+	maintenances map[int64]map[string]any
+	statusPages  map[string]map[string]any
+
+	monitorMaintenances    map[int64][]any
+	maintenanceStatusPages map[int64][]any
+
+	// Entities, which are only known from the lists sent by the server, in
+	// the format of these lists.
+	notifications  map[int64]map[string]any
+	proxies        map[int64]map[string]any
+	dockerHosts    map[int64]map[string]any
+	remoteBrowsers map[int64]map[string]any
+}
+
+func newPlan() *Plan {
+	return &Plan{
+		monitors:               map[int64]map[string]any{},
+		tags:                   map[int64]map[string]any{},
+		maintenances:           map[int64]map[string]any{},
+		statusPages:            map[string]map[string]any{},
+		monitorMaintenances:    map[int64][]any{},
+		maintenanceStatusPages: map[int64][]any{},
+		notifications:          map[int64]map[string]any{},
+		proxies:                map[int64]map[string]any{},
+		dockerHosts:            map[int64]map[string]any{},
+		remoteBrowsers:         map[int64]map[string]any{},
+	}
+}
+
+// Plan returns the plan of the client in dry-run mode, nil otherwise.
+func (c *Client) Plan() *Plan {
+	return c.plan
+}
+
+// Calls returns the recorded calls in the order they have been made.
+func (p *Plan) Calls() []PlannedCall {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return slices.Clone(p.calls)
+}
+
+func (p *Plan) String() string {
+	calls := p.Calls()
+
+	lines := make([]string, 0, len(calls))
+	for _, call := range calls {
+		lines = append(lines, call.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (p *Plan) record(call PlannedCall) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.calls = append(p.calls, call)
+}
+
+// listed returns the changed entities of the given kind, which are only
+// known from the lists sent by the server.
+func (p *Plan) listed(kind ResourceKind) map[int64]map[string]any {
+	switch kind {
+	case ResourceNotification:
+		return p.notifications
+
+	case ResourceProxy:
+		return p.proxies
+
+	case ResourceDockerHost:
+		return p.dockerHosts
+
+	case ResourceRemoteBrowser:
+		return p.remoteBrowsers
+
+	default:
+		return nil
+	}
+}
+
+// nextID returns the next synthetic ID. Synthetic IDs are negative to not
+// collide with the IDs assigned by the server.
+func (p *Plan) nextID() int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lastID--
+
+	return p.lastID
+}
+
+func shadowGet[K comparable, V any](p *Plan, m map[K]V, key K) (V, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	v, ok := m[key]
+
+	return v, ok
+}
+
+func shadowSet[K comparable, V any](p *Plan, m map[K]V, key K, v V) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	m[key] = v
+}
+
+// isReadCommand reports, whether the command does not change the state of
+// the server.
+func isReadCommand(command string) bool {
+	switch command {
+	case "login", "getMonitorList", "getMonitor", "getTags", "getMaintenance", "getMonitorMaintenance",
+		"getMaintenanceStatusPage", "getStatusPage", "getSettings", "getDatabaseSize", "testDockerHost",
+		"testRemoteBrowser":
+		return true
+
+	default:
+		return false
+	}
+}
+
+// dryRun returns the invoker for the dry-run mode. Reads are sent with next,
+// mutating commands are recorded into the plan.
+func (c *Client) dryRun(next Invoker) Invoker {
+	return func(ctx context.Context, command string, args []any) (AckResponse, error) {
+		// Use the arguments as they would be encoded for the server, this
+		// ensures, the arguments can be sent at all.
+		encodedArgs, err := encodeArgs(args)
+		if err != nil {
+			return AckResponse{}, fmt.Errorf("%s: %w", command, err)
+		}
+
+		// The setup of a fresh server is part of establishing the connection
+		// with WithAutosetup and not a change planned by the caller.
+		if command == "setup" {
+			return next(ctx, command, args)
+		}
+
+		if isReadCommand(command) {
+			return c.dryRunRead(ctx, command, args, encodedArgs, next)
+		}
+
+		response, id, err := c.dryRunMutation(ctx, command, encodedArgs)
+		if err != nil {
+			// Validation errors, which mimic the server, already contain the
+			// command.
+			var serverErr *ServerError
+			if errors.As(err, &serverErr) && serverErr.Command == command {
+				return AckResponse{}, err
+			}
+
+			return AckResponse{}, fmt.Errorf("%s: %w", command, err)
+		}
+
+		c.plan.record(PlannedCall{
+			Command: command,
+			Args:    redactArgs(command, encodedArgs),
+			ID:      id,
+		})
+
+		return response, nil
+	}
+}
+
+// dryRunRead answers reads of entities changed by the plan from the plan and
+// sends all other reads to the server.
+func (c *Client) dryRunRead(
+	ctx context.Context,
+	command string,
+	args []any,
+	encodedArgs []any,
+	next Invoker,
+) (AckResponse, error) {
+	notFound := AckResponse{OK: true}
+
+	switch command {
+	case "getMonitor":
+		data, ok := shadowGet(c.plan, c.plan.monitors, argInt64(encodedArgs, 0))
+		if ok {
+			if data == nil {
+				return notFound, nil
+			}
+
+			return AckResponse{OK: true, Monitor: maps.Clone(data)}, nil
+		}
+
+	case "getMaintenance":
+		data, ok := shadowGet(c.plan, c.plan.maintenances, argInt64(encodedArgs, 0))
+		if ok {
+			if data == nil {
+				return notFound, nil
+			}
+
+			return AckResponse{OK: true, Maintenance: maps.Clone(data)}, nil
+		}
+
+	case "getStatusPage":
+		data, ok := shadowGet(c.plan, c.plan.statusPages, argString(encodedArgs, 0))
+		if ok {
+			if data == nil {
+				return notFound, nil
+			}
+
+			return AckResponse{OK: true, Config: maps.Clone(data)}, nil
+		}
+
+	case "getMonitorMaintenance":
+		monitors, ok := shadowGet(c.plan, c.plan.monitorMaintenances, argInt64(encodedArgs, 0))
+		if ok {
+			return AckResponse{OK: true, Monitors: slices.Clone(monitors)}, nil
+		}
+
+	case "getMaintenanceStatusPage":
+		statusPages, ok := shadowGet(c.plan, c.plan.maintenanceStatusPages, argInt64(encodedArgs, 0))
+		if ok {
+			return AckResponse{OK: true, StatusPages: slices.Clone(statusPages)}, nil
+		}
+
+	case "getTags":
+		response, err := next(ctx, command, args)
+		if err != nil {
+			return AckResponse{}, err
+		}
+
+		response.Tags = c.dryRunMergeTags(response.Tags)
+
+		return response, nil
+
+	default:
+	}
+
+	return next(ctx, command, args)
+}
+
+// dryRunMergeTags applies the tags changed by the plan to the tags returned
+// by the server.
+func (c *Client) dryRunMergeTags(tags []any) []any {
+	c.plan.mu.Lock()
+	defer c.plan.mu.Unlock()
+
+	merged := make([]any, 0, len(tags)+len(c.plan.tags))
+	for _, t := range tags {
+		data, ok := t.(map[string]any)
+		if ok {
+			if _, changed := c.plan.tags[mapInt64(data, "id")]; changed {
+				continue
+			}
+		}
+
+		merged = append(merged, t)
+	}
+
+	ids := slices.Sorted(maps.Keys(c.plan.tags))
+	for _, id := range ids {
+		data := c.plan.tags[id]
+		if data != nil {
+			merged = append(merged, maps.Clone(data))
+		}
+	}
+
+	return merged
+}
+
+// dryRunMutation validates the mutating command and applies it to the plan.
+// It returns the response, the server would have sent, and the synthetic ID
+// of the created entity.
+//
+//nolint:gocognit,gocyclo,cyclop,funlen // One case per command.
+func (c *Client) dryRunMutation(ctx context.Context, command string, args []any) (AckResponse, int64, error) {
+	ok := AckResponse{OK: true}
+
+	switch command {
+	case "add":
+		data, err := argMap(args, 0)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		id := c.plan.nextID()
+		data["id"] = id
+
+		if data["tags"] == nil {
+			data["tags"] = []any{}
+		}
+
+		err = checkConvertible(data, &monitor.Base{})
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		shadowSet(c.plan, c.plan.monitors, id, data)
+
+		return AckResponse{OK: true, MonitorID: id}, id, nil
+
+	case "editMonitor":
+		data, err := argMap(args, 0)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		id := mapInt64(data, "id")
+
+		current, err := c.dryRunMonitor(ctx, id)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		// The tags are not changed by editMonitor.
+		data["tags"] = current["tags"]
+
+		err = checkConvertible(data, &monitor.Base{})
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		shadowSet(c.plan, c.plan.monitors, id, data)
+
+		return AckResponse{OK: true, MonitorID: id}, 0, nil
+
+	case "deleteMonitor":
+		id := argInt64(args, 0)
+
+		_, err := c.dryRunMonitor(ctx, id)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		shadowSet(c.plan, c.plan.monitors, id, nil)
+
+		return ok, 0, nil
+
+	case "pauseMonitor", "resumeMonitor":
+		id := argInt64(args, 0)
+
+		data, err := c.dryRunMonitor(ctx, id)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		data["active"] = command == "resumeMonitor"
+		shadowSet(c.plan, c.plan.monitors, id, data)
+
+		return ok, 0, nil
+
+	case "addTag":
+		data, err := argMap(args, 0)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		id := c.plan.nextID()
+		data["id"] = id
+		shadowSet(c.plan, c.plan.tags, id, data)
+
+		return AckResponse{OK: true, Tag: maps.Clone(data)}, id, nil
+
+	case "editTag":
+		data, err := argMap(args, 0)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		id := mapInt64(data, "id")
+
+		_, err = c.dryRunTag(ctx, id)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		shadowSet(c.plan, c.plan.tags, id, data)
+
+		return AckResponse{OK: true, Tag: maps.Clone(data)}, 0, nil
+
+	case "deleteTag":
+		id := argInt64(args, 0)
+
+		_, err := c.dryRunTag(ctx, id)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		shadowSet(c.plan, c.plan.tags, id, nil)
+
+		return ok, 0, nil
+
+	case "addMonitorTag", "editMonitorTag", "deleteMonitorTag":
+		return ok, 0, c.dryRunMonitorTag(ctx, command, args)
+
+	case "addMaintenance":
+		data, err := argMap(args, 0)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		id := c.plan.nextID()
+		data["id"] = id
+
+		err = checkConvertible(data, &maintenance.Maintenance{})
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		shadowSet(c.plan, c.plan.maintenances, id, data)
+
+		return AckResponse{OK: true, MaintenanceID: id}, id, nil
+
+	case "editMaintenance":
+		data, err := argMap(args, 0)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		id := mapInt64(data, "id")
+
+		_, err = c.dryRunMaintenance(ctx, id)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		err = checkConvertible(data, &maintenance.Maintenance{})
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		shadowSet(c.plan, c.plan.maintenances, id, data)
+
+		return AckResponse{OK: true, MaintenanceID: id}, 0, nil
+
+	case "deleteMaintenance":
+		id := argInt64(args, 0)
+
+		_, err := c.dryRunMaintenance(ctx, id)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		shadowSet(c.plan, c.plan.maintenances, id, nil)
+
+		return ok, 0, nil
+
+	case "pauseMaintenance", "resumeMaintenance":
+		id := argInt64(args, 0)
+
+		data, err := c.dryRunMaintenance(ctx, id)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		data["active"] = command == "resumeMaintenance"
+		shadowSet(c.plan, c.plan.maintenances, id, data)
+
+		return ok, 0, nil
+
+	case "addMonitorMaintenance", "addMaintenanceStatusPage":
+		id := argInt64(args, 0)
+
+		_, err := c.dryRunMaintenance(ctx, id)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		list, err := argList(args, 1)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		if command == "addMonitorMaintenance" {
+			shadowSet(c.plan, c.plan.monitorMaintenances, id, list)
+		} else {
+			shadowSet(c.plan, c.plan.maintenanceStatusPages, id, list)
+		}
+
+		return ok, 0, nil
+
+	case "addStatusPage":
+		title := argString(args, 0)
+		slug := argString(args, 1)
+
+		if !statusPageSlugRegexp.MatchString(slug) {
+			return AckResponse{}, 0, newServerError(command, AckResponse{Msg: "Provided Slug is not valid."})
+		}
+
+		_, err := c.dryRunStatusPage(ctx, slug)
+		if err == nil {
+			return AckResponse{}, 0, newServerError(
+				command,
+				AckResponse{Msg: "The slug is already taken. Please choose another slug."},
+			)
+		}
+
+		id := c.plan.nextID()
+		shadowSet(c.plan, c.plan.statusPages, slug, map[string]any{
+			"id":              id,
+			"slug":            slug,
+			"title":           title,
+			"published":       true,
+			"domainNameList":  []any{},
+			"publicGroupList": []any{},
+		})
+
+		return ok, id, nil
+
+	case "saveStatusPage":
+		return c.dryRunSaveStatusPage(ctx, args)
+
+	case "deleteStatusPage":
+		slug := argString(args, 0)
+
+		_, err := c.dryRunStatusPage(ctx, slug)
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		shadowSet(c.plan, c.plan.statusPages, slug, nil)
+
+		return ok, 0, nil
+
+	case "postIncident", "unpinIncident":
+		_, err := c.dryRunStatusPage(ctx, argString(args, 0))
+		if err != nil {
+			return AckResponse{}, 0, err
+		}
+
+		if command == "postIncident" {
+			incident, err := argMap(args, 1)
+			if err != nil {
+				return AckResponse{}, 0, err
+			}
+
+			return AckResponse{OK: true, Incident: incident}, 0, nil
+		}
+
+		return ok, 0, nil
+
+	case "addNotification", "addProxy", "addDockerHost", "addRemoteBrowser":
+		return c.dryRunAddListed(command, args)
+
+	case "deleteNotification", "deleteProxy", "deleteDockerHost", "deleteRemoteBrowser":
+		kind, id, _ := CommandTarget(command, args)
+
+		if !c.dryRunListedExists(kind, id) {
+			return AckResponse{}, 0, fmt.Errorf("%s %d: %w", kind, id, ErrNotFound)
+		}
+
+		shadowSet(c.plan, c.plan.listed(kind), id, nil)
+
+		return ok, 0, nil
+
+	default:
+		// Commands, which are not known to the plan, are recorded without
+		// changing the cache.
+		return ok, 0, nil
+	}
+}
+
+// dryRunAddListed applies addNotification, addProxy, addDockerHost and
+// addRemoteBrowser. These commands create the entity, if the ID argument is
+// nil, and update the entity with the given ID otherwise.
+func (c *Client) dryRunAddListed(command string, args []any) (AckResponse, int64, error) {
+	kind, id, _ := CommandTarget(command, args)
+
+	data, err := argMap(args, 0)
+	if err != nil {
+		return AckResponse{}, 0, err
+	}
+
+	var created int64
+
+	if id == 0 {
+		id = c.plan.nextID()
+		created = id
+	} else if !c.dryRunListedExists(kind, id) {
+		return AckResponse{}, 0, fmt.Errorf("%s %d: %w", kind, id, ErrNotFound)
+	}
+
+	data["id"] = id
+
+	var target any
+
+	switch kind {
+	case ResourceNotification:
+		// The server stores the configuration of a notification as JSON
+		// string.
+		config, err := json.Marshal(data)
+		if err != nil {
+			return AckResponse{}, 0, fmt.Errorf("%w: %w", ErrValidation, err)
+		}
+
+		data = map[string]any{
+			"id":        id,
+			"name":      data["name"],
+			"active":    true,
+			"userId":    data["userId"],
+			"isDefault": data["isDefault"],
+			"config":    string(config),
+		}
+		target = &notification.Base{}
+
+	case ResourceProxy:
+		// The server stores the flags of a proxy as integers.
+		for _, name := range []string{"auth", "active", "default"} {
+			flag, _ := data[name].(bool)
+			data[name] = boolToInt(flag)
+		}
+
+		delete(data, "applyExisting")
+		target = &proxy.Proxy{}
+
+	case ResourceDockerHost:
+		target = &dockerhost.DockerHost{}
+
+	default:
+		target = &remotebrowser.RemoteBrowser{}
+	}
+
+	err = checkConvertible(data, target)
+	if err != nil {
+		return AckResponse{}, 0, err
+	}
+
+	shadowSet(c.plan, c.plan.listed(kind), id, data)
+
+	return AckResponse{OK: true, ID: id}, created, nil
+}
+
+// dryRunListedExists reports, whether the entity, which is only known from
+// the lists sent by the server, exists in the cache with the plan applied.
+func (c *Client) dryRunListedExists(kind ResourceKind, id int64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch kind {
+	case ResourceNotification:
+		return slices.ContainsFunc(c.notificationsLocked(), func(n notification.Base) bool { return n.ID == id })
+
+	case ResourceProxy:
+		return slices.ContainsFunc(c.proxiesLocked(), func(p proxy.Proxy) bool { return p.ID == id })
+
+	case ResourceDockerHost:
+		return slices.ContainsFunc(c.dockerHostsLocked(), func(d dockerhost.DockerHost) bool { return d.ID == id })
+
+	case ResourceRemoteBrowser:
+		return slices.ContainsFunc(c.remoteBrowsersLocked(), func(r remotebrowser.RemoteBrowser) bool {
+			return r.ID == id
+		})
+
+	default:
+		return false
+	}
+}
+
+// dryRunMonitorTag applies addMonitorTag, editMonitorTag and
+// deleteMonitorTag to the tags of the monitor.
+func (c *Client) dryRunMonitorTag(ctx context.Context, command string, args []any) error {
+	tagID := argInt64(args, 0)
+	monitorID := argInt64(args, 1)
+	value := argString(args, 2)
+
+	t, err := c.dryRunTag(ctx, tagID)
+	if err != nil {
+		return err
+	}
+
+	data, err := c.dryRunMonitor(ctx, monitorID)
+	if err != nil {
+		return err
+	}
+
+	tags, _ := data["tags"].([]any)
+	tags = slices.Clone(tags)
+
+	switch command {
+	case "addMonitorTag":
+		tags = append(tags, map[string]any{
+			"tag_id":     tagID,
+			"monitor_id": monitorID,
+			"value":      value,
+			"name":       t["name"],
+			"color":      t["color"],
+		})
+
+	case "editMonitorTag":
+		for i, mt := range tags {
+			mtData, ok := mt.(map[string]any)
+			if ok && mapInt64(mtData, "tag_id") == tagID {
+				mtData = maps.Clone(mtData)
+				mtData["value"] = value
+				tags[i] = mtData
+			}
+		}
+
+	case "deleteMonitorTag":
+		tags = slices.DeleteFunc(tags, func(mt any) bool {
+			mtData, ok := mt.(map[string]any)
+			return ok && mapInt64(mtData, "tag_id") == tagID && mtData["value"] == value
+		})
+
+	default:
+	}
+
+	data["tags"] = tags
+	shadowSet(c.plan, c.plan.monitors, monitorID, data)
+
+	return nil
+}
+
+// dryRunSaveStatusPage applies saveStatusPage to the status page. Public
+// groups without ID get a synthetic ID.
+func (c *Client) dryRunSaveStatusPage(ctx context.Context, args []any) (AckResponse, int64, error) {
+	slug := argString(args, 0)
+
+	data, err := c.dryRunStatusPage(ctx, slug)
+	if err != nil {
+		return AckResponse{}, 0, err
+	}
+
+	config, err := argMap(args, 1)
+	if err != nil {
+		return AckResponse{}, 0, err
+	}
+
+	groups, err := argList(args, 3)
+	if err != nil {
+		return AckResponse{}, 0, err
+	}
+
+	maps.Copy(data, config)
+	data["slug"] = slug
+	data["icon"] = argString(args, 2)
+
+	for i, group := range groups {
+		groupData, ok := group.(map[string]any)
+		if !ok {
+			return AckResponse{}, 0, fmt.Errorf("public group %d: unexpected type %T", i, group)
+		}
+
+		if mapInt64(groupData, "id") == 0 {
+			groupData["id"] = c.plan.nextID()
+		}
+	}
+
+	data["publicGroupList"] = groups
+
+	err = checkConvertible(data, &statuspage.StatusPage{})
+	if err != nil {
+		return AckResponse{}, 0, err
+	}
+
+	shadowSet(c.plan, c.plan.statusPages, slug, data)
+
+	return AckResponse{OK: true, PublicGroupList: slices.Clone(groups)}, 0, nil
+}
+
+// dryRunMonitor returns the monitor from the plan or from the server.
+func (c *Client) dryRunMonitor(ctx context.Context, id int64) (map[string]any, error) {
+	data, ok := shadowGet(c.plan, c.plan.monitors, id)
+	if !ok {
+		response, err := c.emit(ctx, "getMonitor", []any{id})
+		if err != nil {
+			return nil, err
+		}
+
+		data = response.Monitor
+	}
+
+	if data == nil {
+		return nil, fmt.Errorf("monitor %d: %w", id, ErrNotFound)
+	}
+
+	return maps.Clone(data), nil
+}
+
+// dryRunMaintenance returns the maintenance from the plan or from the server.
+func (c *Client) dryRunMaintenance(ctx context.Context, id int64) (map[string]any, error) {
+	data, ok := shadowGet(c.plan, c.plan.maintenances, id)
+	if !ok {
+		response, err := c.emit(ctx, "getMaintenance", []any{id})
+		if err != nil {
+			return nil, err
+		}
+
+		data = response.Maintenance
+	}
+
+	if data == nil {
+		return nil, fmt.Errorf("maintenance %d: %w", id, ErrNotFound)
+	}
+
+	return maps.Clone(data), nil
+}
+
+// dryRunStatusPage returns the status page from the plan or from the server.
+func (c *Client) dryRunStatusPage(ctx context.Context, slug string) (map[string]any, error) {
+	data, ok := shadowGet(c.plan, c.plan.statusPages, slug)
+	if !ok {
+		response, err := c.emit(ctx, "getStatusPage", []any{slug})
+		if err != nil {
+			return nil, err
+		}
+
+		data = response.Config
+	}
+
+	if data == nil {
+		return nil, fmt.Errorf("status page %s: %w", slug, ErrNotFound)
+	}
+
+	return maps.Clone(data), nil
+}
+
+// dryRunTag returns the tag from the plan or from the server.
+func (c *Client) dryRunTag(ctx context.Context, id int64) (map[string]any, error) {
+	data, ok := shadowGet(c.plan, c.plan.tags, id)
+	if !ok {
+		response, err := c.emit(ctx, "getTags", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range response.Tags {
+			tagData, isMap := t.(map[string]any)
+			if isMap && mapInt64(tagData, "id") == id {
+				data = tagData
+				break
+			}
+		}
+	}
+
+	if data == nil {
+		return nil, fmt.Errorf("tag %d: %w", id, ErrNotFound)
+	}
+
+	return maps.Clone(data), nil
+}
+
+// The plan is an overlay of the cache: the cache is kept as sent by the
+// server and the entities changed by the plan are merged, when the cache is
+// read. The following accessors return the merged view. Without plan, they
+// return the cache as is. The caller must hold c.mu and must not modify the
+// returned values.
+
+// monitorsLocked returns the cached monitors with the plan applied.
+func (c *Client) monitorsLocked() []monitor.Base {
+	if c.plan == nil {
+		return c.state.monitors
+	}
+
+	monitors := overlayList(c.plan, c.state.monitors, c.plan.monitors, monitor.Base.GetID)
+
+	c.plan.mu.Lock()
+	defer c.plan.mu.Unlock()
+
+	// The server removes deleted tags from all monitors.
+	for id, data := range c.plan.tags {
+		if data != nil {
+			continue
+		}
+
+		for i := range monitors {
+			// Do not modify the tags of the cached monitor.
+			monitors[i].Tags = slices.DeleteFunc(slices.Clone(monitors[i].Tags), func(mt tag.MonitorTag) bool {
+				return mt.TagID == id
+			})
+		}
+	}
+
+	return monitors
+}
+
+// maintenancesLocked returns the cached maintenances with the plan applied.
+func (c *Client) maintenancesLocked() []maintenance.Maintenance {
+	if c.plan == nil {
+		return c.state.maintenances
+	}
+
+	return overlayList(c.plan, c.state.maintenances, c.plan.maintenances, func(m maintenance.Maintenance) int64 {
+		return m.ID
+	})
+}
+
+// statusPagesLocked returns the cached status pages with the plan applied.
+func (c *Client) statusPagesLocked() map[int64]statuspage.StatusPage {
+	if c.plan == nil {
+		return c.state.statusPages
+	}
+
+	c.plan.mu.Lock()
+	defer c.plan.mu.Unlock()
+
+	statusPages := make(map[int64]statuspage.StatusPage, len(c.state.statusPages)+len(c.plan.statusPages))
+	for id, sp := range c.state.statusPages {
+		if _, changed := c.plan.statusPages[sp.Slug]; !changed {
+			statusPages[id] = sp
+		}
+	}
+
+	for _, data := range c.plan.statusPages {
+		if data == nil {
+			continue
+		}
+
+		var sp statuspage.StatusPage
+
+		// The data has been validated, when it has been added to the plan.
+		err := convertToStruct(data, &sp)
+		if err != nil {
+			continue
+		}
+
+		statusPages[sp.ID] = sp
+	}
+
+	return statusPages
+}
+
+// notificationsLocked returns the cached notifications with the plan
+// applied.
+func (c *Client) notificationsLocked() []notification.Base {
+	if c.plan == nil {
+		return c.state.notifications
+	}
+
+	return overlayList(c.plan, c.state.notifications, c.plan.notifications, notification.Base.GetID)
+}
+
+// proxiesLocked returns the cached proxies with the plan applied.
+func (c *Client) proxiesLocked() []proxy.Proxy {
+	if c.plan == nil {
+		return c.state.proxies
+	}
+
+	return overlayList(c.plan, c.state.proxies, c.plan.proxies, proxy.Proxy.GetID)
+}
+
+// dockerHostsLocked returns the cached docker hosts with the plan applied.
+func (c *Client) dockerHostsLocked() []dockerhost.DockerHost {
+	if c.plan == nil {
+		return c.state.dockerHosts
+	}
+
+	return overlayList(c.plan, c.state.dockerHosts, c.plan.dockerHosts, dockerhost.DockerHost.GetID)
+}
+
+// remoteBrowsersLocked returns the cached remote browsers with the plan
+// applied.
+func (c *Client) remoteBrowsersLocked() []remotebrowser.RemoteBrowser {
+	if c.plan == nil {
+		return c.state.remoteBrowsers
+	}
+
+	return overlayList(c.plan, c.state.remoteBrowsers, c.plan.remoteBrowsers, remotebrowser.RemoteBrowser.GetID)
+}
+
+// overlayList returns a copy of the list of cached entities, where the
+// entities changed by the plan are replaced, deleted or appended. Created
+// entities are appended in the order of their creation.
+func overlayList[T any](p *Plan, list []T, changed map[int64]map[string]any, id func(T) int64) []T {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	result := make([]T, 0, len(list)+len(changed))
+	for _, v := range list {
+		if _, ok := changed[id(v)]; !ok {
+			result = append(result, v)
+		}
+	}
+
+	// Synthetic IDs are decreasing, existing entities come first.
+	ids := slices.Sorted(maps.Keys(changed))
+	slices.Reverse(ids)
+
+	for _, changedID := range ids {
+		data := changed[changedID]
+		if data == nil {
+			continue
+		}
+
+		var v T
+
+		// The data has been validated, when it has been added to the plan.
+		err := convertToStruct(data, &v)
+		if err != nil {
+			continue
+		}
+
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// encodeArgs returns the arguments as they are encoded for the server.
+func encodeArgs(args []any) ([]any, error) {
+	data, err := json.Marshal(args)
+	if err != nil {
+		return nil, fmt.Errorf("encode arguments: %w", err)
+	}
+
+	var encoded []any
+
+	err = json.Unmarshal(data, &encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decode arguments: %w", err)
+	}
+
+	return encoded, nil
+}
+
+// redactArgs returns a copy of the encoded arguments of the command, where
+// the values of all secret fields and passwords are masked, like the
+// snapshots of the audit records.
+func redactArgs(command string, args []any) []any {
+	kind, _, _ := CommandTarget(command, args)
+
+	var names map[string]bool
+
+	switch kind {
+	case ResourceMonitor:
+		names = secret.JSONNames(toAny(monitor.Types())...)
+
+	case ResourceNotification:
+		names = secret.JSONNames(toAny(notification.Types())...)
+
+	case ResourceProxy:
+		names = secret.JSONNames(proxy.Config{})
+
+	case ResourceDockerHost:
+		names = secret.JSONNames(dockerhost.Config{})
+
+	case ResourceRemoteBrowser:
+		names = secret.JSONNames(remotebrowser.Config{})
+
+	case ResourceSettings:
+		names = secret.JSONNames(settings.Settings{})
+
+	default:
+	}
+
+	redacted := make([]any, 0, len(args))
+	for i, arg := range args {
+		switch arg := arg.(type) {
+		case map[string]any:
+			m := maps.Clone(arg)
+			secret.RedactMap(m, names)
+			redacted = append(redacted, m)
+
+		case string:
+			// The second argument of setSettings is the password of the
+			// current user.
+			if command == "setSettings" && i == 1 && arg != "" {
+				redacted = append(redacted, secret.Mask)
+				continue
+			}
+
+			redacted = append(redacted, arg)
+
+		default:
+			redacted = append(redacted, arg)
+		}
+	}
+
+	return redacted
+}
+
+func toAny[T any](values []T) []any {
+	result := make([]any, 0, len(values))
+	for _, v := range values {
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// checkConvertible validates, that the data can be converted to the target.
+func checkConvertible(data map[string]any, target any) error {
+	err := convertToStruct(data, target)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+
+	return nil
+}
+
+func argMap(args []any, i int) (map[string]any, error) {
+	if i >= len(args) {
+		return nil, fmt.Errorf("%w: missing argument %d", ErrValidation, i)
+	}
+
+	data, ok := args[i].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: argument %d: unexpected type %T", ErrValidation, i, args[i])
+	}
+
+	return maps.Clone(data), nil
+}
+
+func argList(args []any, i int) ([]any, error) {
+	if i >= len(args) {
+		return nil, fmt.Errorf("%w: missing argument %d", ErrValidation, i)
+	}
+
+	if args[i] == nil {
+		return []any{}, nil
+	}
+
+	list, ok := args[i].([]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: argument %d: unexpected type %T", ErrValidation, i, args[i])
+	}
+
+	return slices.Clone(list), nil
+}
+
+func argInt64(args []any, i int) int64 {
+	if i >= len(args) {
+		return 0
+	}
+
+	return toInt64(args[i])
+}
+
+func argString(args []any, i int) string {
+	if i >= len(args) {
+		return ""
+	}
+
+	s, _ := args[i].(string)
+
+	return s
+}
+
+func mapInt64(data map[string]any, key string) int64 {
+	return toInt64(data[key])
+}
+
+func toInt64(v any) int64 {
+	switch n := v.(type) {
+	case float64:
+		return int64(n)
+
+	case int64:
+		return n
+
+	case int:
+		return int64(n)
+
+	default:
+		return 0
+	}
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,370 @@
+package kuma_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/maintenance"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/notification"
+	"github.com/breml/go-uptime-kuma-client/proxy"
+	"github.com/breml/go-uptime-kuma-client/settings"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+func TestDryRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 60*time.Second)
+	defer cancel()
+
+	existingID, err := client.CreateMonitor(ctx, &monitor.HTTP{
+		Base: monitor.Base{
+			Name:          "Dry Run Existing",
+			Interval:      60,
+			RetryInterval: 60,
+		},
+		HTTPDetails: monitor.HTTPDetails{
+			URL:    "https://example.com",
+			Method: "GET",
+		},
+	})
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.DeleteMonitor(ctx, existingID)
+	}()
+
+	dryRun, err := kuma.New(ctx, serverURL, "admin", "admin1",
+		kuma.WithDryRun(),
+		kuma.WithConnectTimeout(10*time.Second),
+	)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = dryRun.Disconnect()
+	}()
+
+	require.Nil(t, client.Plan())
+
+	monitorID, err := dryRun.CreateMonitor(ctx, &monitor.HTTP{
+		Base: monitor.Base{
+			Name:          "Dry Run New",
+			Interval:      60,
+			RetryInterval: 60,
+		},
+		HTTPDetails: monitor.HTTPDetails{
+			URL:    "https://example.org",
+			Method: "GET",
+		},
+	})
+	require.NoError(t, err)
+	require.Negative(t, monitorID)
+
+	tagID, err := dryRun.CreateTag(ctx, tag.Tag{Name: "dry-run", Color: "#ff0000"})
+	require.NoError(t, err)
+	require.Negative(t, tagID)
+
+	_, err = dryRun.AddMonitorTag(ctx, tagID, monitorID, "planned")
+	require.NoError(t, err)
+
+	var existing monitor.HTTP
+	err = dryRun.GetMonitorAs(ctx, existingID, &existing)
+	require.NoError(t, err)
+
+	existing.Name = "Dry Run Existing Renamed"
+	err = dryRun.UpdateMonitor(ctx, &existing)
+	require.NoError(t, err)
+
+	m, err := dryRun.CreateMaintenance(ctx, &maintenance.Maintenance{
+		Title:    "Dry Run Maintenance",
+		Strategy: "manual",
+		Active:   true,
+	})
+	require.NoError(t, err)
+	require.Negative(t, m.ID)
+
+	err = dryRun.SetMonitorMaintenance(ctx, m.ID, []int64{monitorID})
+	require.NoError(t, err)
+
+	err = dryRun.DeleteMonitor(ctx, 999999)
+	require.ErrorIs(t, err, kuma.ErrNotFound)
+
+	err = dryRun.AddStatusPage(ctx, "Dry Run", "not a slug")
+	require.ErrorIs(t, err, kuma.ErrValidation)
+
+	t.Run("plan", func(t *testing.T) {
+		commands := []string{}
+		for _, call := range dryRun.Plan().Calls() {
+			commands = append(commands, call.Command)
+		}
+
+		require.Equal(t, []string{
+			"add", "addTag", "addMonitorTag", "editMonitor", "addMaintenance", "addMonitorMaintenance",
+		}, commands)
+	})
+
+	t.Run("shadow cache", func(t *testing.T) {
+		mon, err := dryRun.GetMonitor(ctx, monitorID)
+		require.NoError(t, err)
+		require.Equal(t, "Dry Run New", mon.Name)
+		require.Len(t, mon.Tags, 1)
+		require.Equal(t, "planned", mon.Tags[0].Value)
+
+		monitors, err := dryRun.GetMonitors(ctx)
+		require.NoError(t, err)
+		require.Contains(t, monitorNames(monitors), "Dry Run New")
+		require.Contains(t, monitorNames(monitors), "Dry Run Existing Renamed")
+
+		monitorIDs, err := dryRun.GetMonitorMaintenance(ctx, m.ID)
+		require.NoError(t, err)
+		require.Equal(t, []int64{monitorID}, monitorIDs)
+	})
+
+	t.Run("server unchanged", func(t *testing.T) {
+		mon, err := client.GetMonitor(ctx, existingID)
+		require.NoError(t, err)
+		require.Equal(t, "Dry Run Existing", mon.Name)
+
+		monitors, err := client.GetMonitors(ctx)
+		require.NoError(t, err)
+		require.NotContains(t, monitorNames(monitors), "Dry Run New")
+
+		tags, err := client.GetTags(ctx)
+		require.NoError(t, err)
+
+		for _, tg := range tags {
+			require.NotEqual(t, "dry-run", tg.Name)
+		}
+	})
+}
+
+func monitorNames(monitors []monitor.Base) []string {
+	result := make([]string, 0, len(monitors))
+	for _, mon := range monitors {
+		result = append(result, mon.Name)
+	}
+
+	return result
+}
+
+func TestDryRunListed(t *testing.T) {
+	server := httptest.NewServer(&fakeSocketIOServer{
+		messages:    make(chan []byte, 20),
+		readyEvents: true,
+	})
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(func() {
+		cancel()
+		server.CloseClientConnections()
+		server.Close()
+	})
+
+	dryRun, err := kuma.New(ctx, server.URL, "admin", "admin1",
+		kuma.WithDryRun(),
+		kuma.WithConnectTimeout(5*time.Second),
+	)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = dryRun.Disconnect()
+	}()
+
+	notificationID, err := dryRun.CreateNotification(ctx, notification.Telegram{
+		Base: notification.Base{Name: "Dry Run Telegram"},
+		TelegramDetails: notification.TelegramDetails{
+			BotToken: "bot-token",
+			ChatID:   "123",
+		},
+	})
+	require.NoError(t, err)
+	require.Negative(t, notificationID)
+
+	notif, err := dryRun.GetNotification(ctx, notificationID)
+	require.NoError(t, err)
+	require.Equal(t, "Dry Run Telegram", notif.Name)
+	require.Equal(t, "telegram", notif.Type())
+
+	proxyID, err := dryRun.CreateProxy(ctx, proxy.Config{
+		Protocol: "http",
+		Host:     "proxy.example.com",
+		Port:     3128,
+	})
+	require.NoError(t, err)
+	require.Negative(t, proxyID)
+	require.NotEqual(t, notificationID, proxyID)
+
+	err = dryRun.UpdateProxy(ctx, proxy.Config{
+		ID:       proxyID,
+		Protocol: "http",
+		Host:     "proxy.example.org",
+		Port:     3128,
+	})
+	require.NoError(t, err)
+
+	p, err := dryRun.GetProxy(ctx, proxyID)
+	require.NoError(t, err)
+	require.Equal(t, "proxy.example.org", p.Host)
+
+	err = dryRun.DeleteProxy(ctx, proxyID)
+	require.NoError(t, err)
+
+	_, err = dryRun.GetProxy(ctx, proxyID)
+	require.ErrorIs(t, err, kuma.ErrNotFound)
+
+	err = dryRun.DeleteProxy(ctx, 999999)
+	require.ErrorIs(t, err, kuma.ErrNotFound)
+
+	calls := dryRun.Plan().Calls()
+	require.Len(t, calls, 4)
+	require.Equal(t, "addNotification", calls[0].Command)
+	require.Equal(t, notificationID, calls[0].ID)
+	require.Equal(t, "addProxy", calls[1].Command)
+	require.Equal(t, proxyID, calls[1].ID)
+	require.Equal(t, "addProxy", calls[2].Command)
+	require.Zero(t, calls[2].ID)
+	require.Equal(t, "deleteProxy", calls[3].Command)
+}
+
+func TestDryRunPlan_Redacted(t *testing.T) {
+	server := httptest.NewServer(&fakeSocketIOServer{
+		messages:    make(chan []byte, 20),
+		readyEvents: true,
+	})
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(func() {
+		cancel()
+		server.CloseClientConnections()
+		server.Close()
+	})
+
+	dryRun, err := kuma.New(ctx, server.URL, "admin", "admin1",
+		kuma.WithDryRun(),
+		kuma.WithConnectTimeout(5*time.Second),
+	)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = dryRun.Disconnect()
+	}()
+
+	_, err = dryRun.CreateNotification(ctx, notification.Telegram{
+		Base: notification.Base{Name: "Dry Run Telegram"},
+		TelegramDetails: notification.TelegramDetails{
+			BotToken: "bot-token",
+			ChatID:   "123",
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = dryRun.CreateProxy(ctx, proxy.Config{
+		Protocol: "http",
+		Host:     "proxy.example.com",
+		Port:     3128,
+		Auth:     true,
+		Username: "user",
+		Password: "proxy-secret",
+	})
+	require.NoError(t, err)
+
+	err = dryRun.SetSettings(ctx, settings.Settings{SteamAPIKey: "steam-key"}, "current-password")
+	require.NoError(t, err)
+
+	plan := dryRun.Plan().String()
+	require.Contains(t, plan, "proxy.example.com")
+	require.Contains(t, plan, "Dry Run Telegram")
+
+	for _, value := range []string{"bot-token", "proxy-secret", "steam-key", "current-password"} {
+		require.NotContains(t, plan, value)
+	}
+}
+
+func TestDryRun_Autosetup(t *testing.T) {
+	server := httptest.NewServer(&fakeSocketIOServer{
+		messages:      make(chan []byte, 20),
+		readyEvents:   true,
+		setupRequired: true,
+	})
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(func() {
+		cancel()
+		server.CloseClientConnections()
+		server.Close()
+	})
+
+	dryRun, err := kuma.New(ctx, server.URL, "admin", "admin1",
+		kuma.WithDryRun(),
+		kuma.WithAutosetup(),
+		kuma.WithConnectTimeout(5*time.Second),
+	)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = dryRun.Disconnect()
+	}()
+
+	require.Empty(t, dryRun.Plan().Calls())
+}
+
+func TestDryRun_CacheUnchanged(t *testing.T) {
+	const existing = `{"id":1,"name":"existing","type":"http","url":"https://example.com","interval":60,` +
+		`"retryInterval":60,"active":true,"tags":[],"notificationIDList":{},"accepted_statuscodes":["200-299"]}`
+
+	server := httptest.NewServer(&fakeSocketIOServer{
+		messages:    make(chan []byte, 20),
+		readyEvents: true,
+		monitorList: `{"1":` + existing + `}`,
+		events: []string{
+			`["heartbeatList","1",[{"monitorID":1,"status":1,"time":"2026-01-01 00:00:00","msg":"OK"}],true]`,
+		},
+		acks: map[string]string{
+			"getMonitor":     `{"ok":true,"monitor":` + existing + `}`,
+			"getMonitorList": `{"ok":true}`,
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(func() {
+		cancel()
+		server.CloseClientConnections()
+		server.Close()
+	})
+
+	dryRun, err := kuma.New(ctx, server.URL, "admin", "admin1",
+		kuma.WithDryRun(),
+		kuma.WithConnectTimeout(5*time.Second),
+	)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = dryRun.Disconnect()
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(dryRun.GetHeartbeats(ctx, 1)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	err = dryRun.DeleteMonitor(ctx, 1)
+	require.NoError(t, err)
+
+	_, err = dryRun.GetMonitor(ctx, 1)
+	require.ErrorIs(t, err, kuma.ErrNotFound)
+
+	monitors, err := dryRun.GetMonitors(ctx)
+	require.NoError(t, err)
+	require.Empty(t, monitors)
+
+	// The cache is kept as sent by the server.
+	require.Equal(t, 1, dryRun.CacheStats().Monitors)
+	require.Len(t, dryRun.GetHeartbeats(ctx, 1), 1)
+}
@@ -0,0 +1,211 @@
+package kuma
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// Errors for failed commands, which are mapped from the messages sent by
+// the server. Use errors.Is to check for them and errors.As with a
+// *ServerError to access the message of the server.
+var (
+	// ErrNotFound is returned when a requested resource is not found.
+	ErrNotFound = errors.New("not found")
+
+	// ErrUnauthorized is returned, if the client is not logged in or lacks
+	// the permission for a command.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrInvalidCredentials is returned, if the username, the password or the
+	// two-factor token is wrong.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+
+	// ErrTwoFactorRequired is returned by New, if two-factor authentication
+	// is enabled for the user.
+	ErrTwoFactorRequired = errors.New("two-factor authentication required")
+
+	// ErrValidation is returned, if the server rejects the values of a
+	// command. The field, if known, is available in ServerError.Field.
+	ErrValidation = errors.New("validation failed")
+
+	// ErrRateLimited is returned, if the server rejects a command because of
+	// too many requests.
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrServerBusy is returned, if the server is not able to process a
+	// command at the moment, e.g. because the database is locked. The
+	// command may succeed, if it is retried later.
+	ErrServerBusy = errors.New("server busy")
+
+	// ErrInternal is returned, if the server failed to process a command
+	// because of an internal error, e.g. a TypeError in the server.
+	ErrInternal = errors.New("internal server error")
+)
+
+// ServerError is the error for a command, which has not been acknowledged
+// with ok by the server.
+type ServerError struct {
+	// Command is the socket command, e.g. editMonitor.
+	Command string
+
+	// Msg is the message sent by the server. Depending on the command, the
+	// message is an i18n key, e.g. authIncorrectCreds, or plain text.
+	Msg string
+
+	// Field is the name of the invalid field for validation errors, if
+	// known, e.g. interval.
+	Field string
+
+	// Err is the error, the message is mapped to, e.g. ErrRateLimited. Nil,
+	// if the message is not known.
+	Err error
+}
+
+func (e *ServerError) Error() string {
+	msg := e.Msg
+	if msg == "" && e.Err != nil {
+		msg = e.Err.Error()
+	}
+
+	return e.Command + ": " + msg
+}
+
+func (e *ServerError) Unwrap() error {
+	return e.Err
+}
+
+// serverErrorRule maps the messages of the server, which match the rule,
+// to an error. A message matches, if it is equal to one of the i18n keys or
+// contains one of the texts. If commands is set, the rule only applies to
+// these commands.
+type serverErrorRule struct {
+	commands []string
+	keys     []string
+	texts    []string
+	err      error
+	field    string
+}
+
+func (r serverErrorRule) matches(command string, msg string) bool {
+	if len(r.commands) > 0 && !slices.Contains(r.commands, command) {
+		return false
+	}
+
+	for _, key := range r.keys {
+		if msg == key {
+			return true
+		}
+	}
+
+	for _, text := range r.texts {
+		if strings.Contains(msg, text) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// serverErrorRules returns the known messages of the server.
+func serverErrorRules() []serverErrorRule {
+	return []serverErrorRule{
+		{
+			keys:  []string{"authIncorrectCreds", "authInvalidToken"},
+			texts: []string{"Incorrect username or password", "Invalid Token"},
+			err:   ErrInvalidCredentials,
+		},
+		{
+			keys:  []string{"authUserInactiveOrDeleted"},
+			texts: []string{"You are not logged in", "Permission denied", "Access denied"},
+			err:   ErrUnauthorized,
+		},
+		{
+			keys:  []string{"tooManyRequests"},
+			texts: []string{"Too frequently, try again later"},
+			err:   ErrRateLimited,
+		},
+		{
+			texts: []string{"SQLITE_BUSY", "database is locked", "Timeout acquiring a connection"},
+			err:   ErrServerBusy,
+		},
+		{
+			texts: []string{"No slug?", "You do not own this monitor"},
+			err:   ErrNotFound,
+		},
+		{
+			// The server does not check, whether the entity exists, for these
+			// commands and fails with a TypeError, when it dereferences the
+			// missing entity, e.g. for getMonitor with an unknown ID. For all
+			// other commands, a TypeError is a bug in the server and does not
+			// tell, whether the entity exists.
+			commands: []string{
+				"getMonitor", "editMonitor", "deleteMonitor",
+				"getMaintenance", "editMaintenance", "deleteMaintenance",
+				"getStatusPage", "saveStatusPage", "deleteStatusPage",
+				"editTag", "deleteTag",
+			},
+			texts: []string{"Cannot read properties of null", "Cannot read properties of undefined"},
+			err:   ErrNotFound,
+		},
+		{
+			texts: []string{"Cannot read properties of null", "Cannot read properties of undefined"},
+			err:   ErrInternal,
+		},
+		{
+			texts: []string{"Interval cannot be"},
+			err:   ErrValidation,
+			field: "interval",
+		},
+		{
+			texts: []string{"Retry interval cannot be"},
+			err:   ErrValidation,
+			field: "retryInterval",
+		},
+		{
+			texts: []string{"Timeout cannot be", "Timeout must be"},
+			err:   ErrValidation,
+			field: "timeout",
+		},
+		{
+			texts: []string{"Provided Slug is not valid", "The slug is already taken"},
+			err:   ErrValidation,
+			field: "slug",
+		},
+		{
+			texts: []string{"Invalid URL"},
+			err:   ErrValidation,
+			field: "url",
+		},
+		{
+			texts: []string{"Invalid Cron", "Invalid cron"},
+			err:   ErrValidation,
+			field: "cron",
+		},
+	}
+}
+
+// newServerError returns the error for a command, which has not been
+// acknowledged with ok by the server.
+func newServerError(command string, response AckResponse) *ServerError {
+	e := &ServerError{
+		Command: command,
+		Msg:     response.Msg,
+	}
+
+	if response.TokenRequired {
+		e.Err = ErrTwoFactorRequired
+		return e
+	}
+
+	for _, rule := range serverErrorRules() {
+		if rule.matches(command, response.Msg) {
+			e.Err = rule.err
+			e.Field = rule.field
+
+			return e
+		}
+	}
+
+	return e
+}
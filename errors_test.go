@@ -0,0 +1,131 @@
+package kuma_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+)
+
+func TestServerError(t *testing.T) {
+	tests := []struct {
+		name     string
+		loginAck string
+
+		wantErr   error
+		wantMsg   string
+		wantField string
+	}{
+		{
+			name:     "invalid credentials",
+			loginAck: `{"ok":false,"msg":"authIncorrectCreds","msgi18n":true}`,
+			wantErr:  kuma.ErrInvalidCredentials,
+			wantMsg:  "authIncorrectCreds",
+		},
+		{
+			name:     "invalid credentials plain text",
+			loginAck: `{"ok":false,"msg":"Incorrect username or password."}`,
+			wantErr:  kuma.ErrInvalidCredentials,
+			wantMsg:  "Incorrect username or password.",
+		},
+		{
+			name:     "two-factor required",
+			loginAck: `{"tokenRequired":true}`,
+			wantErr:  kuma.ErrTwoFactorRequired,
+		},
+		{
+			name:     "rate limited",
+			loginAck: `{"ok":false,"msg":"Too frequently, try again later."}`,
+			wantErr:  kuma.ErrRateLimited,
+			wantMsg:  "Too frequently, try again later.",
+		},
+		{
+			name:     "unauthorized",
+			loginAck: `{"ok":false,"msg":"You are not logged in."}`,
+			wantErr:  kuma.ErrUnauthorized,
+			wantMsg:  "You are not logged in.",
+		},
+		{
+			name:     "server busy",
+			loginAck: `{"ok":false,"msg":"SQLITE_BUSY: database is locked"}`,
+			wantErr:  kuma.ErrServerBusy,
+			wantMsg:  "SQLITE_BUSY: database is locked",
+		},
+		{
+			name:      "validation",
+			loginAck:  `{"ok":false,"msg":"Interval cannot be less than 20 seconds"}`,
+			wantErr:   kuma.ErrValidation,
+			wantMsg:   "Interval cannot be less than 20 seconds",
+			wantField: "interval",
+		},
+		{
+			name:     "not found",
+			loginAck: `{"ok":false,"msg":"You do not own this monitor."}`,
+			wantErr:  kuma.ErrNotFound,
+			wantMsg:  "You do not own this monitor.",
+		},
+		{
+			// Only lookups of entities map a TypeError to ErrNotFound.
+			name:     "internal error",
+			loginAck: `{"ok":false,"msg":"Cannot read properties of null (reading 'toJSON')"}`,
+			wantErr:  kuma.ErrInternal,
+			wantMsg:  "Cannot read properties of null (reading 'toJSON')",
+		},
+		{
+			name:     "unknown",
+			loginAck: `{"ok":false,"msg":"Something went wrong."}`,
+			wantMsg:  "Something went wrong.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(&fakeSocketIOServer{
+				messages: make(chan []byte, 10),
+				loginAck: tc.loginAck,
+			})
+
+			t.Cleanup(func() {
+				server.CloseClientConnections()
+				server.Close()
+			})
+
+			_, err := kuma.New(t.Context(), server.URL, "admin", "admin1",
+				kuma.WithConnectTimeout(500*time.Millisecond),
+			)
+			require.Error(t, err)
+
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+			}
+
+			var serverErr *kuma.ServerError
+			require.ErrorAs(t, err, &serverErr)
+			require.Equal(t, "login", serverErr.Command)
+			require.Equal(t, tc.wantMsg, serverErr.Msg)
+			require.Equal(t, tc.wantField, serverErr.Field)
+		})
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	defer cancel()
+
+	_, err := client.GetMonitor(ctx, 999999)
+	require.ErrorIs(t, err, kuma.ErrNotFound)
+
+	_, err = client.GetMaintenance(ctx, 999999)
+	require.ErrorIs(t, err, kuma.ErrNotFound)
+
+	_, err = client.GetStatusPage(ctx, "does-not-exist")
+	require.ErrorIs(t, err, kuma.ErrNotFound)
+}
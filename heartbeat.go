@@ -0,0 +1,223 @@
+package kuma
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/google/uuid"
+	socketio "github.com/maldikhan/go.socket.io/socket.io/v5/client"
+
+	"github.com/breml/go-uptime-kuma-client/heartbeat"
+)
+
+// maxHeartbeats is the number of heartbeats cached per monitor, which
+// matches the number of heartbeats sent by Uptime Kuma after login.
+const maxHeartbeats = 100
+
+// heartbeatState is the cache of the live events of the monitors.
+type heartbeatState struct {
+	heartbeats          map[int64][]heartbeat.Heartbeat
+	importantHeartbeats map[int64][]heartbeat.Heartbeat
+	uptime              map[int64]map[heartbeat.Period]float64
+	avgPing             map[int64]*float64
+}
+
+func newHeartbeatState() heartbeatState {
+	return heartbeatState{
+		heartbeats:          map[int64][]heartbeat.Heartbeat{},
+		importantHeartbeats: map[int64][]heartbeat.Heartbeat{},
+		uptime:              map[int64]map[heartbeat.Period]float64{},
+		avgPing:             map[int64]*float64{},
+	}
+}
+
+// delete removes the cached events of the monitor.
+func (s heartbeatState) delete(monitorID int64) {
+	delete(s.heartbeats, monitorID)
+	delete(s.importantHeartbeats, monitorID)
+	delete(s.uptime, monitorID)
+	delete(s.avgPing, monitorID)
+}
+
+// appendHeartbeats appends the heartbeats to list and keeps the most recent
+// maxHeartbeats heartbeats.
+func appendHeartbeats(list []heartbeat.Heartbeat, heartbeats ...heartbeat.Heartbeat) []heartbeat.Heartbeat {
+	list = append(list, heartbeats...)
+	if len(list) > maxHeartbeats {
+		list = slices.Clone(list[len(list)-maxHeartbeats:])
+	}
+
+	return list
+}
+
+// handleHeartbeatEvents registers the handlers for the heartbeat, uptime and
+// average ping events of the monitors.
+//
+// Uptime Kuma sends the monitor ID of the list and statistic events after
+// login as string, for live updates as number. json.Number accepts both.
+func (c *Client) handleHeartbeatEvents(client *socketio.Client) {
+	client.On("heartbeatList", func(monitorID json.Number, heartbeats []heartbeat.Heartbeat, overwrite bool) {
+		id, err := monitorID.Int64()
+		if err != nil {
+			return
+		}
+
+		c.mu.Lock()
+		if overwrite {
+			c.state.heartbeat.heartbeats[id] = nil
+		}
+
+		c.state.heartbeat.heartbeats[id] = appendHeartbeats(c.state.heartbeat.heartbeats[id], heartbeats...)
+		c.mu.Unlock()
+
+		c.heartbeatEvents.Emit(context.Background(), heartbeat.Event{
+			Type:       heartbeat.EventHeartbeatList,
+			MonitorID:  id,
+			Heartbeats: heartbeats,
+		})
+	})
+
+	client.On("importantHeartbeatList", func(monitorID json.Number, heartbeats []heartbeat.Heartbeat, overwrite bool) {
+		id, err := monitorID.Int64()
+		if err != nil {
+			return
+		}
+
+		// Uptime Kuma sends the important heartbeats newest first.
+		heartbeats = slices.Clone(heartbeats)
+		slices.SortStableFunc(heartbeats, func(a, b heartbeat.Heartbeat) int { return a.Time.Compare(b.Time) })
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		if overwrite {
+			c.state.heartbeat.importantHeartbeats[id] = nil
+		}
+
+		c.state.heartbeat.importantHeartbeats[id] = appendHeartbeats(
+			c.state.heartbeat.importantHeartbeats[id], heartbeats...,
+		)
+	})
+
+	client.On("heartbeat", func(hb heartbeat.Heartbeat) {
+		c.mu.Lock()
+		c.state.heartbeat.heartbeats[hb.MonitorID] = appendHeartbeats(c.state.heartbeat.heartbeats[hb.MonitorID], hb)
+
+		if hb.Important {
+			c.state.heartbeat.importantHeartbeats[hb.MonitorID] = appendHeartbeats(
+				c.state.heartbeat.importantHeartbeats[hb.MonitorID], hb,
+			)
+		}
+		c.mu.Unlock()
+
+		c.heartbeatEvents.Emit(context.Background(), heartbeat.Event{
+			Type:       heartbeat.EventHeartbeat,
+			MonitorID:  hb.MonitorID,
+			Heartbeats: []heartbeat.Heartbeat{hb},
+		})
+	})
+
+	client.On("uptime", func(monitorID json.Number, period any, uptime *float64) {
+		id, err := monitorID.Int64()
+		if err != nil || uptime == nil {
+			return
+		}
+
+		p := heartbeat.ParsePeriod(period)
+
+		c.mu.Lock()
+		if c.state.heartbeat.uptime[id] == nil {
+			c.state.heartbeat.uptime[id] = map[heartbeat.Period]float64{}
+		}
+
+		c.state.heartbeat.uptime[id][p] = *uptime
+		c.mu.Unlock()
+
+		c.heartbeatEvents.Emit(context.Background(), heartbeat.Event{
+			Type:      heartbeat.EventUptime,
+			MonitorID: id,
+			Period:    p,
+			Uptime:    *uptime,
+		})
+	})
+
+	client.On("avgPing", func(monitorID json.Number, avgPing *float64) {
+		id, err := monitorID.Int64()
+		if err != nil {
+			return
+		}
+
+		c.mu.Lock()
+		c.state.heartbeat.avgPing[id] = avgPing
+		c.mu.Unlock()
+
+		c.heartbeatEvents.Emit(context.Background(), heartbeat.Event{
+			Type:      heartbeat.EventAvgPing,
+			MonitorID: id,
+			AvgPing:   avgPing,
+		})
+	})
+}
+
+// GetHeartbeats returns the recent heartbeats of the monitor from the client
+// cache, oldest first. Uptime Kuma sends the last 100 heartbeats of every
+// monitor after login, later heartbeats are added as they are received.
+func (c *Client) GetHeartbeats(_ context.Context, monitorID int64) []heartbeat.Heartbeat {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.Clone(c.state.heartbeat.heartbeats[monitorID])
+}
+
+// GetImportantHeartbeats returns the recent heartbeats of the monitor from the
+// client cache, which changed the status of the monitor, oldest first.
+func (c *Client) GetImportantHeartbeats(_ context.Context, monitorID int64) []heartbeat.Heartbeat {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.Clone(c.state.heartbeat.importantHeartbeats[monitorID])
+}
+
+// GetUptime returns the uptime of the monitor for the period from the client
+// cache as ratio between 0 and 1. The second return value is false, if the
+// uptime has not been received yet.
+func (c *Client) GetUptime(_ context.Context, monitorID int64, period heartbeat.Period) (float64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	uptime, ok := c.state.heartbeat.uptime[monitorID][period]
+
+	return uptime, ok
+}
+
+// GetAvgPing returns the average response time of the monitor in
+// milliseconds from the client cache. The second return value is false, if
+// the average response time is not known.
+func (c *Client) GetAvgPing(_ context.Context, monitorID int64) (float64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	avgPing := c.state.heartbeat.avgPing[monitorID]
+	if avgPing == nil {
+		return 0, false
+	}
+
+	return *avgPing, true
+}
+
+// SubscribeHeartbeats calls handler for every heartbeat, uptime and average
+// ping event received from the server, until ctx is done. The client cache
+// is updated before handler is called. The handler must not block, since the
+// processing of further events waits for it to return.
+func (c *Client) SubscribeHeartbeats(ctx context.Context, handler func(heartbeat.Event)) {
+	listenerID := uuid.New().String()
+
+	c.heartbeatEvents.AddListener(func(_ context.Context, event heartbeat.Event) {
+		handler(event)
+	}, listenerID)
+
+	context.AfterFunc(ctx, func() {
+		c.heartbeatEvents.RemoveListener(listenerID)
+	})
+}
@@ -0,0 +1,230 @@
+// Package heartbeat provides the types for the heartbeats of Uptime Kuma
+// monitors and the live events, which are sent by the server, when a monitor
+// is checked.
+package heartbeat
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Status is the status of a monitor reported by a heartbeat.
+type Status int
+
+// Status values as used by Uptime Kuma.
+const (
+	StatusDown        Status = 0
+	StatusUp          Status = 1
+	StatusPending     Status = 2
+	StatusMaintenance Status = 3
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusDown:
+		return "down"
+
+	case StatusUp:
+		return "up"
+
+	case StatusPending:
+		return "pending"
+
+	case StatusMaintenance:
+		return "maintenance"
+
+	default:
+		return "unknown"
+	}
+}
+
+// Heartbeat is the result of a single check of a monitor.
+type Heartbeat struct {
+	MonitorID int64  `json:"monitorID"`
+	Status    Status `json:"status"`
+
+	// Time is the time of the check in UTC.
+	Time time.Time `json:"time"`
+
+	// Msg is the message of the check, e.g. the error message or the HTTP
+	// status text.
+	Msg string `json:"msg"`
+
+	// Ping is the response time in milliseconds. Nil, if the monitor type
+	// does not measure the response time or the check failed.
+	Ping *float64 `json:"ping"`
+
+	// Important is true, if the status differs from the status of the
+	// previous heartbeat.
+	Important bool `json:"important"`
+
+	// Duration is the time in seconds since the previous heartbeat.
+	Duration int64 `json:"duration"`
+
+	// Retries is the number of retries before the status changed.
+	Retries int `json:"retries"`
+}
+
+// timeLayouts are the formats of the heartbeat time. Uptime Kuma sends the
+// time in UTC without time zone.
+//
+//nolint:gochecknoglobals // list of time layouts.
+var timeLayouts = []string{
+	"2006-01-02 15:04:05.000",
+	"2006-01-02 15:04:05",
+	time.RFC3339Nano,
+}
+
+// UnmarshalJSON decodes a heartbeat as sent by the heartbeat event as well
+// as the rows of the heartbeat table, which are sent by the heartbeatList
+// event, e.g. monitor_id instead of monitorID and important as 0 or 1.
+func (h *Heartbeat) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		MonitorID       *int64          `json:"monitorID"`
+		MonitorIDColumn *int64          `json:"monitor_id"`
+		Status          Status          `json:"status"`
+		Time            string          `json:"time"`
+		Msg             *string         `json:"msg"`
+		Ping            *float64        `json:"ping"`
+		Important       json.RawMessage `json:"important"`
+		Duration        *int64          `json:"duration"`
+		Retries         *int            `json:"retries"`
+	}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return fmt.Errorf("decode heartbeat: %w", err)
+	}
+
+	*h = Heartbeat{
+		Status: raw.Status,
+		Ping:   raw.Ping,
+	}
+
+	switch {
+	case raw.MonitorID != nil:
+		h.MonitorID = *raw.MonitorID
+
+	case raw.MonitorIDColumn != nil:
+		h.MonitorID = *raw.MonitorIDColumn
+
+	default:
+	}
+
+	if raw.Msg != nil {
+		h.Msg = *raw.Msg
+	}
+
+	if raw.Duration != nil {
+		h.Duration = *raw.Duration
+	}
+
+	if raw.Retries != nil {
+		h.Retries = *raw.Retries
+	}
+
+	h.Important, err = parseBool(raw.Important)
+	if err != nil {
+		return fmt.Errorf("decode heartbeat: important: %w", err)
+	}
+
+	if raw.Time != "" {
+		h.Time, err = parseTime(raw.Time)
+		if err != nil {
+			return fmt.Errorf("decode heartbeat: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// parseBool parses a boolean, which is encoded either as JSON boolean or as
+// number.
+func parseBool(data json.RawMessage) (bool, error) {
+	switch string(data) {
+	case "", "null", "false", "0":
+		return false, nil
+
+	case "true", "1":
+		return true, nil
+
+	default:
+		return false, fmt.Errorf("invalid boolean %s", data)
+	}
+}
+
+func parseTime(value string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		t, err := time.ParseInLocation(layout, value, time.UTC)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q", value)
+}
+
+// Period is the period, for which the uptime of a monitor is calculated.
+type Period string
+
+// Periods of the uptime sent by Uptime Kuma.
+const (
+	Period24h Period = "24"
+	Period30d Period = "720"
+	Period1y  Period = "1y"
+)
+
+// ParsePeriod returns the period of an uptime event, which is either the
+// number of hours or a string like "1y".
+func ParsePeriod(value any) Period {
+	switch v := value.(type) {
+	case float64:
+		return Period(strconv.FormatFloat(v, 'f', -1, 64))
+
+	case string:
+		return Period(v)
+
+	default:
+		return Period(fmt.Sprint(v))
+	}
+}
+
+// EventType is the type of a live event.
+type EventType int
+
+// Types of the live events.
+const (
+	// EventHeartbeat is sent for every check of a monitor.
+	EventHeartbeat EventType = iota
+
+	// EventHeartbeatList is sent with the recent heartbeats of a monitor,
+	// e.g. after login.
+	EventHeartbeatList
+
+	// EventUptime is sent with the uptime of a monitor for a period.
+	EventUptime
+
+	// EventAvgPing is sent with the average response time of a monitor.
+	EventAvgPing
+)
+
+// Event is a live event for a monitor sent by the server.
+type Event struct {
+	Type      EventType
+	MonitorID int64
+
+	// Heartbeats contains the heartbeat for EventHeartbeat and the recent
+	// heartbeats, oldest first, for EventHeartbeatList.
+	Heartbeats []Heartbeat
+
+	// Period and Uptime are set for EventUptime. Uptime is the ratio of
+	// successful checks between 0 and 1.
+	Period Period
+	Uptime float64
+
+	// AvgPing is the average response time in milliseconds for
+	// EventAvgPing. Nil, if the monitor has no response times.
+	AvgPing *float64
+}
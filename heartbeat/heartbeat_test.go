@@ -0,0 +1,98 @@
+package heartbeat_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/heartbeat"
+	"github.com/breml/go-uptime-kuma-client/internal/ptr"
+)
+
+func TestHeartbeat_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+
+		want    heartbeat.Heartbeat
+		wantErr bool
+	}{
+		{
+			name: "heartbeat event",
+			data: `{"monitorID":3,"status":1,"time":"2025-03-01 12:30:15.123","msg":"200 - OK",` +
+				`"ping":42,"important":true,"duration":60,"retries":0}`,
+			want: heartbeat.Heartbeat{
+				MonitorID: 3,
+				Status:    heartbeat.StatusUp,
+				Time:      time.Date(2025, 3, 1, 12, 30, 15, 123000000, time.UTC),
+				Msg:       "200 - OK",
+				Ping:      ptr.To(42.0),
+				Important: true,
+				Duration:  60,
+			},
+		},
+		{
+			name: "heartbeat table row",
+			data: `{"id":17,"monitor_id":3,"status":0,"time":"2025-03-01 12:31:15","msg":"timeout",` +
+				`"ping":null,"important":0,"duration":60,"down_count":1,"end_time":null,"retries":2}`,
+			want: heartbeat.Heartbeat{
+				MonitorID: 3,
+				Status:    heartbeat.StatusDown,
+				Time:      time.Date(2025, 3, 1, 12, 31, 15, 0, time.UTC),
+				Msg:       "timeout",
+				Duration:  60,
+				Retries:   2,
+			},
+		},
+		{
+			name: "important as number",
+			data: `{"monitor_id":3,"status":2,"msg":null,"important":1}`,
+			want: heartbeat.Heartbeat{
+				MonitorID: 3,
+				Status:    heartbeat.StatusPending,
+				Important: true,
+			},
+		},
+		{
+			name:    "invalid time",
+			data:    `{"monitorID":3,"status":1,"time":"yesterday"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid important",
+			data:    `{"monitorID":3,"status":1,"important":"yes"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got heartbeat.Heartbeat
+
+			err := json.Unmarshal([]byte(tc.data), &got)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestStatus_String(t *testing.T) {
+	require.Equal(t, "down", heartbeat.StatusDown.String())
+	require.Equal(t, "up", heartbeat.StatusUp.String())
+	require.Equal(t, "pending", heartbeat.StatusPending.String())
+	require.Equal(t, "maintenance", heartbeat.StatusMaintenance.String())
+	require.Equal(t, "unknown", heartbeat.Status(7).String())
+}
+
+func TestParsePeriod(t *testing.T) {
+	require.Equal(t, heartbeat.Period24h, heartbeat.ParsePeriod(24.0))
+	require.Equal(t, heartbeat.Period30d, heartbeat.ParsePeriod(720.0))
+	require.Equal(t, heartbeat.Period1y, heartbeat.ParsePeriod("1y"))
+}
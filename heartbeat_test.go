@@ -0,0 +1,77 @@
+package kuma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/heartbeat"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func TestClient_Heartbeats(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 60*time.Second)
+	defer cancel()
+
+	received := make(chan heartbeat.Heartbeat, 10)
+
+	subscribeCtx, unsubscribe := context.WithCancel(ctx)
+	defer unsubscribe()
+
+	client.SubscribeHeartbeats(subscribeCtx, func(event heartbeat.Event) {
+		if event.Type != heartbeat.EventHeartbeat {
+			return
+		}
+
+		select {
+		case received <- event.Heartbeats[0]:
+		default:
+		}
+	})
+
+	httpMonitor := monitor.HTTP{
+		Base: monitor.Base{
+			Name:          "heartbeat-test",
+			Interval:      20,
+			RetryInterval: 20,
+			IsActive:      true,
+		},
+		HTTPDetails: monitor.HTTPDetails{
+			URL:                 "http://localhost:3001",
+			Timeout:             16,
+			Method:              "GET",
+			MaxRedirects:        10,
+			AcceptedStatusCodes: []string{"200-299"},
+			AuthMethod:          monitor.AuthMethodNone,
+		},
+	}
+
+	monitorID, err := client.CreateMonitor(ctx, &httpMonitor)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.DeleteMonitor(ctx, monitorID)
+	}()
+
+	var hb heartbeat.Heartbeat
+	for hb.MonitorID != monitorID {
+		select {
+		case hb = <-received:
+		case <-ctx.Done():
+			t.Fatal("no heartbeat received")
+		}
+	}
+
+	require.False(t, hb.Time.IsZero())
+	require.Contains(t, client.GetHeartbeats(ctx, monitorID), hb)
+
+	err = client.DeleteMonitor(ctx, monitorID)
+	require.NoError(t, err)
+	require.Empty(t, client.GetHeartbeats(ctx, monitorID))
+}
@@ -0,0 +1,495 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/breml/go-uptime-kuma-client/internal/ptr"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+// BlackboxTarget is a target probed by the Prometheus blackbox_exporter with
+// a given module.
+type BlackboxTarget struct {
+	// Job is the name of the Prometheus scrape job.
+	Job string
+
+	// Module is the name of the blackbox_exporter module.
+	Module string
+
+	// Target is the probed target, e.g. an URL for the http prober or
+	// host:port for the tcp prober.
+	Target string
+
+	// Interval is the scrape interval. If zero, the default interval is used.
+	Interval time.Duration
+}
+
+type blackboxConfig struct {
+	Modules map[string]yaml.Node `yaml:"modules"`
+}
+
+type blackboxModule struct {
+	Prober  string        `yaml:"prober"`
+	Timeout time.Duration `yaml:"timeout"`
+	HTTP    blackboxHTTP  `yaml:"http"`
+	TCP     blackboxTCP   `yaml:"tcp"`
+	ICMP    blackboxICMP  `yaml:"icmp"`
+	DNS     blackboxDNS   `yaml:"dns"`
+}
+
+type blackboxHTTP struct {
+	ValidStatusCodes           []int              `yaml:"valid_status_codes"`
+	Method                     string             `yaml:"method"`
+	Headers                    map[string]string  `yaml:"headers"`
+	Body                       string             `yaml:"body"`
+	NoFollowRedirects          bool               `yaml:"no_follow_redirects"`
+	FollowRedirects            *bool              `yaml:"follow_redirects"`
+	FailIfBodyMatchesRegexp    []string           `yaml:"fail_if_body_matches_regexp"`
+	FailIfBodyNotMatchesRegexp []string           `yaml:"fail_if_body_not_matches_regexp"`
+	TLSConfig                  blackboxTLSConfig  `yaml:"tls_config"`
+	BasicAuth                  *blackboxBasicAuth `yaml:"basic_auth"`
+	BearerToken                string             `yaml:"bearer_token"`
+}
+
+type blackboxTLSConfig struct {
+	InsecureSkipVerify bool `yaml:"insecure_skip_verify"`
+}
+
+type blackboxBasicAuth struct {
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+type blackboxTCP struct {
+	TLS bool `yaml:"tls"`
+}
+
+type blackboxICMP struct {
+	PayloadSize int `yaml:"payload_size"`
+}
+
+type blackboxDNS struct {
+	QueryName         string         `yaml:"query_name"`
+	QueryType         string         `yaml:"query_type"`
+	ValidRcodes       []string       `yaml:"valid_rcodes"`
+	ValidateAnswerRRs blackboxDNSRRs `yaml:"validate_answer_rrs"`
+}
+
+type blackboxDNSRRs struct {
+	FailIfMatchesRegexp    []string `yaml:"fail_if_matches_regexp"`
+	FailIfNotMatchesRegexp []string `yaml:"fail_if_not_matches_regexp"`
+}
+
+// blackboxKeys are the keys of the blackbox_exporter module configuration,
+// which are mapped or have no effect on the check itself.
+//
+//nolint:gochecknoglobals // list of known keys.
+var blackboxKeys = map[string][]string{
+	"": {"prober", "timeout", "http", "tcp", "icmp", "dns"},
+	"http": {
+		"valid_status_codes", "method", "headers", "body", "no_follow_redirects", "follow_redirects",
+		"fail_if_body_matches_regexp", "fail_if_body_not_matches_regexp", "tls_config", "basic_auth",
+		"bearer_token", "preferred_ip_protocol", "ip_protocol_fallback", "valid_http_versions",
+	},
+	"tcp":  {"tls", "preferred_ip_protocol", "ip_protocol_fallback"},
+	"icmp": {"payload_size", "preferred_ip_protocol", "ip_protocol_fallback"},
+	"dns": {
+		"query_name", "query_type", "valid_rcodes", "validate_answer_rrs", "preferred_ip_protocol",
+		"ip_protocol_fallback", "transport_protocol",
+	},
+}
+
+// FromBlackbox converts the targets probed by the Prometheus
+// blackbox_exporter to monitors. The modules are read from the
+// blackbox_exporter configuration file, the targets can be read from a
+// Prometheus configuration with ParseBlackboxTargets.
+//
+// Targets of modules with the http prober are converted to HTTP monitors, or
+// to HTTP keyword monitors, if the body is matched against a literal. The tcp
+// prober is converted to TCP port monitors, the icmp prober to ping monitors
+// and the dns prober to DNS monitors. Settings without an equivalent in
+// Uptime Kuma, like regular expressions, are listed in the report.
+func FromBlackbox(modules io.Reader, targets []BlackboxTarget) (Result, error) {
+	var cfg blackboxConfig
+
+	err := yaml.NewDecoder(modules).Decode(&cfg)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return Result{}, fmt.Errorf("decode blackbox config: %w", err)
+	}
+
+	parsed := make(map[string]blackboxModule, len(cfg.Modules))
+	for name, node := range cfg.Modules {
+		var module blackboxModule
+		err = node.Decode(&module)
+		if err != nil {
+			return Result{}, fmt.Errorf("decode blackbox module %q: %w", name, err)
+		}
+
+		parsed[name] = module
+	}
+
+	var result Result
+	reported := map[string]bool{}
+
+	for _, target := range targets {
+		source := target.Module + "/" + target.Target
+		if target.Job != "" {
+			source = target.Job + "/" + source
+		}
+
+		module, ok := parsed[target.Module]
+		if !ok {
+			result.Report.skip(source, "module %q not found", target.Module)
+			continue
+		}
+
+		// Unknown settings are reported once per module.
+		if !reported[target.Module] {
+			reportBlackboxKeys(&result.Report, target.Module, cfg.Modules[target.Module])
+			reported[target.Module] = true
+		}
+
+		mon, ok := convertBlackboxTarget(module, target, source, &result.Report)
+		if !ok {
+			continue
+		}
+
+		result.Items = append(result.Items, Item{
+			Source:  source,
+			Group:   target.Job,
+			Monitor: mon,
+		})
+	}
+
+	return result, nil
+}
+
+func convertBlackboxTarget(
+	module blackboxModule,
+	target BlackboxTarget,
+	source string,
+	report *Report,
+) (monitor.Monitor, bool) {
+	base := newBase(target.Target, target.Interval)
+
+	switch module.Prober {
+	case "http":
+		return convertBlackboxHTTP(module, target, base, source, report), true
+
+	case "tcp":
+		host, portStr, err := net.SplitHostPort(target.Target)
+		if err != nil {
+			report.skip(source, "invalid target %q: %v", target.Target, err)
+			return nil, false
+		}
+
+		port, err := strconv.Atoi(portStr)
+		if err != nil {
+			report.skip(source, "invalid port in target %q", target.Target)
+			return nil, false
+		}
+
+		details := monitor.TCPPortDetails{Hostname: host, Port: port}
+		if module.TCP.TLS {
+			details.SMTPSecurity = ptr.To("secure")
+		}
+
+		return &monitor.TCPPort{Base: base, TCPPortDetails: details}, true
+
+	case "icmp":
+		details := monitor.PingDetails{Hostname: target.Target, PacketSize: defaultPacketSize}
+		if module.ICMP.PayloadSize > 0 {
+			details.PacketSize = module.ICMP.PayloadSize
+		}
+
+		if module.Timeout > 0 {
+			details.Timeout = ptr.To(timeoutSeconds(module.Timeout))
+		}
+
+		return &monitor.Ping{Base: base, PingDetails: details}, true
+
+	case "dns":
+		return convertBlackboxDNS(module, target, base, source, report)
+
+	default:
+		report.skip(source, "unsupported prober %q", module.Prober)
+		return nil, false
+	}
+}
+
+func convertBlackboxHTTP(
+	module blackboxModule,
+	target BlackboxTarget,
+	base monitor.Base,
+	source string,
+	report *Report,
+) monitor.Monitor {
+	cfg := module.HTTP
+
+	details := newHTTPDetails(target.Target)
+	details.IgnoreTLS = cfg.TLSConfig.InsecureSkipVerify
+	details.Body = cfg.Body
+
+	if cfg.Method != "" {
+		details.Method = strings.ToUpper(cfg.Method)
+	}
+
+	if module.Timeout > 0 {
+		details.Timeout = timeoutSeconds(module.Timeout)
+	}
+
+	if cfg.NoFollowRedirects || (cfg.FollowRedirects != nil && !*cfg.FollowRedirects) {
+		details.MaxRedirects = 0
+	}
+
+	if len(cfg.ValidStatusCodes) > 0 {
+		details.AcceptedStatusCodes = make([]string, 0, len(cfg.ValidStatusCodes))
+		for _, code := range cfg.ValidStatusCodes {
+			details.AcceptedStatusCodes = append(details.AcceptedStatusCodes, strconv.Itoa(code))
+		}
+	}
+
+	headers, err := encodeHeaders(cfg.Headers)
+	if err != nil {
+		report.add(source, "http.headers", "%v", err)
+	}
+
+	details.Headers = headers
+
+	switch {
+	case cfg.BasicAuth != nil:
+		details.AuthMethod = monitor.AuthMethodBasic
+		details.BasicAuthUser = cfg.BasicAuth.Username
+		details.BasicAuthPass = cfg.BasicAuth.Password
+
+	case cfg.BearerToken != "":
+		details.AuthMethod = monitor.AuthMethodBearer
+		details.BearerToken = cfg.BearerToken
+
+	default:
+	}
+
+	var keyword monitor.HTTPKeywordDetails
+
+	matchers := []struct {
+		field   string
+		regexps []string
+		invert  bool
+	}{
+		{field: "http.fail_if_body_not_matches_regexp", regexps: cfg.FailIfBodyNotMatchesRegexp},
+		{field: "http.fail_if_body_matches_regexp", regexps: cfg.FailIfBodyMatchesRegexp, invert: true},
+	}
+
+	for _, matcher := range matchers {
+		for _, expr := range matcher.regexps {
+			literal, ok := literalRegexp(expr)
+			if !ok || keyword.Keyword != "" {
+				report.add(source, matcher.field, "only a single literal body match is supported: %q", expr)
+				continue
+			}
+
+			keyword.Keyword = literal
+			keyword.InvertKeyword = matcher.invert
+		}
+	}
+
+	return httpMonitor(base, details, keyword)
+}
+
+func convertBlackboxDNS(
+	module blackboxModule,
+	target BlackboxTarget,
+	base monitor.Base,
+	source string,
+	report *Report,
+) (monitor.Monitor, bool) {
+	cfg := module.DNS
+
+	resolver, port, ok := splitResolver(target.Target)
+	if !ok {
+		report.skip(source, "invalid port in target %q", target.Target)
+		return nil, false
+	}
+
+	resolveType := monitor.DNSResolveType(strings.ToUpper(cfg.QueryType))
+	if resolveType == "" || resolveType == "ANY" {
+		report.add(source, "dns.query_type", "query type ANY is not supported, using A")
+		resolveType = monitor.DNSResolveTypeA
+	}
+
+	for _, rcode := range cfg.ValidRcodes {
+		if rcode != "NOERROR" {
+			report.add(source, "dns.valid_rcodes", "only the rcode NOERROR is supported: %q", rcode)
+		}
+	}
+
+	details := monitor.DNSDetails{
+		Hostname:       strings.TrimSuffix(cfg.QueryName, "."),
+		ResolverServer: resolver,
+		ResolveType:    resolveType,
+		Port:           port,
+	}
+
+	matchers := []struct {
+		field    string
+		regexps  []string
+		operator string
+	}{
+		{
+			field:    "dns.validate_answer_rrs.fail_if_not_matches_regexp",
+			regexps:  cfg.ValidateAnswerRRs.FailIfNotMatchesRegexp,
+			operator: "contains",
+		},
+		{
+			field:    "dns.validate_answer_rrs.fail_if_matches_regexp",
+			regexps:  cfg.ValidateAnswerRRs.FailIfMatchesRegexp,
+			operator: "not_contains",
+		},
+	}
+
+	for _, matcher := range matchers {
+		for _, expr := range matcher.regexps {
+			literal, ok := literalRegexp(expr)
+			if !ok {
+				report.add(source, matcher.field, "only literal matches are supported: %q", expr)
+				continue
+			}
+
+			details.Conditions = append(details.Conditions, monitor.Condition{
+				Variable: "record",
+				Operator: matcher.operator,
+				Value:    literal,
+				AndOr:    monitor.ConditionAnd,
+			})
+		}
+	}
+
+	return &monitor.DNS{Base: base, DNSDetails: details}, true
+}
+
+// literalRegexp returns the literal, if the regular expression matches only
+// the literal.
+func literalRegexp(expr string) (string, bool) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return "", false
+	}
+
+	literal, complete := re.LiteralPrefix()
+
+	return literal, complete && literal != ""
+}
+
+// reportBlackboxKeys reports the unsupported settings of a blackbox_exporter
+// module.
+func reportBlackboxKeys(report *Report, module string, node yaml.Node) {
+	reportUnknownKeys(report, module, "", &node, blackboxKeys[""])
+
+	for _, prober := range []string{"http", "tcp", "icmp", "dns"} {
+		var section yaml.Node
+		if findKey(&node, prober, &section) {
+			reportUnknownKeys(report, module, prober+".", &section, blackboxKeys[prober])
+		}
+	}
+}
+
+type prometheusConfig struct {
+	Global struct {
+		ScrapeInterval string `yaml:"scrape_interval"`
+	} `yaml:"global"`
+	ScrapeConfigs []struct {
+		JobName        string              `yaml:"job_name"`
+		ScrapeInterval string              `yaml:"scrape_interval"`
+		Params         map[string][]string `yaml:"params"`
+		StaticConfigs  []struct {
+			Targets []string `yaml:"targets"`
+		} `yaml:"static_configs"`
+	} `yaml:"scrape_configs"`
+}
+
+// ParseBlackboxTargets reads the blackbox_exporter targets from a Prometheus
+// configuration. Scrape configs without a module parameter are ignored, as
+// well as targets from service discovery other than static_configs.
+func ParseBlackboxTargets(r io.Reader) ([]BlackboxTarget, error) {
+	var cfg prometheusConfig
+
+	err := yaml.NewDecoder(r).Decode(&cfg)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("decode prometheus config: %w", err)
+	}
+
+	globalInterval, err := parsePrometheusDuration(cfg.Global.ScrapeInterval)
+	if err != nil {
+		return nil, fmt.Errorf("decode prometheus config: global scrape interval: %w", err)
+	}
+
+	var targets []BlackboxTarget
+	for _, scrape := range cfg.ScrapeConfigs {
+		modules := scrape.Params["module"]
+		if len(modules) == 0 {
+			continue
+		}
+
+		interval, err := parsePrometheusDuration(scrape.ScrapeInterval)
+		if err != nil {
+			return nil, fmt.Errorf("decode prometheus config: job %q: scrape interval: %w", scrape.JobName, err)
+		}
+
+		if interval == 0 {
+			interval = globalInterval
+		}
+
+		for _, static := range scrape.StaticConfigs {
+			for _, target := range static.Targets {
+				targets = append(targets, BlackboxTarget{
+					Job:      scrape.JobName,
+					Module:   modules[0],
+					Target:   target,
+					Interval: interval,
+				})
+			}
+		}
+	}
+
+	return targets, nil
+}
+
+// parsePrometheusDuration parses a duration in the Prometheus format, which
+// in addition to the Go format supports days, weeks and years.
+func parsePrometheusDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	units := map[byte]time.Duration{
+		'd': 24 * time.Hour,
+		'w': 7 * 24 * time.Hour,
+		'y': 365 * 24 * time.Hour,
+	}
+
+	unit, ok := units[s[len(s)-1]]
+	if ok {
+		n, err := strconv.Atoi(s[:len(s)-1])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+
+		return time.Duration(n) * unit, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,194 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/importer"
+	"github.com/breml/go-uptime-kuma-client/internal/ptr"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+const blackboxModules = `
+modules:
+  http_2xx:
+    prober: http
+    timeout: 5s
+    http:
+      valid_status_codes: [200, 204]
+      no_follow_redirects: true
+      fail_if_body_not_matches_regexp:
+        - "healthy"
+        - "version: [0-9]+"
+      fail_if_ssl: true
+      basic_auth:
+        username: user
+        password: secret
+  tcp_tls:
+    prober: tcp
+    tcp:
+      tls: true
+  icmp:
+    prober: icmp
+    timeout: 3s
+  dns_example:
+    prober: dns
+    dns:
+      query_name: "example.com"
+      query_type: "MX"
+      validate_answer_rrs:
+        fail_if_not_matches_regexp:
+          - "mail\\.example\\.com"
+        fail_if_matches_regexp:
+          - ".*\\.invalid"
+  grpc:
+    prober: grpc
+`
+
+func TestFromBlackbox(t *testing.T) {
+	prometheus := `
+global:
+  scrape_interval: 2m
+scrape_configs:
+  - job_name: node
+    static_configs:
+      - targets: ["localhost:9100"]
+  - job_name: blackbox-http
+    metrics_path: /probe
+    scrape_interval: 30s
+    params:
+      module: [http_2xx]
+    static_configs:
+      - targets: ["https://example.com"]
+  - job_name: blackbox-other
+    params:
+      module: [tcp_tls]
+    static_configs:
+      - targets: ["example.com:443"]
+`
+
+	targets, err := importer.ParseBlackboxTargets(strings.NewReader(prometheus))
+	require.NoError(t, err)
+	require.Equal(t, []importer.BlackboxTarget{
+		{Job: "blackbox-http", Module: "http_2xx", Target: "https://example.com", Interval: 30 * time.Second},
+		{Job: "blackbox-other", Module: "tcp_tls", Target: "example.com:443", Interval: 2 * time.Minute},
+	}, targets)
+
+	targets = append(targets,
+		importer.BlackboxTarget{Module: "icmp", Target: "10.0.0.1"},
+		importer.BlackboxTarget{Module: "dns_example", Target: "8.8.8.8:5353"},
+		importer.BlackboxTarget{Module: "grpc", Target: "example.com:50051"},
+		importer.BlackboxTarget{Module: "missing", Target: "example.com"},
+	)
+
+	result, err := importer.FromBlackbox(strings.NewReader(blackboxModules), targets)
+	require.NoError(t, err)
+
+	want := []importer.Item{
+		{
+			Source: "blackbox-http/http_2xx/https://example.com",
+			Group:  "blackbox-http",
+			Monitor: &monitor.HTTPKeyword{
+				Base: monitor.Base{Name: "https://example.com", Interval: 30, RetryInterval: 30, IsActive: true},
+				HTTPDetails: monitor.HTTPDetails{
+					URL:                 "https://example.com",
+					Timeout:             5,
+					AcceptedStatusCodes: []string{"200", "204"},
+					Method:              "GET",
+					AuthMethod:          monitor.AuthMethodBasic,
+					BasicAuthUser:       "user",
+					BasicAuthPass:       "secret",
+				},
+				HTTPKeywordDetails: monitor.HTTPKeywordDetails{Keyword: "healthy"},
+			},
+		},
+		{
+			Source: "blackbox-other/tcp_tls/example.com:443",
+			Group:  "blackbox-other",
+			Monitor: &monitor.TCPPort{
+				Base: monitor.Base{Name: "example.com:443", Interval: 120, RetryInterval: 120, IsActive: true},
+				TCPPortDetails: monitor.TCPPortDetails{
+					Hostname:     "example.com",
+					Port:         443,
+					SMTPSecurity: ptr.To("secure"),
+				},
+			},
+		},
+		{
+			Source: "icmp/10.0.0.1",
+			Monitor: &monitor.Ping{
+				Base:        monitor.Base{Name: "10.0.0.1", Interval: 60, RetryInterval: 60, IsActive: true},
+				PingDetails: monitor.PingDetails{Hostname: "10.0.0.1", PacketSize: 56, Timeout: ptr.To(int64(3))},
+			},
+		},
+		{
+			Source: "dns_example/8.8.8.8:5353",
+			Monitor: &monitor.DNS{
+				Base: monitor.Base{Name: "8.8.8.8:5353", Interval: 60, RetryInterval: 60, IsActive: true},
+				DNSDetails: monitor.DNSDetails{
+					Hostname:       "example.com",
+					ResolverServer: "8.8.8.8",
+					ResolveType:    monitor.DNSResolveTypeMX,
+					Port:           5353,
+					Conditions: []monitor.Condition{
+						{Variable: "record", Operator: "contains", Value: "mail.example.com", AndOr: monitor.ConditionAnd},
+					},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, want, result.Items)
+
+	wantReport := []importer.ReportItem{
+		{Source: "http_2xx", Field: "http.fail_if_ssl", Message: "setting is not supported"},
+		{
+			Source:  "blackbox-http/http_2xx/https://example.com",
+			Field:   "http.fail_if_body_not_matches_regexp",
+			Message: `only a single literal body match is supported: "version: [0-9]+"`,
+		},
+		{
+			Source:  "dns_example/8.8.8.8:5353",
+			Field:   "dns.validate_answer_rrs.fail_if_matches_regexp",
+			Message: `only literal matches are supported: ".*\\.invalid"`,
+		},
+		{Source: "grpc/example.com:50051", Message: `unsupported prober "grpc"`, Skipped: true},
+		{Source: "missing/example.com", Message: `module "missing" not found`, Skipped: true},
+	}
+
+	require.Equal(t, wantReport, result.Report.Items)
+}
+
+func TestParseBlackboxTargets_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+	}{
+		{name: "invalid yaml", config: "scrape_configs: [}"},
+		{name: "invalid global interval", config: "global:\n  scrape_interval: 1x\n"},
+		{
+			name:   "invalid job interval",
+			config: "scrape_configs:\n  - job_name: a\n    scrape_interval: xd\n    params:\n      module: [http]\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := importer.ParseBlackboxTargets(strings.NewReader(tc.config))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParseBlackboxTargets_Days(t *testing.T) {
+	config := "scrape_configs:\n  - job_name: a\n    scrape_interval: 1d\n    params:\n      module: [http]\n" +
+		"    static_configs:\n      - targets: [https://example.com]\n"
+
+	targets, err := importer.ParseBlackboxTargets(strings.NewReader(config))
+	require.NoError(t, err)
+	require.Len(t, targets, 1)
+	require.Equal(t, 24*time.Hour, targets[0].Interval)
+}
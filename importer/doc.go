@@ -0,0 +1,30 @@
+// Package importer converts the checks of other monitoring tools to Uptime Kuma monitors.
+//
+// Supported Sources:
+//   - Gatus: endpoints of the YAML configuration, see FromGatus
+//   - Prometheus blackbox_exporter: modules and targets, see FromBlackbox
+//   - UptimeRobot: JSON export of the getMonitors API, see FromUptimeRobot
+//
+// The checks are converted to HTTP, HTTP keyword, TCP port, ping and DNS
+// monitors. Everything, which could not be mapped to a monitor, is listed in
+// the report of the result, such that the migration can be reviewed and
+// repeated.
+//
+// Example usage:
+//
+//	result, err := importer.FromGatus(f)
+//	if err != nil {
+//	    return err
+//	}
+//
+//	for _, item := range result.Report.Items {
+//	    log.Println(item)
+//	}
+//
+//	for _, mon := range result.Monitors() {
+//	    _, err = client.CreateMonitor(ctx, mon)
+//	    if err != nil {
+//	        return err
+//	    }
+//	}
+package importer
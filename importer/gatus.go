@@ -0,0 +1,483 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/breml/go-uptime-kuma-client/internal/ptr"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+type gatusConfig struct {
+	Endpoints []yaml.Node `yaml:"endpoints"`
+}
+
+type gatusEndpoint struct {
+	Name       string            `yaml:"name"`
+	Group      string            `yaml:"group"`
+	Enabled    *bool             `yaml:"enabled"`
+	URL        string            `yaml:"url"`
+	Method     string            `yaml:"method"`
+	Body       string            `yaml:"body"`
+	Headers    map[string]string `yaml:"headers"`
+	Interval   time.Duration     `yaml:"interval"`
+	Conditions []string          `yaml:"conditions"`
+	Client     gatusClient       `yaml:"client"`
+	DNS        *gatusDNS         `yaml:"dns"`
+}
+
+type gatusClient struct {
+	Insecure       bool          `yaml:"insecure"`
+	IgnoreRedirect bool          `yaml:"ignore-redirect"`
+	Timeout        time.Duration `yaml:"timeout"`
+}
+
+type gatusDNS struct {
+	QueryName string `yaml:"query-name"`
+	QueryType string `yaml:"query-type"`
+}
+
+// gatusEndpointKeys are the keys of a Gatus endpoint, which are mapped or
+// have no effect on the check itself.
+//
+//nolint:gochecknoglobals // list of known keys.
+var gatusEndpointKeys = []string{
+	"name", "group", "enabled", "url", "method", "body", "headers", "interval", "conditions", "client", "dns", "ui",
+}
+
+// gatusClientKeys are the keys of the client configuration of a Gatus
+// endpoint, which are mapped.
+//
+//nolint:gochecknoglobals // list of known keys.
+var gatusClientKeys = []string{"insecure", "ignore-redirect", "timeout"}
+
+// FromGatus converts the endpoints of a Gatus configuration to monitors.
+//
+// Endpoints with a http or https URL are converted to HTTP monitors, or to
+// HTTP keyword monitors, if a body pattern condition is present. Endpoints
+// with a tcp URL are converted to TCP port monitors, with an icmp URL to
+// ping monitors and endpoints with a dns configuration to DNS monitors.
+//
+// The conditions are mapped to the respective settings of the monitor, e.g.
+// `[STATUS] == 200` to the accepted status codes, or to monitor.Condition for
+// DNS monitors. Conditions and settings without an equivalent in Uptime Kuma
+// are listed in the report.
+func FromGatus(r io.Reader) (Result, error) {
+	var cfg gatusConfig
+
+	err := yaml.NewDecoder(r).Decode(&cfg)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return Result{}, fmt.Errorf("decode gatus config: %w", err)
+	}
+
+	var result Result
+	for i, node := range cfg.Endpoints {
+		var endpoint gatusEndpoint
+		err = node.Decode(&endpoint)
+		if err != nil {
+			return Result{}, fmt.Errorf("decode gatus endpoint %d: %w", i, err)
+		}
+
+		source := endpoint.Name
+		if endpoint.Group != "" {
+			source = endpoint.Group + "/" + endpoint.Name
+		}
+
+		reportUnknownKeys(&result.Report, source, "", &node, gatusEndpointKeys)
+
+		var client yaml.Node
+		if findKey(&node, "client", &client) {
+			reportUnknownKeys(&result.Report, source, "client.", &client, gatusClientKeys)
+		}
+
+		mon, ok := convertGatusEndpoint(endpoint, source, &result.Report)
+		if !ok {
+			continue
+		}
+
+		result.Items = append(result.Items, Item{
+			Source:  source,
+			Group:   endpoint.Group,
+			Monitor: mon,
+		})
+	}
+
+	return result, nil
+}
+
+func convertGatusEndpoint(endpoint gatusEndpoint, source string, report *Report) (monitor.Monitor, bool) {
+	base := newBase(endpoint.Name, endpoint.Interval)
+	if endpoint.Enabled != nil {
+		base.IsActive = *endpoint.Enabled
+	}
+
+	if endpoint.DNS != nil {
+		return convertGatusDNS(endpoint, base, source, report)
+	}
+
+	u, err := url.Parse(endpoint.URL)
+	if err != nil {
+		report.skip(source, "invalid url %q: %v", endpoint.URL, err)
+		return nil, false
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		return convertGatusHTTP(endpoint, base, source, report)
+
+	case "tcp":
+		port, err := strconv.Atoi(u.Port())
+		if err != nil {
+			report.skip(source, "invalid port in url %q", endpoint.URL)
+			return nil, false
+		}
+
+		gatusConnectionConditions(endpoint.Conditions, source, report)
+
+		return &monitor.TCPPort{
+			Base:           base,
+			TCPPortDetails: monitor.TCPPortDetails{Hostname: u.Hostname(), Port: port},
+		}, true
+
+	case "icmp":
+		gatusConnectionConditions(endpoint.Conditions, source, report)
+
+		details := monitor.PingDetails{Hostname: u.Hostname(), PacketSize: defaultPacketSize}
+		if endpoint.Client.Timeout > 0 {
+			details.Timeout = ptr.To(timeoutSeconds(endpoint.Client.Timeout))
+		}
+
+		return &monitor.Ping{Base: base, PingDetails: details}, true
+
+	default:
+		report.skip(source, "unsupported url scheme %q", u.Scheme)
+		return nil, false
+	}
+}
+
+func convertGatusHTTP(
+	endpoint gatusEndpoint,
+	base monitor.Base,
+	source string,
+	report *Report,
+) (monitor.Monitor, bool) {
+	details := newHTTPDetails(endpoint.URL)
+	details.IgnoreTLS = endpoint.Client.Insecure
+	details.Body = endpoint.Body
+
+	if endpoint.Method != "" {
+		details.Method = strings.ToUpper(endpoint.Method)
+	}
+
+	if endpoint.Client.IgnoreRedirect {
+		details.MaxRedirects = 0
+	}
+
+	if endpoint.Client.Timeout > 0 {
+		details.Timeout = timeoutSeconds(endpoint.Client.Timeout)
+	}
+
+	headers, err := encodeHeaders(endpoint.Headers)
+	if err != nil {
+		report.add(source, "headers", "%v", err)
+	}
+
+	details.Headers = headers
+
+	var keyword monitor.HTTPKeywordDetails
+	statusMapped := false
+
+	for _, condition := range endpoint.Conditions {
+		left, op, right, ok := parseGatusCondition(condition)
+		if !ok {
+			report.add(source, condition, "invalid condition")
+			continue
+		}
+
+		switch left {
+		case "[STATUS]":
+			codes, ok := gatusStatusCodes(op, right)
+			if !ok || statusMapped {
+				report.add(source, condition, "unsupported status condition")
+				continue
+			}
+
+			details.AcceptedStatusCodes = codes
+			statusMapped = true
+
+		case "[BODY]":
+			value, ok := gatusKeyword(right)
+			if !ok || keyword.Keyword != "" || (op != "==" && op != "!=") {
+				report.add(source, condition, "only a single body pattern of the form pat(*keyword*) is supported")
+				continue
+			}
+
+			keyword.Keyword = value
+			keyword.InvertKeyword = op == "!="
+
+		case "[CERTIFICATE_EXPIRATION]":
+			details.ExpiryNotification = true
+			report.add(source, condition, "threshold is not supported, expiry notification is enabled instead")
+
+		case "[CONNECTED]":
+			// Implied by every successful HTTP request.
+
+		default:
+			report.add(source, condition, "unsupported condition")
+		}
+	}
+
+	return httpMonitor(base, details, keyword), true
+}
+
+func convertGatusDNS(
+	endpoint gatusEndpoint,
+	base monitor.Base,
+	source string,
+	report *Report,
+) (monitor.Monitor, bool) {
+	resolver, port, ok := splitResolver(strings.TrimPrefix(endpoint.URL, "dns://"))
+	if !ok {
+		report.skip(source, "invalid port in url %q", endpoint.URL)
+		return nil, false
+	}
+
+	resolveType := monitor.DNSResolveType(strings.ToUpper(endpoint.DNS.QueryType))
+	if resolveType == "" {
+		resolveType = monitor.DNSResolveTypeA
+	}
+
+	details := monitor.DNSDetails{
+		Hostname:       strings.TrimSuffix(endpoint.DNS.QueryName, "."),
+		ResolverServer: resolver,
+		ResolveType:    resolveType,
+		Port:           port,
+	}
+
+	for _, condition := range endpoint.Conditions {
+		left, op, right, ok := parseGatusCondition(condition)
+		if !ok {
+			report.add(source, condition, "invalid condition")
+			continue
+		}
+
+		switch left {
+		case "[DNS_RCODE]":
+			if op != "==" || right != "NOERROR" {
+				report.add(source, condition, "only the rcode NOERROR is supported")
+			}
+
+		case "[BODY]":
+			cond, ok := gatusDNSCondition(op, right)
+			if !ok {
+				report.add(source, condition, "unsupported body condition")
+				continue
+			}
+
+			details.Conditions = append(details.Conditions, cond)
+
+		case "[CONNECTED]":
+			// Implied by every successful DNS query.
+
+		default:
+			report.add(source, condition, "unsupported condition")
+		}
+	}
+
+	return &monitor.DNS{Base: base, DNSDetails: details}, true
+}
+
+// gatusConnectionConditions reports all conditions except [CONNECTED], which
+// is the only condition checked by TCP port and ping monitors.
+func gatusConnectionConditions(conditions []string, source string, report *Report) {
+	for _, condition := range conditions {
+		left, op, right, ok := parseGatusCondition(condition)
+		if ok && left == "[CONNECTED]" && op == "==" && right == "true" {
+			continue
+		}
+
+		report.add(source, condition, "unsupported condition")
+	}
+}
+
+// gatusOperators are the comparison operators of Gatus conditions. The two
+// character operators are listed first, such that they take precedence.
+//
+//nolint:gochecknoglobals // list of operators.
+var gatusOperators = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+// parseGatusCondition splits a Gatus condition like `[STATUS] == 200` into
+// its left side, operator and right side.
+func parseGatusCondition(condition string) (string, string, string, bool) {
+	pos, op := -1, ""
+	for _, candidate := range gatusOperators {
+		i := strings.Index(condition, candidate)
+		if i >= 0 && (pos < 0 || i < pos) {
+			pos, op = i, candidate
+		}
+	}
+
+	if pos < 0 {
+		return "", "", "", false
+	}
+
+	left := strings.TrimSpace(condition[:pos])
+	right := strings.TrimSpace(condition[pos+len(op):])
+
+	return left, op, right, left != "" && right != ""
+}
+
+// gatusStatusCodes converts the right side of a status condition to the
+// accepted status codes.
+func gatusStatusCodes(op string, value string) ([]string, bool) {
+	if op == "==" && strings.HasPrefix(value, "any(") && strings.HasSuffix(value, ")") {
+		var codes []string
+		for code := range strings.SplitSeq(value[len("any("):len(value)-1], ",") {
+			code = strings.TrimSpace(code)
+
+			_, err := strconv.Atoi(code)
+			if err != nil {
+				return nil, false
+			}
+
+			codes = append(codes, code)
+		}
+
+		return codes, len(codes) > 0
+	}
+
+	code, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, false
+	}
+
+	switch op {
+	case "==":
+		return []string{strconv.Itoa(code)}, true
+	case "<":
+		return []string{fmt.Sprintf("100-%d", code-1)}, code > 100
+	case "<=":
+		return []string{fmt.Sprintf("100-%d", code)}, code >= 100
+	default:
+		return nil, false
+	}
+}
+
+// gatusPattern returns the pattern of a pat(...) function.
+func gatusPattern(value string) (string, bool) {
+	if !strings.HasPrefix(value, "pat(") || !strings.HasSuffix(value, ")") {
+		return "", false
+	}
+
+	return value[len("pat(") : len(value)-1], true
+}
+
+// gatusKeyword returns the keyword of a pattern of the form pat(*keyword*).
+// This is the only form of pattern, which is equivalent to the keyword
+// search of Uptime Kuma.
+func gatusKeyword(value string) (string, bool) {
+	pattern, ok := gatusPattern(value)
+	if !ok || !strings.HasPrefix(pattern, "*") || !strings.HasSuffix(pattern, "*") {
+		return "", false
+	}
+
+	keyword := strings.Trim(pattern, "*")
+	if keyword == "" || strings.ContainsAny(keyword, "*?") {
+		return "", false
+	}
+
+	return keyword, true
+}
+
+// gatusDNSCondition converts a body condition of a DNS endpoint to a
+// condition on the DNS record.
+func gatusDNSCondition(op string, value string) (monitor.Condition, bool) {
+	cond := monitor.Condition{Variable: "record", AndOr: monitor.ConditionAnd}
+
+	pattern, isPattern := gatusPattern(value)
+	if !isPattern {
+		if op != "==" && op != "!=" {
+			return monitor.Condition{}, false
+		}
+
+		cond.Operator = op
+		cond.Value = value
+
+		return cond, true
+	}
+
+	trimmed := strings.Trim(pattern, "*")
+	if trimmed == "" || strings.ContainsAny(trimmed, "*?") {
+		return monitor.Condition{}, false
+	}
+
+	prefix := strings.HasPrefix(pattern, "*")
+	suffix := strings.HasSuffix(pattern, "*")
+
+	switch {
+	case prefix && suffix:
+		cond.Operator = "contains"
+	case suffix:
+		cond.Operator = "starts_with"
+	case prefix:
+		cond.Operator = "ends_with"
+	default:
+		cond.Operator = "=="
+	}
+
+	switch op {
+	case "==":
+	case "!=":
+		if cond.Operator == "==" {
+			cond.Operator = "!="
+		} else {
+			cond.Operator = "not_" + cond.Operator
+		}
+
+	default:
+		return monitor.Condition{}, false
+	}
+
+	cond.Value = trimmed
+
+	return cond, true
+}
+
+// reportUnknownKeys adds a report item for every key of the mapping node,
+// which is not in the list of known keys.
+func reportUnknownKeys(report *Report, source string, prefix string, node *yaml.Node, known []string) {
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i].Value
+		if !slices.Contains(known, key) {
+			report.add(source, prefix+key, "setting is not supported")
+		}
+	}
+}
+
+// findKey looks up the value of a key in a mapping node.
+func findKey(node *yaml.Node, key string, value *yaml.Node) bool {
+	if node.Kind != yaml.MappingNode {
+		return false
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			*value = *node.Content[i+1]
+			return true
+		}
+	}
+
+	return false
+}
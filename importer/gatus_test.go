@@ -0,0 +1,229 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/importer"
+	"github.com/breml/go-uptime-kuma-client/internal/ptr"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func TestFromGatus(t *testing.T) {
+	config := `
+endpoints:
+  - name: website
+    group: core
+    url: "https://example.com/health"
+    interval: 5m
+    headers:
+      X-Token: abc
+    client:
+      insecure: true
+      timeout: 10s
+    conditions:
+      - "[STATUS] == 200"
+      - "[BODY] == pat(*healthy*)"
+      - "[RESPONSE_TIME] < 300"
+      - "[CERTIFICATE_EXPIRATION] > 48h"
+  - name: api
+    url: "https://api.example.com"
+    method: post
+    enabled: false
+    alerts:
+      - type: slack
+    conditions:
+      - "[STATUS] < 300"
+  - name: database
+    url: "tcp://db.example.com:5432"
+    conditions:
+      - "[CONNECTED] == true"
+  - name: router
+    url: "icmp://10.0.0.1"
+  - name: dns
+    url: "8.8.8.8"
+    dns:
+      query-name: "example.com"
+      query-type: "A"
+    conditions:
+      - "[DNS_RCODE] == NOERROR"
+      - "[BODY] == 93.184.216.34"
+      - "[BODY] == pat(*.example.org)"
+  - name: ssh
+    url: "ssh://example.com:22"
+`
+
+	result, err := importer.FromGatus(strings.NewReader(config))
+	require.NoError(t, err)
+
+	want := []importer.Item{
+		{
+			Source: "core/website",
+			Group:  "core",
+			Monitor: &monitor.HTTPKeyword{
+				Base: monitor.Base{Name: "website", Interval: 300, RetryInterval: 300, IsActive: true},
+				HTTPDetails: monitor.HTTPDetails{
+					URL:                 "https://example.com/health",
+					Timeout:             10,
+					ExpiryNotification:  true,
+					IgnoreTLS:           true,
+					MaxRedirects:        10,
+					AcceptedStatusCodes: []string{"200"},
+					Method:              "GET",
+					Headers:             `{"X-Token":"abc"}`,
+				},
+				HTTPKeywordDetails: monitor.HTTPKeywordDetails{Keyword: "healthy"},
+			},
+		},
+		{
+			Source: "api",
+			Monitor: &monitor.HTTP{
+				Base: monitor.Base{Name: "api", Interval: 60, RetryInterval: 60},
+				HTTPDetails: monitor.HTTPDetails{
+					URL:                 "https://api.example.com",
+					Timeout:             48,
+					MaxRedirects:        10,
+					AcceptedStatusCodes: []string{"100-299"},
+					Method:              "POST",
+				},
+			},
+		},
+		{
+			Source: "database",
+			Monitor: &monitor.TCPPort{
+				Base:           monitor.Base{Name: "database", Interval: 60, RetryInterval: 60, IsActive: true},
+				TCPPortDetails: monitor.TCPPortDetails{Hostname: "db.example.com", Port: 5432},
+			},
+		},
+		{
+			Source: "router",
+			Monitor: &monitor.Ping{
+				Base:        monitor.Base{Name: "router", Interval: 60, RetryInterval: 60, IsActive: true},
+				PingDetails: monitor.PingDetails{Hostname: "10.0.0.1", PacketSize: 56},
+			},
+		},
+		{
+			Source: "dns",
+			Monitor: &monitor.DNS{
+				Base: monitor.Base{Name: "dns", Interval: 60, RetryInterval: 60, IsActive: true},
+				DNSDetails: monitor.DNSDetails{
+					Hostname:       "example.com",
+					ResolverServer: "8.8.8.8",
+					ResolveType:    monitor.DNSResolveTypeA,
+					Port:           53,
+					Conditions: []monitor.Condition{
+						{Variable: "record", Operator: "==", Value: "93.184.216.34", AndOr: monitor.ConditionAnd},
+						{Variable: "record", Operator: "ends_with", Value: ".example.org", AndOr: monitor.ConditionAnd},
+					},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, want, result.Items)
+
+	wantReport := []importer.ReportItem{
+		{Source: "core/website", Field: "[RESPONSE_TIME] < 300", Message: "unsupported condition"},
+		{
+			Source:  "core/website",
+			Field:   "[CERTIFICATE_EXPIRATION] > 48h",
+			Message: "threshold is not supported, expiry notification is enabled instead",
+		},
+		{Source: "api", Field: "alerts", Message: "setting is not supported"},
+		{Source: "ssh", Message: `unsupported url scheme "ssh"`, Skipped: true},
+	}
+
+	require.Equal(t, wantReport, result.Report.Items)
+	require.Len(t, result.Report.Skipped(), 1)
+	require.Len(t, result.Monitors(), 5)
+}
+
+func TestFromGatus_Conditions(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+
+		wantCodes   []string
+		wantKeyword monitor.HTTPKeywordDetails
+		wantReport  bool
+	}{
+		{name: "status any", condition: "[STATUS] == any(200, 201)", wantCodes: []string{"200", "201"}},
+		{name: "status less or equal", condition: "[STATUS] <= 399", wantCodes: []string{"100-399"}},
+		{name: "status greater", condition: "[STATUS] > 199", wantReport: true},
+		{
+			name:        "inverted keyword",
+			condition:   "[BODY] != pat(*error*)",
+			wantKeyword: monitor.HTTPKeywordDetails{Keyword: "error", InvertKeyword: true},
+		},
+		{name: "prefix pattern", condition: "[BODY] == pat(ok*)", wantReport: true},
+		{name: "json path", condition: "[BODY].status == UP", wantReport: true},
+		{name: "invalid", condition: "[STATUS]", wantReport: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := "endpoints:\n  - name: test\n    url: https://example.com\n    conditions:\n      - \"" +
+				tc.condition + "\"\n"
+
+			result, err := importer.FromGatus(strings.NewReader(config))
+			require.NoError(t, err)
+			require.Len(t, result.Items, 1)
+			require.Equal(t, tc.wantReport, !result.Report.Empty())
+
+			var details monitor.HTTPDetails
+			var keyword monitor.HTTPKeywordDetails
+
+			switch mon := result.Items[0].Monitor.(type) {
+			case *monitor.HTTP:
+				details = mon.HTTPDetails
+			case *monitor.HTTPKeyword:
+				details = mon.HTTPDetails
+				keyword = mon.HTTPKeywordDetails
+			default:
+				t.Fatalf("unexpected monitor type %T", mon)
+			}
+
+			wantCodes := tc.wantCodes
+			if wantCodes == nil {
+				wantCodes = []string{"200-299"}
+			}
+
+			require.Equal(t, wantCodes, details.AcceptedStatusCodes)
+			require.Equal(t, tc.wantKeyword, keyword)
+		})
+	}
+}
+
+func TestFromGatus_PingTimeout(t *testing.T) {
+	config := `
+endpoints:
+  - name: router
+    url: "icmp://10.0.0.1"
+    client:
+      timeout: 1500ms
+      dns-resolver: "tcp://1.1.1.1:53"
+`
+
+	result, err := importer.FromGatus(strings.NewReader(config))
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+
+	ping, ok := result.Items[0].Monitor.(*monitor.Ping)
+	require.True(t, ok)
+	require.Equal(t, ptr.To(int64(2)), ping.Timeout)
+
+	require.Equal(t, []importer.ReportItem{
+		{Source: "router", Field: "client.dns-resolver", Message: "setting is not supported"},
+	}, result.Report.Items)
+}
+
+func TestFromGatus_Invalid(t *testing.T) {
+	_, err := importer.FromGatus(strings.NewReader("endpoints: [}"))
+	require.Error(t, err)
+
+	result, err := importer.FromGatus(strings.NewReader(""))
+	require.NoError(t, err)
+	require.Empty(t, result.Items)
+}
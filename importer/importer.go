@@ -0,0 +1,213 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+// Default values for the imported monitors, which are applied, if the
+// source does not define a value. The defaults follow the defaults of the
+// Uptime Kuma web interface.
+const (
+	defaultInterval     = 60
+	defaultHTTPTimeout  = 48
+	defaultMaxRedirects = 10
+	defaultPacketSize   = 56
+	defaultDNSPort      = 53
+	defaultDNSResolver  = "1.1.1.1"
+	defaultStatusCodes  = "200-299"
+)
+
+// Item is a monitor converted from a check of the source configuration.
+type Item struct {
+	// Source identifies the check in the source configuration, e.g. the
+	// name of a Gatus endpoint.
+	Source string
+
+	// Group is the name of the group, the check belongs to in the source
+	// configuration. Empty, if the source does not group the checks.
+	Group string
+
+	// Monitor is the converted monitor. The concrete type is one of
+	// *monitor.HTTP, *monitor.HTTPKeyword, *monitor.TCPPort, *monitor.Ping
+	// or *monitor.DNS.
+	Monitor monitor.Monitor
+}
+
+// Result is the result of an import.
+type Result struct {
+	// Items are the converted monitors in the order of the source
+	// configuration.
+	Items []Item
+
+	// Report lists everything, which could not be mapped to a monitor.
+	Report Report
+}
+
+// Monitors returns the converted monitors.
+func (r Result) Monitors() []monitor.Monitor {
+	monitors := make([]monitor.Monitor, 0, len(r.Items))
+	for _, item := range r.Items {
+		monitors = append(monitors, item.Monitor)
+	}
+
+	return monitors
+}
+
+// Report lists the settings of the source configuration, which could not be
+// mapped to Uptime Kuma monitors.
+type Report struct {
+	Items []ReportItem
+}
+
+// ReportItem is a single setting or check, which could not be mapped.
+type ReportItem struct {
+	// Source identifies the check in the source configuration.
+	Source string
+
+	// Field is the setting, which could not be mapped, e.g. a condition.
+	// Empty, if the check as a whole could not be converted.
+	Field string
+
+	// Message describes, why the setting could not be mapped.
+	Message string
+
+	// Skipped is true, if no monitor has been created for the check.
+	Skipped bool
+}
+
+func (i ReportItem) String() string {
+	if i.Field == "" {
+		return i.Source + ": " + i.Message
+	}
+
+	return i.Source + ": " + i.Field + ": " + i.Message
+}
+
+// Empty reports, whether everything has been mapped.
+func (r Report) Empty() bool {
+	return len(r.Items) == 0
+}
+
+// Skipped returns the items for checks, for which no monitor has been
+// created.
+func (r Report) Skipped() []ReportItem {
+	var skipped []ReportItem
+	for _, item := range r.Items {
+		if item.Skipped {
+			skipped = append(skipped, item)
+		}
+	}
+
+	return skipped
+}
+
+func (r Report) String() string {
+	lines := make([]string, 0, len(r.Items))
+	for _, item := range r.Items {
+		lines = append(lines, item.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (r *Report) add(source string, field string, format string, args ...any) {
+	r.Items = append(r.Items, ReportItem{
+		Source:  source,
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (r *Report) skip(source string, format string, args ...any) {
+	r.Items = append(r.Items, ReportItem{
+		Source:  source,
+		Message: fmt.Sprintf(format, args...),
+		Skipped: true,
+	})
+}
+
+// newBase returns the base of an imported monitor with the defaults applied.
+func newBase(name string, interval time.Duration) monitor.Base {
+	seconds := int64(interval / time.Second)
+	if seconds <= 0 {
+		seconds = defaultInterval
+	}
+
+	return monitor.Base{
+		Name:          name,
+		Interval:      seconds,
+		RetryInterval: seconds,
+		IsActive:      true,
+	}
+}
+
+// newHTTPDetails returns the HTTP details of an imported monitor with the
+// defaults applied.
+func newHTTPDetails(url string) monitor.HTTPDetails {
+	return monitor.HTTPDetails{
+		URL:                 url,
+		Timeout:             defaultHTTPTimeout,
+		Method:              "GET",
+		MaxRedirects:        defaultMaxRedirects,
+		AcceptedStatusCodes: []string{defaultStatusCodes},
+		AuthMethod:          monitor.AuthMethodNone,
+	}
+}
+
+// httpMonitor returns a *monitor.HTTPKeyword, if a keyword is set, and
+// a *monitor.HTTP otherwise.
+func httpMonitor(base monitor.Base, details monitor.HTTPDetails, keyword monitor.HTTPKeywordDetails) monitor.Monitor {
+	if keyword.Keyword != "" {
+		return &monitor.HTTPKeyword{Base: base, HTTPDetails: details, HTTPKeywordDetails: keyword}
+	}
+
+	return &monitor.HTTP{Base: base, HTTPDetails: details}
+}
+
+// encodeHeaders encodes the headers in the JSON format expected by Uptime
+// Kuma.
+func encodeHeaders(headers map[string]string) (string, error) {
+	if len(headers) == 0 {
+		return "", nil
+	}
+
+	data, err := json.Marshal(headers)
+	if err != nil {
+		return "", fmt.Errorf("encode headers: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// splitResolver splits the address of a DNS resolver in host and port. The
+// default port and resolver are applied, if not present.
+func splitResolver(address string) (string, int, bool) {
+	if address == "" {
+		return defaultDNSResolver, defaultDNSPort, true
+	}
+
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return address, defaultDNSPort, true
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return "", 0, false
+	}
+
+	return host, port, true
+}
+
+// timeoutSeconds converts a timeout to seconds, fractions of a second are
+// rounded up.
+func timeoutSeconds(timeout time.Duration) int64 {
+	return int64((timeout + time.Second - 1) / time.Second)
+}
@@ -0,0 +1,263 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/breml/go-uptime-kuma-client/internal/ptr"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+// UptimeRobot monitor types.
+const (
+	uptimeRobotTypeHTTP      = 1
+	uptimeRobotTypeKeyword   = 2
+	uptimeRobotTypePing      = 3
+	uptimeRobotTypePort      = 4
+	uptimeRobotTypeHeartbeat = 5
+)
+
+// UptimeRobot keyword types.
+const (
+	uptimeRobotKeywordExists    = 1
+	uptimeRobotKeywordNotExists = 2
+)
+
+// UptimeRobot port sub types for the predefined ports.
+//
+//nolint:gochecknoglobals // map of predefined ports.
+var uptimeRobotPorts = map[int]int{
+	1: 80,  // HTTP
+	2: 443, // HTTPS
+	3: 21,  // FTP
+	4: 25,  // SMTP
+	5: 110, // POP3
+	6: 143, // IMAP
+}
+
+// uptimeRobotMethods maps the UptimeRobot HTTP method IDs to the method
+// names.
+//
+//nolint:gochecknoglobals // map of HTTP methods.
+var uptimeRobotMethods = map[int]string{
+	1: "HEAD",
+	2: "GET",
+	3: "POST",
+	4: "PUT",
+	5: "PATCH",
+	6: "DELETE",
+	7: "OPTIONS",
+}
+
+// uptimeRobotInt is an integer, which UptimeRobot encodes as number, as
+// string or as empty string.
+type uptimeRobotInt int
+
+func (i *uptimeRobotInt) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*i = 0
+		return nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("invalid number %s: %w", data, err)
+	}
+
+	*i = uptimeRobotInt(n)
+
+	return nil
+}
+
+type uptimeRobotExport struct {
+	Monitors []uptimeRobotMonitor `json:"monitors"`
+}
+
+type uptimeRobotMonitor struct {
+	ID                uptimeRobotInt    `json:"id"`
+	FriendlyName      string            `json:"friendly_name"`
+	URL               string            `json:"url"`
+	Type              uptimeRobotInt    `json:"type"`
+	SubType           uptimeRobotInt    `json:"sub_type"`
+	KeywordType       uptimeRobotInt    `json:"keyword_type"`
+	KeywordCaseType   uptimeRobotInt    `json:"keyword_case_type"`
+	KeywordValue      string            `json:"keyword_value"`
+	HTTPUsername      string            `json:"http_username"`
+	HTTPPassword      string            `json:"http_password"`
+	HTTPAuthType      uptimeRobotInt    `json:"http_auth_type"`
+	HTTPMethod        uptimeRobotInt    `json:"http_method"`
+	PostValue         string            `json:"post_value"`
+	CustomHTTPHeaders map[string]string `json:"custom_http_headers"`
+	CustomHTTPStatus  string            `json:"custom_http_statuses"`
+	Port              uptimeRobotInt    `json:"port"`
+	Interval          uptimeRobotInt    `json:"interval"`
+	Timeout           uptimeRobotInt    `json:"timeout"`
+	Status            *uptimeRobotInt   `json:"status"`
+}
+
+// FromUptimeRobot converts the monitors of an UptimeRobot export to monitors.
+// The export is the JSON response of the getMonitors API, either the full
+// response with a "monitors" field or only the list of monitors.
+//
+// HTTP monitors are converted to HTTP monitors, keyword monitors to HTTP
+// keyword monitors, ping monitors to ping monitors and port monitors to TCP
+// port monitors. Heartbeat monitors and settings without an equivalent in
+// Uptime Kuma are listed in the report.
+func FromUptimeRobot(r io.Reader) (Result, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Result{}, fmt.Errorf("read uptimerobot export: %w", err)
+	}
+
+	var export uptimeRobotExport
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(trimmed, &export.Monitors)
+	} else {
+		err = json.Unmarshal(data, &export)
+	}
+
+	if err != nil {
+		return Result{}, fmt.Errorf("decode uptimerobot export: %w", err)
+	}
+
+	var result Result
+	for _, mon := range export.Monitors {
+		source := mon.FriendlyName
+		if mon.ID != 0 {
+			source = fmt.Sprintf("%s (%d)", mon.FriendlyName, mon.ID)
+		}
+
+		converted, ok := convertUptimeRobotMonitor(mon, source, &result.Report)
+		if !ok {
+			continue
+		}
+
+		result.Items = append(result.Items, Item{
+			Source:  source,
+			Monitor: converted,
+		})
+	}
+
+	return result, nil
+}
+
+func convertUptimeRobotMonitor(mon uptimeRobotMonitor, source string, report *Report) (monitor.Monitor, bool) {
+	base := newBase(mon.FriendlyName, time.Duration(mon.Interval)*time.Second)
+
+	// Status 0 is paused.
+	if mon.Status != nil && *mon.Status == 0 {
+		base.IsActive = false
+	}
+
+	switch mon.Type {
+	case uptimeRobotTypeHTTP, uptimeRobotTypeKeyword:
+		return convertUptimeRobotHTTP(mon, base, source, report), true
+
+	case uptimeRobotTypePing:
+		details := monitor.PingDetails{Hostname: mon.URL, PacketSize: defaultPacketSize}
+		if mon.Timeout > 0 {
+			details.Timeout = ptr.To(int64(mon.Timeout))
+		}
+
+		return &monitor.Ping{Base: base, PingDetails: details}, true
+
+	case uptimeRobotTypePort:
+		port, ok := uptimeRobotPorts[int(mon.SubType)]
+		if !ok {
+			port = int(mon.Port)
+		}
+
+		if port == 0 {
+			report.skip(source, "port monitor without port")
+			return nil, false
+		}
+
+		return &monitor.TCPPort{
+			Base:           base,
+			TCPPortDetails: monitor.TCPPortDetails{Hostname: mon.URL, Port: port},
+		}, true
+
+	case uptimeRobotTypeHeartbeat:
+		report.skip(source, "heartbeat monitors are not supported")
+		return nil, false
+
+	default:
+		report.skip(source, "unsupported monitor type %d", mon.Type)
+		return nil, false
+	}
+}
+
+func convertUptimeRobotHTTP(
+	mon uptimeRobotMonitor,
+	base monitor.Base,
+	source string,
+	report *Report,
+) monitor.Monitor {
+	details := newHTTPDetails(mon.URL)
+	details.Body = mon.PostValue
+
+	if mon.Timeout > 0 {
+		details.Timeout = int64(mon.Timeout)
+	}
+
+	if method, ok := uptimeRobotMethods[int(mon.HTTPMethod)]; ok {
+		details.Method = method
+	}
+
+	headers, err := encodeHeaders(mon.CustomHTTPHeaders)
+	if err != nil {
+		report.add(source, "custom_http_headers", "%v", err)
+	}
+
+	details.Headers = headers
+
+	if mon.HTTPUsername != "" {
+		details.AuthMethod = monitor.AuthMethodBasic
+		details.BasicAuthUser = mon.HTTPUsername
+		details.BasicAuthPass = mon.HTTPPassword
+
+		// Auth type 2 is digest authentication.
+		if mon.HTTPAuthType == 2 {
+			report.add(source, "http_auth_type", "digest authentication is not supported, using basic")
+		}
+	}
+
+	if mon.CustomHTTPStatus != "" {
+		report.add(source, "custom_http_statuses", "custom status codes are not supported: %q", mon.CustomHTTPStatus)
+	}
+
+	var keyword monitor.HTTPKeywordDetails
+	if mon.Type == uptimeRobotTypeKeyword {
+		keyword.Keyword = mon.KeywordValue
+
+		// UptimeRobot alerts, if the keyword exists, respectively does not
+		// exist. Uptime Kuma is down, if the keyword is missing, unless the
+		// keyword is inverted.
+		switch mon.KeywordType {
+		case uptimeRobotKeywordExists:
+			keyword.InvertKeyword = true
+		case uptimeRobotKeywordNotExists:
+			keyword.InvertKeyword = false
+		default:
+			report.add(source, "keyword_type", "unknown keyword type %d", mon.KeywordType)
+		}
+
+		// Case type 0 is case insensitive, the keyword check of Uptime Kuma
+		// is always case sensitive.
+		if mon.KeywordCaseType == 0 {
+			report.add(source, "keyword_case_type", "case insensitive keyword match is not supported")
+		}
+
+		if keyword.Keyword == "" {
+			report.add(source, "keyword_value", "empty keyword, using HTTP monitor")
+		}
+	}
+
+	return httpMonitor(base, details, keyword)
+}
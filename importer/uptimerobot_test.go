@@ -0,0 +1,137 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/importer"
+	"github.com/breml/go-uptime-kuma-client/internal/ptr"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func TestFromUptimeRobot(t *testing.T) {
+	export := `{
+		"stat": "ok",
+		"monitors": [
+			{
+				"id": 1, "friendly_name": "Website", "url": "https://example.com", "type": 1,
+				"interval": 300, "timeout": 30, "status": 2, "http_method": 3, "post_value": "{}",
+				"http_username": "user", "http_password": "secret", "custom_http_headers": {"X-Token": "abc"}
+			},
+			{
+				"id": 2, "friendly_name": "Shop", "url": "https://shop.example.com", "type": 2,
+				"keyword_type": 1, "keyword_value": "error", "keyword_case_type": 1, "interval": 60, "status": 0
+			},
+			{
+				"id": "3", "friendly_name": "Router", "url": "10.0.0.1", "type": "3", "sub_type": "",
+				"interval": "120", "timeout": "5"
+			},
+			{
+				"id": 4, "friendly_name": "Mail", "url": "mail.example.com", "type": 4, "sub_type": 4, "port": ""
+			},
+			{
+				"id": 5, "friendly_name": "Custom port", "url": "db.example.com", "type": 4, "sub_type": 99,
+				"port": 5432, "custom_http_statuses": "404:1"
+			},
+			{
+				"id": 6, "friendly_name": "Cron", "url": "https://heartbeat.uptimerobot.com/abc", "type": 5
+			}
+		]
+	}`
+
+	result, err := importer.FromUptimeRobot(strings.NewReader(export))
+	require.NoError(t, err)
+
+	want := []importer.Item{
+		{
+			Source: "Website (1)",
+			Monitor: &monitor.HTTP{
+				Base: monitor.Base{Name: "Website", Interval: 300, RetryInterval: 300, IsActive: true},
+				HTTPDetails: monitor.HTTPDetails{
+					URL:                 "https://example.com",
+					Timeout:             30,
+					MaxRedirects:        10,
+					AcceptedStatusCodes: []string{"200-299"},
+					Method:              "POST",
+					Body:                "{}",
+					Headers:             `{"X-Token":"abc"}`,
+					AuthMethod:          monitor.AuthMethodBasic,
+					BasicAuthUser:       "user",
+					BasicAuthPass:       "secret",
+				},
+			},
+		},
+		{
+			Source: "Shop (2)",
+			Monitor: &monitor.HTTPKeyword{
+				Base: monitor.Base{Name: "Shop", Interval: 60, RetryInterval: 60},
+				HTTPDetails: monitor.HTTPDetails{
+					URL:                 "https://shop.example.com",
+					Timeout:             48,
+					MaxRedirects:        10,
+					AcceptedStatusCodes: []string{"200-299"},
+					Method:              "GET",
+				},
+				HTTPKeywordDetails: monitor.HTTPKeywordDetails{Keyword: "error", InvertKeyword: true},
+			},
+		},
+		{
+			Source: "Router (3)",
+			Monitor: &monitor.Ping{
+				Base:        monitor.Base{Name: "Router", Interval: 120, RetryInterval: 120, IsActive: true},
+				PingDetails: monitor.PingDetails{Hostname: "10.0.0.1", PacketSize: 56, Timeout: ptr.To(int64(5))},
+			},
+		},
+		{
+			Source: "Mail (4)",
+			Monitor: &monitor.TCPPort{
+				Base:           monitor.Base{Name: "Mail", Interval: 60, RetryInterval: 60, IsActive: true},
+				TCPPortDetails: monitor.TCPPortDetails{Hostname: "mail.example.com", Port: 25},
+			},
+		},
+		{
+			Source: "Custom port (5)",
+			Monitor: &monitor.TCPPort{
+				Base:           monitor.Base{Name: "Custom port", Interval: 60, RetryInterval: 60, IsActive: true},
+				TCPPortDetails: monitor.TCPPortDetails{Hostname: "db.example.com", Port: 5432},
+			},
+		},
+	}
+
+	require.Equal(t, want, result.Items)
+
+	// The custom status codes of a port monitor are not relevant.
+	require.Equal(t, []importer.ReportItem{
+		{Source: "Cron (6)", Message: "heartbeat monitors are not supported", Skipped: true},
+	}, result.Report.Items)
+}
+
+func TestFromUptimeRobot_List(t *testing.T) {
+	export := `[
+		{"friendly_name": "Shop", "url": "https://shop.example.com", "type": 2, "keyword_value": "Welcome",
+		 "keyword_type": 2, "custom_http_statuses": "404:1", "http_username": "u", "http_auth_type": 2}
+	]`
+
+	result, err := importer.FromUptimeRobot(strings.NewReader(export))
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+
+	mon, ok := result.Items[0].Monitor.(*monitor.HTTPKeyword)
+	require.True(t, ok)
+	require.Equal(t, "Welcome", mon.Keyword)
+	require.False(t, mon.InvertKeyword)
+
+	require.Equal(t, "Shop: http_auth_type: digest authentication is not supported, using basic\n"+
+		`Shop: custom_http_statuses: custom status codes are not supported: "404:1"`+"\n"+
+		"Shop: keyword_case_type: case insensitive keyword match is not supported", result.Report.String())
+}
+
+func TestFromUptimeRobot_Invalid(t *testing.T) {
+	_, err := importer.FromUptimeRobot(strings.NewReader(`{"monitors": [{"type": "x"}]}`))
+	require.Error(t, err)
+
+	_, err = importer.FromUptimeRobot(strings.NewReader(`{`))
+	require.Error(t, err)
+}
@@ -0,0 +1,145 @@
+package kuma
+
+import "context"
+
+// Invoker sends a socket command to the server and returns the
+// acknowledgement of the server. An error is returned, if the server does
+// not acknowledge the command with ok.
+type Invoker func(ctx context.Context, command string, args []any) (AckResponse, error)
+
+// Interceptor intercepts the socket commands sent by the client, e.g. for
+// tracing, logging, metrics, rate limiting or fault injection. The
+// interceptor is expected to call next to send the command, it may modify
+// the context and the arguments or skip the call entirely.
+//
+// For commands, which cause the server to send an updated list, e.g. of the
+// monitors, next returns after the updated list has been received.
+//
+// The commands sent by New, like login, are intercepted as well. Their
+// arguments contain the credentials of the user.
+type Interceptor func(ctx context.Context, command string, args []any, next Invoker) (AckResponse, error)
+
+// WithInterceptor adds an interceptor, which wraps every socket command sent
+// by the client. Interceptors are called in the order they are added, the
+// first added interceptor is the outermost one.
+func WithInterceptor(interceptor Interceptor) Option {
+	return func(c *Client) {
+		if interceptor != nil {
+			c.interceptors = append(c.interceptors, interceptor)
+		}
+	}
+}
+
+// invoke sends the command through the interceptors to the invoker. The
+// invoker is wrapped, such that mutating commands are audited, if an audit
+// sink is configured. In dry-run mode, mutating commands are recorded into
+// the plan instead and are therefore not audited.
+func (c *Client) invoke(ctx context.Context, command string, args []any, invoker Invoker) (AckResponse, error) {
+	if c.auditSink != nil {
+		invoker = c.audit(invoker)
+	}
+
+	if c.plan != nil {
+		invoker = c.dryRun(invoker)
+	}
+
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor := c.interceptors[i]
+		next := invoker
+
+		invoker = func(ctx context.Context, command string, args []any) (AckResponse, error) {
+			return interceptor(ctx, command, args, next)
+		}
+	}
+
+	return invoker(ctx, command, args)
+}
+
+// CommandTarget returns the kind and the ID or the slug of the entity, which
+// is read or changed by the socket command with the given arguments, e.g. the
+// monitor for addMonitorTag. The ID is 0 for commands, which create an entity,
+// the ID of the created entity is only known from the acknowledgement. An
+// empty kind is returned, if the command does not refer to a single entity.
+func CommandTarget(command string, args []any) (ResourceKind, int64, string) {
+	arg := func(i int) any {
+		if i >= len(args) {
+			return nil
+		}
+
+		return args[i]
+	}
+
+	argID := func(i int) int64 {
+		return toInt64(arg(i))
+	}
+
+	mapID := func(i int) int64 {
+		data, _ := arg(i).(map[string]any)
+		return toInt64(data["id"])
+	}
+
+	slug := func(i int) string {
+		s, _ := arg(i).(string)
+		return s
+	}
+
+	switch command {
+	case "add", "editMonitor":
+		return ResourceMonitor, mapID(0), ""
+
+	case "getMonitor", "deleteMonitor", "pauseMonitor", "resumeMonitor", "clearEvents", "clearHeartbeats":
+		return ResourceMonitor, argID(0), ""
+
+	case "addMonitorTag", "editMonitorTag", "deleteMonitorTag":
+		return ResourceMonitor, argID(1), ""
+
+	case "addTag", "editTag":
+		return ResourceTag, mapID(0), ""
+
+	case "deleteTag":
+		return ResourceTag, argID(0), ""
+
+	case "addNotification":
+		return ResourceNotification, argID(1), ""
+
+	case "deleteNotification":
+		return ResourceNotification, argID(0), ""
+
+	case "addProxy":
+		return ResourceProxy, argID(1), ""
+
+	case "deleteProxy":
+		return ResourceProxy, argID(0), ""
+
+	case "addDockerHost":
+		return ResourceDockerHost, argID(1), ""
+
+	case "deleteDockerHost":
+		return ResourceDockerHost, argID(0), ""
+
+	case "addRemoteBrowser":
+		return ResourceRemoteBrowser, argID(1), ""
+
+	case "deleteRemoteBrowser":
+		return ResourceRemoteBrowser, argID(0), ""
+
+	case "addMaintenance", "editMaintenance":
+		return ResourceMaintenance, mapID(0), ""
+
+	case "getMaintenance", "deleteMaintenance", "pauseMaintenance", "resumeMaintenance",
+		"getMonitorMaintenance", "addMonitorMaintenance", "getMaintenanceStatusPage", "addMaintenanceStatusPage":
+		return ResourceMaintenance, argID(0), ""
+
+	case "addStatusPage":
+		return ResourceStatusPage, 0, slug(1)
+
+	case "getStatusPage", "saveStatusPage", "deleteStatusPage", "postIncident", "unpinIncident":
+		return ResourceStatusPage, 0, slug(0)
+
+	case "setSettings":
+		return ResourceSettings, 0, ""
+
+	default:
+		return "", 0, ""
+	}
+}
@@ -0,0 +1,101 @@
+package kuma_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+)
+
+func TestWithInterceptor(t *testing.T) {
+	const timeout = 500 * time.Millisecond
+
+	newServer := func(t *testing.T) *httptest.Server {
+		t.Helper()
+
+		server := httptest.NewServer(&fakeSocketIOServer{
+			messages: make(chan []byte, 10),
+		})
+
+		t.Cleanup(func() {
+			server.CloseClientConnections()
+			server.Close()
+		})
+
+		return server
+	}
+
+	t.Run("order", func(t *testing.T) {
+		server := newServer(t)
+
+		var (
+			mu    sync.Mutex
+			calls []string
+		)
+
+		record := func(name string) kuma.Interceptor {
+			return func(ctx context.Context, command string, args []any, next kuma.Invoker) (kuma.AckResponse, error) {
+				mu.Lock()
+				calls = append(calls, name+" before "+command)
+				mu.Unlock()
+
+				response, err := next(ctx, command, args)
+
+				mu.Lock()
+				calls = append(calls, name+" after "+command)
+				mu.Unlock()
+
+				return response, err
+			}
+		}
+
+		// The fake server acknowledges the login, but never sends the
+		// ready events, so New fails after the login.
+		_, err := kuma.New(t.Context(), server.URL, "admin", "admin1",
+			kuma.WithConnectTimeout(timeout),
+			kuma.WithInterceptor(record("outer")),
+			kuma.WithInterceptor(record("inner")),
+		)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		require.Equal(t, []string{
+			"outer before login",
+			"inner before login",
+			"inner after login",
+			"outer after login",
+		}, calls)
+	})
+
+	t.Run("fault_injection", func(t *testing.T) {
+		server := newServer(t)
+
+		errInjected := errors.New("injected")
+
+		_, err := kuma.New(t.Context(), server.URL, "admin", "admin1",
+			kuma.WithConnectTimeout(timeout),
+			kuma.WithInterceptor(func(
+				ctx context.Context,
+				command string,
+				args []any,
+				next kuma.Invoker,
+			) (kuma.AckResponse, error) {
+				if command == "login" {
+					return kuma.AckResponse{}, errInjected
+				}
+
+				return next(ctx, command, args)
+			}),
+		)
+		require.ErrorIs(t, err, errInjected)
+		require.ErrorContains(t, err, "login: injected")
+	})
+}
@@ -0,0 +1,296 @@
+// Package secret provides helpers for struct fields, which hold sensitive
+// values like passwords, tokens or API keys.
+//
+// Fields are marked as secret with the struct tag `kuma:"secret"`.
+package secret
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Mask is the placeholder, which replaces secret values.
+const Mask = "***"
+
+// IsSecret reports whether the struct field is marked as secret.
+func IsSecret(field reflect.StructField) bool {
+	return field.Tag.Get("kuma") == "secret"
+}
+
+// Redact returns a copy of v, where all non-empty secret fields are replaced
+// with Mask. Nested and embedded structs are redacted recursively. Values
+// referenced by v are never modified, redacted pointers and maps are
+// replaced with new ones.
+func Redact[T any](v T) T {
+	redacted, _ := Transform(v, func(string) (string, error) {
+		return Mask, nil
+	})
+
+	return redacted
+}
+
+// Transform returns a copy of v, where the non-empty values of all secret
+// fields are replaced with the result of fn. Secret fields of kind string,
+// pointer to string, string slices and maps with string values are supported.
+// Nested and embedded structs are processed recursively. If v is a pointer
+// to a struct, a pointer to a transformed copy of the struct is returned.
+// Values referenced by v are never modified.
+func Transform[T any](v T, fn func(value string) (string, error)) (T, error) {
+	err := transform(reflect.ValueOf(&v).Elem(), fn)
+
+	return v, err
+}
+
+// JSONNames returns the JSON names of all secret fields of the given struct
+// values, including the fields of embedded structs.
+func JSONNames(values ...any) map[string]bool {
+	names := map[string]bool{}
+	for _, v := range values {
+		collectJSONNames(reflect.TypeOf(v), names)
+	}
+
+	return names
+}
+
+// RedactMap replaces the non-empty values of all entries in m, whose key is
+// contained in names, with Mask. Nested objects and arrays are masked element
+// wise to retain their structure. The map is modified in place.
+func RedactMap(m map[string]any, names map[string]bool) {
+	for k, v := range m {
+		if names[k] {
+			m[k] = maskAny(v)
+		}
+	}
+}
+
+// IsMasked reports whether the value of a secret field is masked, that is,
+// whether it holds a non-empty value.
+func IsMasked(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return !value.IsNil() && IsMasked(value.Elem())
+
+	case reflect.Map, reflect.Slice:
+		return value.Len() > 0
+
+	default:
+		return !value.IsZero()
+	}
+}
+
+// transform applies fn to the secret fields of the struct held by val. val
+// is either a struct, a pointer to a struct or an interface holding one of
+// these. val needs to be settable.
+func transform(val reflect.Value, fn func(string) (string, error)) error {
+	switch {
+	case val.Kind() == reflect.Struct:
+		return transformStruct(val, fn)
+
+	case (val.Kind() == reflect.Pointer || val.Kind() == reflect.Interface) && !val.IsNil():
+		// Transform a copy of the referenced value, the original must not
+		// be modified. Values held by an interface are not settable, so the
+		// same applies for them.
+		elem := val.Elem()
+		if elem.Kind() != reflect.Struct && elem.Kind() != reflect.Pointer {
+			return nil
+		}
+
+		cp := reflect.New(elem.Type()).Elem()
+		cp.Set(elem)
+
+		err := transform(cp, fn)
+		if err != nil {
+			return err
+		}
+
+		if val.Kind() == reflect.Pointer {
+			val.Set(cp.Addr())
+			return nil
+		}
+
+		val.Set(cp)
+
+		return nil
+
+	default:
+		return nil
+	}
+}
+
+func transformStruct(val reflect.Value, fn func(string) (string, error)) error {
+	typ := val.Type()
+	for i := range val.NumField() {
+		field := typ.Field(i)
+		value := val.Field(i)
+
+		if !field.IsExported() || !value.CanSet() {
+			continue
+		}
+
+		var err error
+		if IsSecret(field) {
+			err = replace(value, fn)
+		} else if value.Kind() == reflect.Struct || value.Kind() == reflect.Pointer {
+			err = transform(value, fn)
+		}
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// replace replaces a secret value with the result of fn. Strings, string
+// slices, maps with string values and pointers to these are supported, other
+// kinds are left untouched.
+func replace(value reflect.Value, fn func(string) (string, error)) error {
+	switch value.Kind() {
+	case reflect.String:
+		if value.String() == "" {
+			return nil
+		}
+
+		replaced, err := fn(value.String())
+		if err != nil {
+			return err
+		}
+
+		value.SetString(replaced)
+
+	case reflect.Pointer:
+		if value.IsNil() {
+			return nil
+		}
+
+		// Replace a copy of the referenced value, the original must not be modified.
+		cp := reflect.New(value.Elem().Type())
+		cp.Elem().Set(value.Elem())
+
+		err := replace(cp.Elem(), fn)
+		if err != nil {
+			return err
+		}
+
+		value.Set(cp)
+
+	case reflect.Slice:
+		if value.Type().Elem().Kind() != reflect.String || value.Len() == 0 {
+			return nil
+		}
+
+		cp := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		reflect.Copy(cp, value)
+
+		for i := range cp.Len() {
+			err := replace(cp.Index(i), fn)
+			if err != nil {
+				return err
+			}
+		}
+
+		value.Set(cp)
+
+	case reflect.Map:
+		if value.IsNil() {
+			return nil
+		}
+
+		cp := reflect.MakeMapWithSize(value.Type(), value.Len())
+
+		iter := value.MapRange()
+		for iter.Next() {
+			elem := reflect.New(value.Type().Elem()).Elem()
+			elem.Set(iter.Value())
+
+			// Map values of type any are replaced, if they hold a string.
+			if elem.Kind() == reflect.Interface && !elem.IsNil() && elem.Elem().Kind() == reflect.String {
+				str := reflect.New(elem.Elem().Type()).Elem()
+				str.Set(elem.Elem())
+
+				err := replace(str, fn)
+				if err != nil {
+					return err
+				}
+
+				elem.Set(str)
+			}
+
+			err := replace(elem, fn)
+			if err != nil {
+				return err
+			}
+
+			cp.SetMapIndex(iter.Key(), elem)
+		}
+
+		value.Set(cp)
+
+	default:
+	}
+
+	return nil
+}
+
+// maskAny masks a value decoded from JSON.
+func maskAny(v any) any {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return v
+		}
+
+		return Mask
+
+	case map[string]any:
+		masked := make(map[string]any, len(v))
+		for k, e := range v {
+			masked[k] = maskAny(e)
+		}
+
+		return masked
+
+	case []any:
+		masked := make([]any, 0, len(v))
+		for _, e := range v {
+			masked = append(masked, maskAny(e))
+		}
+
+		return masked
+
+	default:
+		return v
+	}
+}
+
+func collectJSONNames(typ reflect.Type, names map[string]bool) {
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if IsSecret(field) {
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name != "" && name != "-" {
+				names[name] = true
+			}
+
+			continue
+		}
+
+		if field.Anonymous {
+			collectJSONNames(field.Type, names)
+		}
+	}
+}
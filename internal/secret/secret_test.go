@@ -0,0 +1,144 @@
+package secret_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/internal/ptr"
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
+)
+
+type Details struct {
+	User     string          `json:"user"`
+	Password string          `json:"password" kuma:"secret"`
+	Token    *string         `json:"token" kuma:"secret"`
+	Keys     []string        `json:"keys" kuma:"secret"`
+	Options  *map[string]any `json:"options" kuma:"secret"`
+	Empty    string          `json:"empty" kuma:"secret"`
+	Nested   nested          `json:"nested"`
+	Nothing  *string         `json:"nothing,omitzero" kuma:"secret"`
+}
+
+type nested struct {
+	Secret string `json:"secret" kuma:"secret"`
+}
+
+type wrapper struct {
+	Details
+
+	Name string `json:"name"`
+}
+
+func TestRedact(t *testing.T) {
+	options := map[string]any{"mechanism": "plain", "password": "sasl"}
+	orig := wrapper{
+		Details: Details{
+			User:     "user",
+			Password: "password",
+			Token:    ptr.To("token"),
+			Keys:     []string{"a", "b"},
+			Options:  &options,
+			Nested:   nested{Secret: "nested"},
+		},
+		Name: "name",
+	}
+
+	got := secret.Redact(orig)
+
+	require.Equal(t, wrapper{
+		Details: Details{
+			User:     "user",
+			Password: secret.Mask,
+			Token:    ptr.To(secret.Mask),
+			Keys:     []string{secret.Mask, secret.Mask},
+			Options:  &map[string]any{"mechanism": secret.Mask, "password": secret.Mask},
+			Nested:   nested{Secret: secret.Mask},
+		},
+		Name: "name",
+	}, got)
+
+	// The original value and the values referenced by it are not modified.
+	require.Equal(t, "password", orig.Password)
+	require.Equal(t, "token", *orig.Token)
+	require.Equal(t, []string{"a", "b"}, orig.Keys)
+	require.Equal(t, "sasl", options["password"])
+	require.Equal(t, "nested", orig.Nested.Secret)
+}
+
+func TestRedact_Interface(t *testing.T) {
+	var v any = nested{Secret: "nested"}
+
+	got := secret.Redact(v)
+
+	require.Equal(t, nested{Secret: secret.Mask}, got)
+	require.Equal(t, nested{Secret: "nested"}, v)
+}
+
+func TestJSONNames(t *testing.T) {
+	got := secret.JSONNames(wrapper{}, nested{})
+
+	require.Equal(t, map[string]bool{
+		"password": true,
+		"token":    true,
+		"keys":     true,
+		"options":  true,
+		"empty":    true,
+		"nothing":  true,
+		"secret":   true,
+	}, got)
+}
+
+func TestRedactMap(t *testing.T) {
+	m := map[string]any{
+		"user":     "user",
+		"password": "password",
+		"empty":    "",
+		"token":    nil,
+		"options":  map[string]any{"mechanism": "plain", "port": float64(1)},
+		"keys":     []any{"a", "b"},
+	}
+
+	secret.RedactMap(m, secret.JSONNames(wrapper{}))
+
+	require.Equal(t, map[string]any{
+		"user":     "user",
+		"password": secret.Mask,
+		"empty":    "",
+		"token":    nil,
+		"options":  map[string]any{"mechanism": secret.Mask, "port": float64(1)},
+		"keys":     []any{secret.Mask, secret.Mask},
+	}, m)
+}
+
+func TestTransform(t *testing.T) {
+	orig := &wrapper{
+		Details: Details{
+			User:     "user",
+			Password: "env:PASSWORD",
+			Token:    ptr.To("env:TOKEN"),
+		},
+	}
+
+	got, err := secret.Transform(orig, func(value string) (string, error) {
+		return strings.TrimPrefix(value, "env:") + "-resolved", nil
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "PASSWORD-resolved", got.Password)
+	require.Equal(t, "TOKEN-resolved", *got.Token)
+	require.Equal(t, "user", got.User)
+
+	// The original value is not modified.
+	require.Equal(t, "env:PASSWORD", orig.Password)
+	require.Equal(t, "env:TOKEN", *orig.Token)
+}
+
+func TestTransform_Error(t *testing.T) {
+	_, err := secret.Transform(Details{Password: "env:PASSWORD"}, func(string) (string, error) {
+		return "", errors.New("not found")
+	})
+	require.EqualError(t, err, "Password: not found")
+}
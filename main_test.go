@@ -20,6 +20,12 @@ import (
 //nolint:gochecknoglobals // client is used across multiple tests.
 var client *kuma.Client
 
+// serverURL is the URL of the Uptime Kuma server, for tests, which require
+// a dedicated client.
+//
+//nolint:gochecknoglobals // serverURL is used across multiple tests.
+var serverURL string
+
 func TestMain(m *testing.M) {
 	code, err := testMainSetup(m)
 	if err != nil {
@@ -86,11 +92,13 @@ func testMainSetup(m *testing.M) (int, error) {
 	}()
 
 	// exponential backoff-retry, because the application in the container might not be ready to accept connections yet
+	serverURL = fmt.Sprintf("http://localhost:%s", resource.GetPort("3001/tcp"))
+
 	retryErr := pool.Retry(func() error {
 		var err error
 		client, err = kuma.New(
 			ctx,
-			fmt.Sprintf("http://localhost:%s", resource.GetPort("3001/tcp")),
+			serverURL,
 			"admin", "admin1",
 			kuma.WithAutosetup(),
 			kuma.WithLogLevel(kuma.LogLevel(os.Getenv("SOCKETIO_LOG_LEVEL"))),
@@ -12,13 +12,14 @@ func (c *Client) GetMaintenances(_ context.Context) ([]maintenance.Maintenance,
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.state.maintenances == nil {
+	cached := c.maintenancesLocked()
+	if cached == nil {
 		return []maintenance.Maintenance{}, nil
 	}
 
 	// Return a copy to prevent external modifications
-	maintenances := make([]maintenance.Maintenance, len(c.state.maintenances))
-	copy(maintenances, c.state.maintenances)
+	maintenances := make([]maintenance.Maintenance, len(cached))
+	copy(maintenances, cached)
 
 	return maintenances, nil
 }
@@ -31,7 +32,7 @@ func (c *Client) GetMaintenance(ctx context.Context, id int64) (*maintenance.Mai
 	}
 
 	if response.Maintenance == nil {
-		return nil, fmt.Errorf("get maintenance %d: maintenance not found in response", id)
+		return nil, fmt.Errorf("get maintenance %d: %w", id, ErrNotFound)
 	}
 
 	var m maintenance.Maintenance
@@ -0,0 +1,268 @@
+package maintenance
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule is a parsed cron expression.
+//
+// The expression consists of 5 fields (minute, hour, day of month, month,
+// day of week) or 6 fields with an additional leading seconds field. Besides
+// numbers, the fields support "*", "?", lists ("1,15"), ranges ("1-5"),
+// steps ("*/15", "10-50/10") as well as month and weekday names ("JAN",
+// "MON"). The day of month field additionally supports "L" for the last
+// day of the month. The macros "@yearly", "@annually", "@monthly", "@weekly",
+// "@daily" and "@hourly" are supported as well.
+//
+// If both, day of month and day of week are restricted, a day matches if
+// either of them matches, which is the behavior of the cron implementation
+// used by Uptime Kuma.
+type cronSchedule struct {
+	seconds  []bool
+	minutes  []bool
+	hours    []bool
+	days     []bool
+	months   []bool
+	weekdays []bool
+
+	lastDay          bool
+	daysWildcard     bool
+	weekdaysWildcard bool
+}
+
+//nolint:gochecknoglobals // lookup table for cron month names.
+var cronMonthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+//nolint:gochecknoglobals // lookup table for cron weekday names.
+var cronWeekdayNames = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+//nolint:gochecknoglobals // lookup table for cron macros.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// parseCron parses a cron expression.
+func parseCron(expr string) (*cronSchedule, error) {
+	expr = strings.TrimSpace(expr)
+	if macro, ok := cronMacros[strings.ToLower(expr)]; ok {
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+
+	case 6:
+	default:
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 or 6 fields, got %d", expr, len(fields))
+	}
+
+	s := &cronSchedule{}
+
+	var err error
+
+	s.seconds, _, err = parseCronField(fields[0], 0, 59, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: seconds: %w", expr, err)
+	}
+
+	s.minutes, _, err = parseCronField(fields[1], 0, 59, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: minutes: %w", expr, err)
+	}
+
+	s.hours, _, err = parseCronField(fields[2], 0, 23, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: hours: %w", expr, err)
+	}
+
+	dayField := fields[3]
+	if strings.EqualFold(dayField, "L") {
+		s.lastDay = true
+		dayField = ""
+	} else if trimmed, ok := strings.CutSuffix(dayField, ",L"); ok {
+		s.lastDay = true
+		dayField = trimmed
+	}
+
+	if dayField == "" {
+		s.days = make([]bool, 32)
+	} else {
+		s.days, s.daysWildcard, err = parseCronField(dayField, 1, 31, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: day of month: %w", expr, err)
+		}
+	}
+
+	s.months, _, err = parseCronField(fields[4], 1, 12, cronMonthNames)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: month: %w", expr, err)
+	}
+
+	s.weekdays, s.weekdaysWildcard, err = parseCronField(fields[5], 0, 7, cronWeekdayNames)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: day of week: %w", expr, err)
+	}
+
+	// Both, 0 and 7 represent Sunday.
+	if s.weekdays[7] {
+		s.weekdays[0] = true
+	}
+
+	return s, nil
+}
+
+// parseCronField parses a single cron field with values in the range
+// [minimum, maximum]. It returns the set of matching values, indexed by
+// value, and whether the field is a wildcard.
+func parseCronField(field string, minimum int, maximum int, names map[string]int) ([]bool, bool, error) {
+	set := make([]bool, maximum+1)
+
+	if field == "*" || field == "?" {
+		for i := minimum; i <= maximum; i++ {
+			set[i] = true
+		}
+
+		return set, true, nil
+	}
+
+	for part := range strings.SplitSeq(field, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+
+			step, err = strconv.Atoi(stepExpr)
+			if err != nil || step < 1 {
+				return nil, false, fmt.Errorf("invalid step %q", stepExpr)
+			}
+		}
+
+		var start, end int
+
+		switch {
+		case rangeExpr == "*" || rangeExpr == "?":
+			start, end = minimum, maximum
+
+		case strings.Contains(rangeExpr, "-"):
+			startExpr, endExpr, _ := strings.Cut(rangeExpr, "-")
+
+			var err error
+
+			start, err = parseCronValue(startExpr, minimum, maximum, names)
+			if err != nil {
+				return nil, false, err
+			}
+
+			end, err = parseCronValue(endExpr, minimum, maximum, names)
+			if err != nil {
+				return nil, false, err
+			}
+
+			if end < start {
+				return nil, false, fmt.Errorf("invalid range %q", rangeExpr)
+			}
+
+		default:
+			var err error
+
+			start, err = parseCronValue(rangeExpr, minimum, maximum, names)
+			if err != nil {
+				return nil, false, err
+			}
+
+			end = start
+			if hasStep {
+				end = maximum
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			set[i] = true
+		}
+	}
+
+	return set, false, nil
+}
+
+// parseCronValue parses a single numeric or named cron value.
+func parseCronValue(value string, minimum int, maximum int, names map[string]int) (int, error) {
+	if n, ok := names[strings.ToUpper(value)]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+
+	if n < minimum || n > maximum {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", n, minimum, maximum)
+	}
+
+	return n, nil
+}
+
+// matchesDay reports whether the given civil date matches the day of month,
+// month and day of week fields of the schedule.
+func (s *cronSchedule) matchesDay(date time.Time) bool {
+	if !s.months[int(date.Month())] {
+		return false
+	}
+
+	dayMatch := s.days[date.Day()] || (s.lastDay && isLastDayOfMonth(date, 1))
+	weekdayMatch := s.weekdays[int(date.Weekday())]
+
+	dayRestricted := !s.daysWildcard
+	weekdayRestricted := !s.weekdaysWildcard
+
+	if dayRestricted && weekdayRestricted {
+		return dayMatch || weekdayMatch
+	}
+
+	return dayMatch && weekdayMatch
+}
+
+// timesOfDay returns the times of the given civil date in loc, which match
+// the schedule, in ascending order.
+func (s *cronSchedule) timesOfDay(date time.Time, loc *time.Location) []time.Time {
+	var times []time.Time
+
+	for hour, hourOK := range s.hours {
+		if !hourOK {
+			continue
+		}
+
+		for minute, minuteOK := range s.minutes {
+			if !minuteOK {
+				continue
+			}
+
+			for second, secondOK := range s.seconds {
+				if !secondOK {
+					continue
+				}
+
+				times = append(times, wallClock(date, TimeOfDay{Hours: hour, Minutes: minute, Seconds: second}, loc))
+			}
+		}
+	}
+
+	return times
+}
@@ -34,4 +34,10 @@
 //	    },
 //	    "America/New_York",
 //	)
+//
+//	// Preview the next 5 maintenance windows without contacting the server
+//	windows, err := maintenance.NextWindows(m, time.Now(), 5)
+//
+//	// Export maintenance windows as iCalendar for calendar applications
+//	data, warnings, err := maintenance.ToICS(maintenances)
 package maintenance
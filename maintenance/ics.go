@@ -0,0 +1,521 @@
+package maintenance
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	icsDateTimeFormat = "20060102T150405"
+	icsDateFormat     = "20060102"
+	icsProdID         = "-//breml//go-uptime-kuma-client//EN"
+
+	// icsMaxLineLength is the maximum length of a content line in octets,
+	// excluding the line break (RFC 5545, section 3.1).
+	icsMaxLineLength = 75
+
+	// icsCronExpansion is the number of windows, a cron maintenance is
+	// expanded to, if the cron expression can not be represented as
+	// recurrence rule.
+	icsCronExpansion = 50
+
+	// icsTimezoneYears is the number of years covered by the time zone
+	// transitions of the generated VTIMEZONE components.
+	icsTimezoneYears = 10
+
+	// icsPropertyID, icsPropertyStrategy and icsPropertyCron are non-standard
+	// properties, which retain information, which is not part of the
+	// iCalendar representation.
+	icsPropertyID       = "X-UPTIME-KUMA-ID"
+	icsPropertyStrategy = "X-UPTIME-KUMA-STRATEGY"
+	icsPropertyCron     = "X-UPTIME-KUMA-CRON"
+)
+
+//nolint:gochecknoglobals // lookup table for iCalendar weekday names, indexed by time.Weekday.
+var icsWeekdays = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// ICSWarning describes a maintenance window or calendar event, which could not
+// be converted exactly or not at all.
+type ICSWarning struct {
+	// Title is the title of the maintenance window or the summary of the
+	// calendar event.
+	Title string
+
+	// Message describes the issue.
+	Message string
+}
+
+func (w ICSWarning) String() string {
+	return fmt.Sprintf("%q: %s", w.Title, w.Message)
+}
+
+// ToICS converts the maintenance windows to an iCalendar (RFC 5545) calendar,
+// which can be subscribed to or imported by calendar applications.
+//
+// Every maintenance window is mapped to a VEVENT:
+//   - single: DTSTART and DTEND of the window.
+//   - recurring-interval: RRULE with FREQ=DAILY and INTERVAL.
+//   - recurring-weekday: RRULE with FREQ=WEEKLY and BYDAY.
+//   - recurring-day-of-month: RRULE with FREQ=MONTHLY and BYMONTHDAY, where
+//     "lastDay1" to "lastDay4" are mapped to -1 to -4.
+//   - cron: RRULE with FREQ=DAILY and the respective BYxxx rules. Cron
+//     expressions restricting both, day of month and day of week, can not be
+//     represented as RRULE. These are expanded to the next windows (RDATE)
+//     and a warning is returned.
+//   - manual: manual maintenance windows have no schedule, they are skipped
+//     with a warning.
+//
+// An optional DateRange of the recurring strategies is mapped to DTSTART and
+// UNTIL. Recurring windows without start date start at the next window.
+// Paused maintenance windows are exported with STATUS:TENTATIVE.
+//
+// The times are exported in the time zone of the maintenance window (see
+// Location). For time zones other than UTC, a VTIMEZONE component is
+// generated. Windows in the local time zone of an unknown server time zone
+// are exported in UTC with a warning.
+//
+// The ID, the strategy and the cron expression are retained in non-standard
+// X-UPTIME-KUMA-* properties, which are used by FromICS.
+func ToICS(maintenances []Maintenance) ([]byte, []ICSWarning, error) {
+	e := icsExporter{
+		now:       time.Now(),
+		timezones: map[string]time.Time{},
+		locations: map[string]*time.Location{},
+	}
+
+	for i := range maintenances {
+		err := e.addMaintenance(&maintenances[i])
+		if err != nil {
+			return nil, e.warnings, fmt.Errorf("to ics: maintenance %q: %w", maintenances[i].Title, err)
+		}
+	}
+
+	w := icsWriter{}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + icsProdID)
+	w.line("CALSCALE:GREGORIAN")
+
+	names := make([]string, 0, len(e.timezones))
+	for name := range e.timezones {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		w.lines(icsTimezone(e.locations[name], e.timezones[name]))
+	}
+
+	w.lines(e.events)
+	w.line("END:VCALENDAR")
+
+	return w.buf.Bytes(), e.warnings, nil
+}
+
+type icsExporter struct {
+	now time.Time
+
+	// timezones holds the earliest start time for every time zone, which is
+	// referenced by TZID.
+	timezones map[string]time.Time
+	locations map[string]*time.Location
+
+	events   []string
+	warnings []ICSWarning
+}
+
+func (e *icsExporter) warn(m *Maintenance, format string, args ...any) {
+	e.warnings = append(e.warnings, ICSWarning{Title: m.Title, Message: fmt.Sprintf(format, args...)})
+}
+
+func (e *icsExporter) addMaintenance(m *Maintenance) error {
+	if m.Strategy == "manual" {
+		e.warn(m, "manual maintenance has no schedule, skipped")
+		return nil
+	}
+
+	loc, err := Location(m)
+	if err != nil {
+		return err
+	}
+
+	if loc == time.Local {
+		e.warn(m, "server time zone is unknown, times are exported in UTC")
+
+		loc = time.UTC
+	}
+
+	anchor := e.now
+	rangeStart, rangeEnd := dateRange(m)
+
+	if rangeStart != nil {
+		anchor = *rangeStart
+	} else if m.Strategy == "recurring-interval" {
+		e.warn(m, "no start date, the interval is anchored at the time of the export")
+	}
+
+	var rrule string
+	var rdates []Timeslot
+
+	switch m.Strategy {
+	case "single":
+
+	case "recurring-interval":
+		rrule = fmt.Sprintf("FREQ=DAILY;INTERVAL=%d", m.IntervalDay)
+
+	case "recurring-weekday":
+		weekdays := make([]bool, 7)
+		for _, wd := range m.Weekdays {
+			if wd < 0 || wd > 7 {
+				return fmt.Errorf("invalid weekday %d", wd)
+			}
+
+			weekdays[wd%7] = true
+		}
+
+		rrule = "FREQ=WEEKLY;BYDAY=" + icsByDay(weekdays)
+
+	case "recurring-day-of-month":
+		days, lastDays, err := parseDaysOfMonth(m.DaysOfMonth)
+		if err != nil {
+			return err
+		}
+
+		monthDays := icsList(days, 1)
+		for _, nth := range lastDays {
+			monthDays = append(monthDays, strconv.Itoa(-nth))
+		}
+
+		rrule = "FREQ=MONTHLY;BYMONTHDAY=" + strings.Join(monthDays, ",")
+
+	case "cron":
+		schedule, err := parseCron(m.Cron)
+		if err != nil {
+			return err
+		}
+
+		var ok bool
+
+		rrule, ok = schedule.rrule()
+		if !ok {
+			from := e.now
+			if rangeStart != nil && rangeStart.After(from) {
+				from = *rangeStart
+			}
+
+			rdates, err = NextWindows(m, from, icsCronExpansion)
+			if err != nil {
+				return err
+			}
+
+			e.warn(m, "cron expression %q can not be represented as recurrence rule, expanded to the next %d windows",
+				m.Cron, len(rdates))
+		}
+
+	default:
+		return fmt.Errorf("unsupported strategy %q", m.Strategy)
+	}
+
+	first := rdates
+	if len(first) == 0 {
+		first, err = NextWindows(m, anchor, 1)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(first) == 0 {
+		e.warn(m, "maintenance has no window, skipped")
+		return nil
+	}
+
+	window := first[0]
+
+	if rrule != "" && rangeEnd != nil {
+		// The windows need to start before the end of the date range, UNTIL
+		// is inclusive.
+		rrule += ";UNTIL=" + rangeEnd.Add(-time.Second).UTC().Format(icsDateTimeFormat) + "Z"
+	}
+
+	if loc != time.UTC {
+		if earliest, ok := e.timezones[loc.String()]; !ok || window.StartDate.Before(earliest) {
+			e.timezones[loc.String()] = window.StartDate
+			e.locations[loc.String()] = loc
+		}
+	}
+
+	status := "CONFIRMED"
+	if !m.Active {
+		status = "TENTATIVE"
+	}
+
+	event := []string{
+		"BEGIN:VEVENT",
+		"UID:" + icsUID(m),
+		"DTSTAMP:" + e.now.UTC().Format(icsDateTimeFormat) + "Z",
+		icsDateTime("DTSTART", window.StartDate, loc),
+		icsDateTime("DTEND", window.EndDate, loc),
+	}
+
+	if rrule != "" {
+		event = append(event, "RRULE:"+rrule)
+	}
+
+	if len(rdates) > 1 {
+		values := make([]string, 0, len(rdates)-1)
+		for _, rdate := range rdates[1:] {
+			values = append(values, icsDateTimeValue(rdate.StartDate, loc))
+		}
+
+		event = append(event, icsDateTimeParams("RDATE", loc)+":"+strings.Join(values, ","))
+	}
+
+	event = append(event, "SUMMARY:"+icsEscape(m.Title))
+	if m.Description != "" {
+		event = append(event, "DESCRIPTION:"+icsEscape(m.Description))
+	}
+
+	event = append(event, "STATUS:"+status)
+	if m.ID > 0 {
+		event = append(event, icsPropertyID+":"+strconv.FormatInt(m.ID, 10))
+	}
+
+	event = append(event, icsPropertyStrategy+":"+m.Strategy)
+	if m.Strategy == "cron" {
+		event = append(event, icsPropertyCron+":"+icsEscape(m.Cron))
+	}
+
+	event = append(event, "END:VEVENT")
+
+	e.events = append(e.events, event...)
+
+	return nil
+}
+
+// rrule returns the recurrence rule equivalent to the cron schedule. Cron
+// schedules, which restrict both, day of month and day of week, match if
+// either of them matches. This can not be represented as a single recurrence
+// rule, in which case false is returned.
+func (s *cronSchedule) rrule() (string, bool) {
+	if !s.daysWildcard && !s.weekdaysWildcard {
+		return "", false
+	}
+
+	parts := []string{"FREQ=DAILY"}
+
+	if slices.Contains(s.months[1:], false) {
+		parts = append(parts, "BYMONTH="+strings.Join(icsList(s.months, 1), ","))
+	}
+
+	if !s.daysWildcard {
+		days := icsList(s.days, 1)
+		if s.lastDay {
+			days = append(days, "-1")
+		}
+
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+
+	if !s.weekdaysWildcard {
+		parts = append(parts, "BYDAY="+icsByDay(s.weekdays[:7]))
+	}
+
+	parts = append(parts, "BYHOUR="+strings.Join(icsList(s.hours, 0), ","))
+	parts = append(parts, "BYMINUTE="+strings.Join(icsList(s.minutes, 0), ","))
+
+	if seconds := icsList(s.seconds, 0); len(seconds) != 1 || seconds[0] != "0" {
+		parts = append(parts, "BYSECOND="+strings.Join(seconds, ","))
+	}
+
+	return strings.Join(parts, ";"), true
+}
+
+// icsTimezone returns the VTIMEZONE component for loc. The component
+// contains the time zone transitions of icsTimezoneYears years, starting
+// with the year of from.
+func icsTimezone(loc *time.Location, from time.Time) []string {
+	from = time.Date(from.In(loc).Year(), time.January, 1, 0, 0, 0, 0, loc)
+	to := from.AddDate(icsTimezoneYears, 0, 0)
+
+	lines := []string{"BEGIN:VTIMEZONE", "TZID:" + loc.String()}
+
+	_, offset := from.Zone()
+	lines = append(lines, icsObservance(from, offset)...)
+
+	for t := from; t.Before(to); {
+		next := t.Add(24 * time.Hour)
+		if !icsSameZone(t, next) {
+			// Find the exact time of the transition.
+			lo, hi := t, next
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2)
+				if icsSameZone(lo, mid) {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+
+			_, offsetFrom := lo.Zone()
+			lines = append(lines, icsObservance(hi, offsetFrom)...)
+		}
+
+		t = next
+	}
+
+	lines = append(lines, "END:VTIMEZONE")
+
+	return lines
+}
+
+// icsObservance returns the STANDARD or DAYLIGHT component for the time zone
+// observance, which starts at t. offsetFrom is the UTC offset in seconds
+// before t.
+func icsObservance(t time.Time, offsetFrom int) []string {
+	component := "STANDARD"
+	if t.IsDST() {
+		component = "DAYLIGHT"
+	}
+
+	name, offsetTo := t.Zone()
+
+	return []string{
+		"BEGIN:" + component,
+		"DTSTART:" + t.In(time.FixedZone("", offsetFrom)).Format(icsDateTimeFormat),
+		"TZOFFSETFROM:" + icsUTCOffset(offsetFrom),
+		"TZOFFSETTO:" + icsUTCOffset(offsetTo),
+		"TZNAME:" + icsEscape(name),
+		"END:" + component,
+	}
+}
+
+func icsSameZone(a time.Time, b time.Time) bool {
+	nameA, offsetA := a.Zone()
+	nameB, offsetB := b.Zone()
+
+	return nameA == nameB && offsetA == offsetB && a.IsDST() == b.IsDST()
+}
+
+// icsUTCOffset formats the UTC offset in seconds as [+-]hhmm[ss].
+func icsUTCOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+
+	s := fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset%3600/60)
+	if seconds := offset % 60; seconds != 0 {
+		s += fmt.Sprintf("%02d", seconds)
+	}
+
+	return s
+}
+
+// icsUID returns a stable unique identifier for the maintenance window.
+func icsUID(m *Maintenance) string {
+	if m.ID > 0 {
+		return fmt.Sprintf("maintenance-%d@uptime-kuma", m.ID)
+	}
+
+	sum := sha256.Sum256([]byte(m.Strategy + "\x00" + m.Title + "\x00" + m.Description))
+
+	return fmt.Sprintf("maintenance-%x@uptime-kuma", sum[:8])
+}
+
+func icsDateTime(name string, t time.Time, loc *time.Location) string {
+	return icsDateTimeParams(name, loc) + ":" + icsDateTimeValue(t, loc)
+}
+
+func icsDateTimeParams(name string, loc *time.Location) string {
+	if loc == time.UTC {
+		return name
+	}
+
+	return name + ";TZID=" + loc.String()
+}
+
+func icsDateTimeValue(t time.Time, loc *time.Location) string {
+	if loc == time.UTC {
+		return t.UTC().Format(icsDateTimeFormat) + "Z"
+	}
+
+	return t.In(loc).Format(icsDateTimeFormat)
+}
+
+// icsByDay returns the BYDAY value for the set of weekdays, indexed by
+// time.Weekday. The week starts on Monday.
+func icsByDay(weekdays []bool) string {
+	var days []string
+	for i := range 7 {
+		wd := (i + 1) % 7
+		if weekdays[wd] {
+			days = append(days, icsWeekdays[wd])
+		}
+	}
+
+	return strings.Join(days, ",")
+}
+
+// icsList returns the values contained in set, starting at minimum.
+func icsList(set []bool, minimum int) []string {
+	var values []string
+	for i := minimum; i < len(set); i++ {
+		if set[i] {
+			values = append(values, strconv.Itoa(i))
+		}
+	}
+
+	return values
+}
+
+// icsEscape escapes a TEXT value (RFC 5545, section 3.3.11).
+func icsEscape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// icsWriter writes content lines, folded to icsMaxLineLength octets.
+type icsWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *icsWriter) lines(lines []string) {
+	for _, line := range lines {
+		w.line(line)
+	}
+}
+
+func (w *icsWriter) line(line string) {
+	limit := icsMaxLineLength
+	for len(line) > limit {
+		// Do not split multi-octet characters.
+		n := limit
+		for n > 0 && !utf8.RuneStart(line[n]) {
+			n--
+		}
+
+		w.buf.WriteString(line[:n])
+		w.buf.WriteString("\r\n ")
+
+		line = line[n:]
+
+		// The leading space of continuation lines counts towards the limit.
+		limit = icsMaxLineLength - 1
+	}
+
+	w.buf.WriteString(line)
+	w.buf.WriteString("\r\n")
+}
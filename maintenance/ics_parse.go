@@ -0,0 +1,926 @@
+package maintenance
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// icsMaxLineSize limits the size of a single unfolded content line.
+const icsMaxLineSize = 1 << 20
+
+//nolint:gochecknoglobals // regular expression for iCalendar durations (RFC 5545, section 3.3.6).
+var icsDurationRegexp = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// FromICS reads an iCalendar (RFC 5545) calendar and converts its events
+// to maintenance windows.
+//
+// The events are mapped as follows:
+//   - Events without RRULE are mapped to single maintenance windows.
+//   - Daily recurring events without further rules are mapped to
+//     recurring-interval.
+//   - Weekly recurring events on fixed weekdays are mapped to
+//     recurring-weekday.
+//   - Monthly recurring events on fixed days of the month (including -1 to
+//     -4 for the last days of the month) are mapped to recurring-day-of-month.
+//   - Other recurring events are mapped to cron, if possible.
+//   - Events exported by ToICS with a cron expression are mapped to the
+//     original cron expression.
+//
+// The windows of recurring strategies need to be shorter than a day, longer
+// windows are mapped to cron. DTSTART and UNTIL (or the last occurrence
+// defined by COUNT) are mapped to DateRange.
+//
+// Times with a TZID are mapped to the respective time zone, UTC times to
+// "UTC" and floating times and dates to "SAME_AS_SERVER". Time zones, which
+// are not known to the IANA time zone database, e.g. Windows time zone names,
+// are converted to UTC, based on the VTIMEZONE component, with a warning.
+//
+// Events, which can not be represented as maintenance window, e.g. events
+// recurring every second week, as well as canceled events are skipped with a
+// warning. Tentative events are mapped to paused maintenance windows.
+// Properties without equivalent, e.g. EXDATE, are ignored with a warning.
+func FromICS(r io.Reader) ([]Maintenance, []ICSWarning, error) {
+	components, err := parseICS(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("from ics: %w", err)
+	}
+
+	var maintenances []Maintenance
+	var warnings []ICSWarning
+
+	for _, calendar := range components {
+		if calendar.name != "VCALENDAR" {
+			continue
+		}
+
+		offsets := icsTimezoneOffsets(calendar)
+
+		for _, event := range calendar.components {
+			if event.name != "VEVENT" {
+				continue
+			}
+
+			i := icsImporter{event: event, offsets: offsets}
+
+			m := i.maintenance()
+			if m != nil {
+				maintenances = append(maintenances, *m)
+			}
+
+			warnings = append(warnings, i.warnings...)
+		}
+	}
+
+	return maintenances, warnings, nil
+}
+
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+type icsComponent struct {
+	name       string
+	properties []icsProperty
+	components []*icsComponent
+}
+
+// property returns the first property with the given name.
+func (c *icsComponent) property(name string) (icsProperty, bool) {
+	for _, p := range c.properties {
+		if p.name == name {
+			return p, true
+		}
+	}
+
+	return icsProperty{}, false
+}
+
+// parseICS parses the content lines of an iCalendar stream into components.
+func parseICS(r io.Reader) ([]*icsComponent, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), icsMaxLineSize)
+
+	var lines []string
+
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+
+		// Unfold continuation lines, which start with a space or a tab.
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		if line == "" {
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("read calendar: %w", err)
+	}
+
+	var roots []*icsComponent
+	var stack []*icsComponent
+
+	for n, line := range lines {
+		p, err := parseICSProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+1, err)
+		}
+
+		switch p.name {
+		case "BEGIN":
+			c := &icsComponent{name: strings.ToUpper(p.value)}
+			if len(stack) == 0 {
+				roots = append(roots, c)
+			} else {
+				parent := stack[len(stack)-1]
+				parent.components = append(parent.components, c)
+			}
+
+			stack = append(stack, c)
+
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].name != strings.ToUpper(p.value) {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", n+1, p.value)
+			}
+
+			stack = stack[:len(stack)-1]
+
+		default:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("line %d: property %s outside of component", n+1, p.name)
+			}
+
+			c := stack[len(stack)-1]
+			c.properties = append(c.properties, p)
+		}
+	}
+
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("missing END:%s", stack[len(stack)-1].name)
+	}
+
+	if len(roots) == 0 {
+		return nil, errors.New("no calendar found")
+	}
+
+	return roots, nil
+}
+
+// parseICSProperty parses a single unfolded content line of the form
+// name *(";" param) ":" value.
+func parseICSProperty(line string) (icsProperty, error) {
+	p := icsProperty{params: map[string]string{}}
+
+	quoted := false
+	colon := -1
+
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		}
+
+		if r == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+
+	if colon < 0 {
+		return p, fmt.Errorf("invalid content line %q", line)
+	}
+
+	head := line[:colon]
+	p.value = line[colon+1:]
+
+	parts := splitICSQuoted(head, ';')
+	p.name = strings.ToUpper(parts[0])
+
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		p.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+
+	return p, nil
+}
+
+// splitICSQuoted splits s at sep, except within double quotes.
+func splitICSQuoted(s string, sep rune) []string {
+	var parts []string
+
+	quoted := false
+	start := 0
+
+	for i, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+
+		case r == sep && !quoted:
+			parts = append(parts, s[start:i])
+			start = i + 1
+
+		default:
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+// icsUnescape unescapes a TEXT value (RFC 5545, section 3.3.11).
+func icsUnescape(s string) string {
+	var b strings.Builder
+
+	escaped := false
+
+	for _, r := range s {
+		if !escaped {
+			if r == '\\' {
+				escaped = true
+			} else {
+				b.WriteRune(r)
+			}
+
+			continue
+		}
+
+		escaped = false
+
+		switch r {
+		case 'n', 'N':
+			b.WriteRune('\n')
+
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// icsTimezoneOffsets returns the UTC offset of the latest standard time
+// observance (or the last observance, if there is no standard time) of the
+// VTIMEZONE components in the calendar by TZID. The offsets are used for
+// time zones, which are not known to the time zone database.
+func icsTimezoneOffsets(calendar *icsComponent) map[string]int {
+	offsets := map[string]int{}
+
+	for _, tz := range calendar.components {
+		if tz.name != "VTIMEZONE" {
+			continue
+		}
+
+		tzid, ok := tz.property("TZID")
+		if !ok {
+			continue
+		}
+
+		var latestStandard string
+
+		for _, observance := range tz.components {
+			offsetTo, ok := observance.property("TZOFFSETTO")
+			if !ok {
+				continue
+			}
+
+			offset, err := parseICSUTCOffset(offsetTo.value)
+			if err != nil {
+				continue
+			}
+
+			start, _ := observance.property("DTSTART")
+
+			switch {
+			case observance.name == "STANDARD" && start.value >= latestStandard:
+				offsets[tzid.value] = offset
+				latestStandard = start.value
+
+			case latestStandard == "":
+				offsets[tzid.value] = offset
+
+			default:
+			}
+		}
+	}
+
+	return offsets
+}
+
+func parseICSUTCOffset(value string) (int, error) {
+	if len(value) != 5 && len(value) != 7 {
+		return 0, fmt.Errorf("invalid UTC offset %q", value)
+	}
+
+	sign := 1
+
+	switch value[0] {
+	case '+':
+	case '-':
+		sign = -1
+
+	default:
+		return 0, fmt.Errorf("invalid UTC offset %q", value)
+	}
+
+	hours, errHours := strconv.Atoi(value[1:3])
+	minutes, errMinutes := strconv.Atoi(value[3:5])
+	seconds := 0
+
+	var errSeconds error
+	if len(value) == 7 {
+		seconds, errSeconds = strconv.Atoi(value[5:7])
+	}
+
+	if errHours != nil || errMinutes != nil || errSeconds != nil {
+		return 0, fmt.Errorf("invalid UTC offset %q", value)
+	}
+
+	return sign * (hours*3600 + minutes*60 + seconds), nil
+}
+
+// icsImporter converts a single VEVENT to a maintenance window.
+type icsImporter struct {
+	event   *icsComponent
+	offsets map[string]int
+
+	title    string
+	warnings []ICSWarning
+}
+
+func (i *icsImporter) warn(format string, args ...any) {
+	warning := ICSWarning{Title: i.title, Message: fmt.Sprintf(format, args...)}
+	if slices.Contains(i.warnings, warning) {
+		return
+	}
+
+	i.warnings = append(i.warnings, warning)
+}
+
+// icsTime is a parsed DATE or DATE-TIME value.
+type icsTime struct {
+	time.Time
+
+	timezoneOption string
+	allDay         bool
+}
+
+// maintenance returns the maintenance window for the event or nil, if the
+// event is skipped.
+func (i *icsImporter) maintenance() *Maintenance {
+	summary, _ := i.event.property("SUMMARY")
+	i.title = icsUnescape(summary.value)
+
+	if status, ok := i.event.property("STATUS"); ok && strings.EqualFold(status.value, "CANCELLED") {
+		i.warn("event is canceled, skipped")
+		return nil
+	}
+
+	dtstart, ok := i.event.property("DTSTART")
+	if !ok {
+		i.warn("event has no DTSTART, skipped")
+		return nil
+	}
+
+	start, err := i.time(dtstart)
+	if err != nil {
+		i.warn("invalid DTSTART: %v, skipped", err)
+		return nil
+	}
+
+	duration, err := i.duration(start)
+	if err != nil {
+		i.warn("%v, skipped", err)
+		return nil
+	}
+
+	description, _ := i.event.property("DESCRIPTION")
+
+	m := &Maintenance{
+		Title:          i.title,
+		Description:    icsUnescape(description.value),
+		Active:         true,
+		DateRange:      []*time.Time{nil, nil},
+		TimezoneOption: start.timezoneOption,
+	}
+
+	if status, ok := i.event.property("STATUS"); ok && strings.EqualFold(status.value, "TENTATIVE") {
+		m.Active = false
+	}
+
+	if id, ok := i.event.property(icsPropertyID); ok {
+		m.ID, _ = strconv.ParseInt(id.value, 10, 64)
+	}
+
+	if _, ok := i.event.property("EXDATE"); ok {
+		i.warn("excluded dates (EXDATE) are not supported and ignored")
+	}
+
+	rrule, hasRRule := i.event.property("RRULE")
+
+	if cron, ok := i.event.property(icsPropertyCron); ok {
+		m.Strategy = "cron"
+		m.Cron = icsUnescape(cron.value)
+		m.DurationMinutes = i.durationMinutes(duration)
+
+		if hasRRule {
+			rule := parseICSRRule(rrule.value)
+			if until, ok := rule["UNTIL"]; ok {
+				err = i.setUntil(m, until)
+				if err != nil {
+					i.warn("%v, ignored", err)
+				}
+			}
+		}
+
+		return m
+	}
+
+	if !hasRRule {
+		if _, ok := i.event.property("RDATE"); ok {
+			i.warn("additional dates (RDATE) are not supported and ignored")
+		}
+
+		end := start.Add(duration)
+		m.Strategy = "single"
+		m.DateRange = []*time.Time{&start.Time, &end}
+
+		return m
+	}
+
+	err = i.recurrence(m, start, duration, parseICSRRule(rrule.value))
+	if err != nil {
+		i.warn("recurrence rule %q: %v, skipped", rrule.value, err)
+		return nil
+	}
+
+	return m
+}
+
+// recurrence maps the recurrence rule to the strategy of m.
+func (i *icsImporter) recurrence(m *Maintenance, start icsTime, duration time.Duration, rule map[string]string) error {
+	for _, key := range []string{"BYSETPOS", "BYWEEKNO", "BYYEARDAY"} {
+		if _, ok := rule[key]; ok {
+			return fmt.Errorf("%s is not supported", key)
+		}
+	}
+
+	freq := rule["FREQ"]
+
+	interval := 1
+	if value, ok := rule["INTERVAL"]; ok {
+		var err error
+
+		interval, err = strconv.Atoi(value)
+		if err != nil || interval < 1 {
+			return fmt.Errorf("invalid INTERVAL %q", value)
+		}
+	}
+
+	byDay, err := parseICSByDay(rule["BYDAY"])
+	if err != nil {
+		return err
+	}
+
+	byMonthDay, err := parseICSIntList(rule["BYMONTHDAY"], -31, 31)
+	if err != nil {
+		return fmt.Errorf("BYMONTHDAY: %w", err)
+	}
+
+	byMonth, err := parseICSIntList(rule["BYMONTH"], 1, 12)
+	if err != nil {
+		return fmt.Errorf("BYMONTH: %w", err)
+	}
+
+	byHour, err := parseICSIntList(rule["BYHOUR"], 0, 23)
+	if err != nil {
+		return fmt.Errorf("BYHOUR: %w", err)
+	}
+
+	byMinute, err := parseICSIntList(rule["BYMINUTE"], 0, 59)
+	if err != nil {
+		return fmt.Errorf("BYMINUTE: %w", err)
+	}
+
+	bySecond, err := parseICSIntList(rule["BYSECOND"], 0, 59)
+	if err != nil {
+		return fmt.Errorf("BYSECOND: %w", err)
+	}
+
+	byTime := len(byHour) > 0 || len(byMinute) > 0 || len(bySecond) > 0
+
+	// The recurring strategies are limited to windows shorter than a day.
+	short := duration < 24*time.Hour
+	timeRange := []TimeOfDay{icsTimeOfDay(start.Time), icsTimeOfDay(start.Add(duration).In(start.Location()))}
+
+	startDate := start.Time
+	m.DateRange = []*time.Time{&startDate, nil}
+
+	switch {
+	case freq == "DAILY" && short && !byTime && len(byDay) == 0 && len(byMonthDay) == 0 && len(byMonth) == 0:
+		m.Strategy = "recurring-interval"
+		m.IntervalDay = interval
+		m.TimeRange = timeRange
+
+	case freq == "WEEKLY" && interval == 1 && short && !byTime && len(byMonthDay) == 0 && len(byMonth) == 0:
+		if len(byDay) == 0 {
+			byDay = []time.Weekday{start.Weekday()}
+		}
+
+		m.Strategy = "recurring-weekday"
+		m.TimeRange = timeRange
+
+		for _, wd := range byDay {
+			// 1=Monday, ..., 7=Sunday.
+			m.Weekdays = append(m.Weekdays, (int(wd)+6)%7+1)
+		}
+
+		slices.Sort(m.Weekdays)
+
+	case freq == "MONTHLY" && interval == 1 && short && !byTime && len(byDay) == 0 && len(byMonth) == 0 &&
+		!slices.ContainsFunc(byMonthDay, func(day int) bool { return day < -4 }):
+		if len(byMonthDay) == 0 {
+			byMonthDay = []int{start.Day()}
+		}
+
+		m.Strategy = "recurring-day-of-month"
+		m.TimeRange = timeRange
+
+		for _, day := range byMonthDay {
+			if day < 0 {
+				m.DaysOfMonth = append(m.DaysOfMonth, fmt.Sprintf("lastDay%d", -day))
+				continue
+			}
+
+			m.DaysOfMonth = append(m.DaysOfMonth, day)
+		}
+
+	default:
+		if interval != 1 {
+			return fmt.Errorf("FREQ=%s with INTERVAL=%d is not supported", freq, interval)
+		}
+
+		cron, err := icsCron(freq, start.Time, byMonth, byMonthDay, byDay, byHour, byMinute, bySecond)
+		if err != nil {
+			return err
+		}
+
+		m.Strategy = "cron"
+		m.Cron = cron
+		m.DurationMinutes = i.durationMinutes(duration)
+	}
+
+	if until, ok := rule["UNTIL"]; ok {
+		return i.setUntil(m, until)
+	}
+
+	if value, ok := rule["COUNT"]; ok {
+		count, err := strconv.Atoi(value)
+		if err != nil || count < 1 {
+			return fmt.Errorf("invalid COUNT %q", value)
+		}
+
+		windows, err := NextWindows(m, start.Time, count)
+		if err != nil {
+			return err
+		}
+
+		if len(windows) > 0 {
+			// The windows need to start before the end of the date range.
+			end := windows[len(windows)-1].StartDate.Add(time.Second)
+			m.DateRange[1] = &end
+		}
+	}
+
+	return nil
+}
+
+// icsCron returns the cron expression for the recurrence rule.
+func icsCron(
+	freq string,
+	start time.Time,
+	byMonth []int,
+	byMonthDay []int,
+	byDay []time.Weekday,
+	byHour []int,
+	byMinute []int,
+	bySecond []int,
+) (string, error) {
+	if freq != "DAILY" && freq != "WEEKLY" && freq != "MONTHLY" && freq != "YEARLY" {
+		return "", fmt.Errorf("FREQ=%s is not supported", freq)
+	}
+
+	if len(byHour) == 0 {
+		byHour = []int{start.Hour()}
+	}
+
+	if len(byMinute) == 0 {
+		byMinute = []int{start.Minute()}
+	}
+
+	if len(bySecond) == 0 {
+		bySecond = []int{start.Second()}
+	}
+
+	if len(byMonth) == 0 && freq == "YEARLY" {
+		byMonth = []int{int(start.Month())}
+	}
+
+	if len(byMonthDay) == 0 && len(byDay) == 0 && (freq == "MONTHLY" || freq == "YEARLY") {
+		byMonthDay = []int{start.Day()}
+	}
+
+	if len(byDay) == 0 && len(byMonthDay) == 0 && freq == "WEEKLY" {
+		byDay = []time.Weekday{start.Weekday()}
+	}
+
+	if len(byMonthDay) > 0 && len(byDay) > 0 {
+		// In a recurrence rule, both need to match, while in a cron
+		// expression, either of them needs to match.
+		return "", errors.New("BYMONTHDAY combined with BYDAY is not supported")
+	}
+
+	days := "*"
+	if len(byMonthDay) > 0 {
+		var values []string
+		for _, day := range byMonthDay {
+			switch {
+			case day == -1:
+				values = append(values, "L")
+
+			case day < 0:
+				return "", fmt.Errorf("BYMONTHDAY=%d is not supported", day)
+
+			default:
+				values = append(values, strconv.Itoa(day))
+			}
+		}
+
+		days = strings.Join(values, ",")
+	}
+
+	weekdays := "*"
+	if len(byDay) > 0 {
+		values := make([]int, 0, len(byDay))
+		for _, wd := range byDay {
+			values = append(values, int(wd))
+		}
+
+		weekdays = icsCronList(values)
+	}
+
+	months := "*"
+	if len(byMonth) > 0 {
+		months = icsCronList(byMonth)
+	}
+
+	fields := []string{icsCronList(byMinute), icsCronList(byHour), days, months, weekdays}
+	if len(bySecond) != 1 || bySecond[0] != 0 {
+		fields = append([]string{icsCronList(bySecond)}, fields...)
+	}
+
+	return strings.Join(fields, " "), nil
+}
+
+func icsCronList(values []int) string {
+	values = slices.Clone(values)
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	list := make([]string, 0, len(values))
+	for _, v := range values {
+		list = append(list, strconv.Itoa(v))
+	}
+
+	return strings.Join(list, ",")
+}
+
+// setUntil sets the end of the date range of m to the UNTIL value of the
+// recurrence rule.
+func (i *icsImporter) setUntil(m *Maintenance, value string) error {
+	until, err := i.time(icsProperty{value: value})
+	if err != nil {
+		return fmt.Errorf("invalid UNTIL: %w", err)
+	}
+
+	// UNTIL is inclusive, while windows need to start before the end of the
+	// date range.
+	end := until.Add(time.Second)
+	if until.allDay {
+		end = until.AddDate(0, 0, 1)
+	}
+
+	if len(m.DateRange) < 2 {
+		m.DateRange = []*time.Time{nil, nil}
+	}
+
+	m.DateRange[1] = &end
+
+	return nil
+}
+
+// time parses the DATE or DATE-TIME value of p.
+func (i *icsImporter) time(p icsProperty) (icsTime, error) {
+	value := p.value
+
+	if p.params["VALUE"] == "DATE" || len(value) == len(icsDateFormat) {
+		t, err := time.ParseInLocation(icsDateFormat, value, time.Local)
+		if err != nil {
+			return icsTime{}, fmt.Errorf("invalid date %q", value)
+		}
+
+		return icsTime{Time: t, timezoneOption: "SAME_AS_SERVER", allDay: true}, nil
+	}
+
+	if utc, ok := strings.CutSuffix(value, "Z"); ok {
+		t, err := time.ParseInLocation(icsDateTimeFormat, utc, time.UTC)
+		if err != nil {
+			return icsTime{}, fmt.Errorf("invalid date-time %q", value)
+		}
+
+		return icsTime{Time: t, timezoneOption: "UTC"}, nil
+	}
+
+	tzid, ok := p.params["TZID"]
+	if !ok {
+		// Floating time, which is interpreted in the local time zone.
+		t, err := time.ParseInLocation(icsDateTimeFormat, value, time.Local)
+		if err != nil {
+			return icsTime{}, fmt.Errorf("invalid date-time %q", value)
+		}
+
+		return icsTime{Time: t, timezoneOption: "SAME_AS_SERVER"}, nil
+	}
+
+	loc, err := time.LoadLocation(strings.TrimPrefix(tzid, "/"))
+	if err == nil && loc != time.Local {
+		t, err := time.ParseInLocation(icsDateTimeFormat, value, loc)
+		if err != nil {
+			return icsTime{}, fmt.Errorf("invalid date-time %q", value)
+		}
+
+		return icsTime{Time: t, timezoneOption: loc.String()}, nil
+	}
+
+	offset, ok := i.offsets[tzid]
+	if !ok {
+		return icsTime{}, fmt.Errorf("unknown time zone %q", tzid)
+	}
+
+	t, err := time.ParseInLocation(icsDateTimeFormat, value, time.FixedZone(tzid, offset))
+	if err != nil {
+		return icsTime{}, fmt.Errorf("invalid date-time %q", value)
+	}
+
+	i.warn("unknown time zone %q, converted to UTC with offset %s", tzid, icsUTCOffset(offset))
+
+	return icsTime{Time: t.UTC(), timezoneOption: "UTC"}, nil
+}
+
+// duration returns the duration of the event, based on DTEND or DURATION.
+func (i *icsImporter) duration(start icsTime) (time.Duration, error) {
+	if dtend, ok := i.event.property("DTEND"); ok {
+		end, err := i.time(dtend)
+		if err != nil {
+			return 0, fmt.Errorf("invalid DTEND: %w", err)
+		}
+
+		if !end.After(start.Time) {
+			return 0, errors.New("DTEND is not after DTSTART")
+		}
+
+		return end.Sub(start.Time), nil
+	}
+
+	if duration, ok := i.event.property("DURATION"); ok {
+		d, err := parseICSDuration(duration.value)
+		if err != nil {
+			return 0, err
+		}
+
+		if d <= 0 {
+			return 0, errors.New("DURATION is not positive")
+		}
+
+		return d, nil
+	}
+
+	if start.allDay {
+		return 24 * time.Hour, nil
+	}
+
+	return 0, errors.New("event has no duration")
+}
+
+// durationMinutes returns the duration in whole minutes, rounded up.
+func (i *icsImporter) durationMinutes(d time.Duration) int {
+	minutes := math.Ceil(d.Minutes())
+	if time.Duration(minutes)*time.Minute != d {
+		i.warn("duration %s rounded up to whole minutes", d)
+	}
+
+	return int(minutes)
+}
+
+// parseICSRRule parses the parts of a recurrence rule.
+func parseICSRRule(value string) map[string]string {
+	rule := map[string]string{}
+
+	for part := range strings.SplitSeq(value, ";") {
+		key, val, _ := strings.Cut(part, "=")
+		rule[strings.ToUpper(key)] = strings.ToUpper(val)
+	}
+
+	return rule
+}
+
+// parseICSByDay parses the BYDAY part of a recurrence rule. Weekdays with
+// an ordinal, e.g. "1MO", are not supported.
+func parseICSByDay(value string) ([]time.Weekday, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var weekdays []time.Weekday
+
+	for day := range strings.SplitSeq(value, ",") {
+		wd := slices.Index(icsWeekdays[:], day)
+		if wd < 0 {
+			return nil, fmt.Errorf("BYDAY=%s is not supported", day)
+		}
+
+		weekdays = append(weekdays, time.Weekday(wd))
+	}
+
+	return weekdays, nil
+}
+
+// parseICSIntList parses a comma separated list of integers in the range
+// [minimum, maximum], excluding 0 for negative minimums.
+func parseICSIntList(value string, minimum int, maximum int) ([]int, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var values []int
+
+	for v := range strings.SplitSeq(value, ",") {
+		n, err := strconv.Atoi(strings.TrimPrefix(v, "+"))
+		if err != nil || n < minimum || n > maximum || (minimum < 0 && n == 0) {
+			return nil, fmt.Errorf("invalid value %q", v)
+		}
+
+		values = append(values, n)
+	}
+
+	return values, nil
+}
+
+// parseICSDuration parses a duration value (RFC 5545, section 3.3.6).
+func parseICSDuration(value string) (time.Duration, error) {
+	match := icsDurationRegexp.FindStringSubmatch(value)
+	if match == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("invalid DURATION %q", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+	var d time.Duration
+
+	for n, unit := range units {
+		if match[n+2] == "" {
+			continue
+		}
+
+		v, err := strconv.Atoi(match[n+2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid DURATION %q", value)
+		}
+
+		d += time.Duration(v) * unit
+	}
+
+	if match[1] == "-" {
+		d = -d
+	}
+
+	return d, nil
+}
+
+func icsTimeOfDay(t time.Time) TimeOfDay {
+	return TimeOfDay{Hours: t.Hour(), Minutes: t.Minute(), Seconds: t.Second()}
+}
@@ -0,0 +1,469 @@
+package maintenance_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/internal/ptr"
+	"github.com/breml/go-uptime-kuma-client/maintenance"
+)
+
+func TestToICS(t *testing.T) {
+	date := func(year int, month time.Month, day int, hour int, minute int, loc *time.Location) *time.Time {
+		t := time.Date(year, month, day, hour, minute, 0, 0, loc)
+		return &t
+	}
+
+	withDateRange := func(m *maintenance.Maintenance, start *time.Time, end *time.Time) *maintenance.Maintenance {
+		m.DateRange = []*time.Time{start, end}
+		return m
+	}
+
+	withID := func(m *maintenance.Maintenance, id int64) *maintenance.Maintenance {
+		m.ID = id
+		return m
+	}
+
+	twoToFour := []maintenance.TimeOfDay{{Hours: 2}, {Hours: 4}}
+
+	tests := []struct {
+		name        string
+		maintenance *maintenance.Maintenance
+
+		wantLines    []string
+		wantWarnings []string
+	}{
+		{
+			name: "single",
+			maintenance: withID(maintenance.NewSingleMaintenance(
+				"Upgrade; database, cluster", "Line 1\nLine 2",
+				*date(2025, 3, 10, 8, 0, time.UTC), *date(2025, 3, 10, 10, 30, time.UTC),
+				"UTC",
+			), 42),
+
+			wantLines: []string{
+				"UID:maintenance-42@uptime-kuma",
+				"DTSTART:20250310T080000Z",
+				"DTEND:20250310T103000Z",
+				`SUMMARY:Upgrade\; database\, cluster`,
+				`DESCRIPTION:Line 1\nLine 2`,
+				"STATUS:CONFIRMED",
+				"X-UPTIME-KUMA-ID:42",
+				"X-UPTIME-KUMA-STRATEGY:single",
+			},
+		},
+		{
+			name: "recurring weekday with time zone and date range",
+			maintenance: withDateRange(maintenance.NewRecurringWeekdayMaintenance(
+				"Weekly", "", []int{3, 1}, twoToFour, "Europe/Berlin",
+			), date(2025, 3, 1, 0, 0, time.UTC), date(2025, 7, 1, 0, 0, time.UTC)),
+
+			wantLines: []string{
+				"DTSTART;TZID=Europe/Berlin:20250303T020000",
+				"DTEND;TZID=Europe/Berlin:20250303T040000",
+				"RRULE:FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20250630T235959Z",
+				"BEGIN:VTIMEZONE",
+				"TZID:Europe/Berlin",
+				"DTSTART:20250330T020000",
+				"TZOFFSETFROM:+0100",
+				"TZOFFSETTO:+0200",
+				"TZNAME:CEST",
+			},
+		},
+		{
+			name: "recurring day of month",
+			maintenance: withDateRange(maintenance.NewRecurringDayOfMonthMaintenance(
+				"Monthly", "", []any{15, "lastDay1", 1}, twoToFour, "UTC",
+			), date(2025, 3, 2, 0, 0, time.UTC), nil),
+
+			wantLines: []string{
+				"DTSTART:20250315T020000Z",
+				"DTEND:20250315T040000Z",
+				"RRULE:FREQ=MONTHLY;BYMONTHDAY=1,15,-1",
+			},
+		},
+		{
+			name: "recurring interval",
+			maintenance: withDateRange(maintenance.NewRecurringIntervalMaintenance(
+				"Interval", "", 3, []maintenance.TimeOfDay{{Hours: 23}, {Hours: 1}}, "UTC",
+			), date(2025, 3, 2, 0, 0, time.UTC), nil),
+
+			wantLines: []string{
+				"DTSTART:20250302T230000Z",
+				"DTEND:20250303T010000Z",
+				"RRULE:FREQ=DAILY;INTERVAL=3",
+			},
+		},
+		{
+			name: "cron as recurrence rule",
+			maintenance: withDateRange(maintenance.NewCronMaintenance(
+				"Cron", "", "30 2 * 1-3 MON-FRI", 90, "UTC",
+			), date(2025, 3, 1, 0, 0, time.UTC), nil),
+
+			wantLines: []string{
+				"DTSTART:20250303T023000Z",
+				"DTEND:20250303T040000Z",
+				"RRULE:FREQ=DAILY;BYMONTH=1,2,3;BYDAY=MO,TU,WE,TH,FR;BYHOUR=2;BYMINUTE=30",
+				"X-UPTIME-KUMA-STRATEGY:cron",
+				"X-UPTIME-KUMA-CRON:30 2 * 1-3 MON-FRI",
+			},
+		},
+		{
+			name: "cron expanded",
+			maintenance: withDateRange(maintenance.NewCronMaintenance(
+				"Cron", "", "0 3 1 * SUN", 60, "UTC",
+			), date(2100, 1, 1, 0, 0, time.UTC), nil),
+
+			wantLines: []string{
+				"DTSTART:21000101T030000Z",
+				"DTEND:21000101T040000Z",
+				"X-UPTIME-KUMA-CRON:0 3 1 * SUN",
+			},
+			wantWarnings: []string{
+				`"Cron": cron expression "0 3 1 * SUN" can not be represented as recurrence rule, expanded to the next 50 windows`,
+			},
+		},
+		{
+			name: "paused",
+			maintenance: func() *maintenance.Maintenance {
+				m := maintenance.NewSingleMaintenance(
+					"Paused", "", *date(2025, 3, 10, 8, 0, time.UTC), *date(2025, 3, 10, 10, 0, time.UTC), "UTC",
+				)
+				m.Active = false
+
+				return m
+			}(),
+
+			wantLines: []string{
+				"STATUS:TENTATIVE",
+			},
+		},
+		{
+			name:        "manual",
+			maintenance: maintenance.NewManualMaintenance("Manual", ""),
+
+			wantWarnings: []string{
+				`"Manual": manual maintenance has no schedule, skipped`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data, warnings, err := maintenance.ToICS([]maintenance.Maintenance{*tc.maintenance})
+			require.NoError(t, err)
+
+			for _, line := range strings.Split(string(data), "\r\n") {
+				require.LessOrEqual(t, len(line), 75)
+			}
+
+			lines := strings.Split(strings.ReplaceAll(string(data), "\r\n ", ""), "\r\n")
+			require.Equal(t, "BEGIN:VCALENDAR", lines[0])
+			require.Equal(t, "VERSION:2.0", lines[1])
+
+			for _, want := range tc.wantLines {
+				require.Contains(t, lines, want)
+			}
+
+			var gotWarnings []string
+			for _, w := range warnings {
+				gotWarnings = append(gotWarnings, w.String())
+			}
+
+			require.Equal(t, tc.wantWarnings, gotWarnings)
+		})
+	}
+}
+
+func TestFromICS(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	date := func(year int, month time.Month, day int, hour int, minute int, loc *time.Location) *time.Time {
+		t := time.Date(year, month, day, hour, minute, 0, 0, loc)
+		return &t
+	}
+
+	calendar := strings.ReplaceAll(`BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Example//Calendar//EN
+BEGIN:VTIMEZONE
+TZID:W. Europe Standard Time
+BEGIN:STANDARD
+DTSTART:16010101T030000
+TZOFFSETFROM:+0200
+TZOFFSETTO:+0100
+END:STANDARD
+BEGIN:DAYLIGHT
+DTSTART:16010101T020000
+TZOFFSETFROM:+0100
+TZOFFSETTO:+0200
+END:DAYLIGHT
+END:VTIMEZONE
+BEGIN:VEVENT
+UID:1
+SUMMARY:Database upgrade\, phase 1
+DESCRIPTION:Planned change\nCHG-1234
+DTSTART:20250310T080000Z
+DTEND:20250310T100000Z
+END:VEVENT
+BEGIN:VEVENT
+UID:2
+SUMMARY:Weekly patching
+DTSTART;TZID=Europe/Berlin:20250303T020000
+DURATION:PT2H
+RRULE:FREQ=WEEKLY;BYDAY=MO,SU;UNTIL=20250630T235959Z
+STATUS:TENTATIVE
+END:VEVENT
+BEGIN:VEVENT
+UID:3
+SUMMARY:Month end
+DTSTART:20250331T220000Z
+DTEND:20250331T235900Z
+RRULE:FREQ=MONTHLY;BYMONTHDAY=-1,15
+END:VEVENT
+BEGIN:VEVENT
+UID:4
+SUMMARY:Every other day
+DTSTART:20250301T230000Z
+DTEND:20250302T010000Z
+RRULE:FREQ=DAILY;INTERVAL=2;COUNT=3
+END:VEVENT
+BEGIN:VEVENT
+UID:5
+SUMMARY:Working days
+DTSTART;TZID=Europe/Berlin:20250303T061500
+DTEND;TZID=Europe/Berlin:20250303T063000
+RRULE:FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR
+EXDATE;TZID=Europe/Berlin:20250305T061500
+END:VEVENT
+BEGIN:VEVENT
+UID:6
+SUMMARY:Windows time zone
+DTSTART;TZID=W. Europe Standard Time:20250115T090000
+DTEND;TZID=W. Europe Standard Time:20250115T100000
+END:VEVENT
+BEGIN:VEVENT
+UID:7
+SUMMARY:Bi-weekly
+DTSTART:20250303T020000Z
+DTEND:20250303T040000Z
+RRULE:FREQ=WEEKLY;INTERVAL=2
+END:VEVENT
+BEGIN:VEVENT
+UID:8
+SUMMARY:Canceled
+DTSTART:20250303T020000Z
+DTEND:20250303T040000Z
+STATUS:CANCELLED
+END:VEVENT
+BEGIN:VEVENT
+UID:9
+SUMMARY:Multi day
+DTSTART;VALUE=DATE:20250301
+DTEND;VALUE=DATE:20250303
+RRULE:FREQ=YEARLY
+END:VEVENT
+END:VCALENDAR
+`, "\n", "\r\n")
+
+	maintenances, warnings, err := maintenance.FromICS(strings.NewReader(calendar))
+	require.NoError(t, err)
+
+	want := []maintenance.Maintenance{
+		{
+			Title:          "Database upgrade, phase 1",
+			Description:    "Planned change\nCHG-1234",
+			Strategy:       "single",
+			Active:         true,
+			DateRange:      []*time.Time{date(2025, 3, 10, 8, 0, time.UTC), date(2025, 3, 10, 10, 0, time.UTC)},
+			TimezoneOption: "UTC",
+		},
+		{
+			Title:          "Weekly patching",
+			Strategy:       "recurring-weekday",
+			Active:         false,
+			DateRange:      []*time.Time{date(2025, 3, 3, 2, 0, berlin), date(2025, 7, 1, 0, 0, time.UTC)},
+			TimeRange:      []maintenance.TimeOfDay{{Hours: 2}, {Hours: 4}},
+			Weekdays:       []int{1, 7},
+			TimezoneOption: "Europe/Berlin",
+		},
+		{
+			Title:          "Month end",
+			Strategy:       "recurring-day-of-month",
+			Active:         true,
+			DateRange:      []*time.Time{date(2025, 3, 31, 22, 0, time.UTC), nil},
+			TimeRange:      []maintenance.TimeOfDay{{Hours: 22}, {Hours: 23, Minutes: 59}},
+			DaysOfMonth:    []any{"lastDay1", 15},
+			TimezoneOption: "UTC",
+		},
+		{
+			Title:       "Every other day",
+			Strategy:    "recurring-interval",
+			Active:      true,
+			IntervalDay: 2,
+			// The end of the date range is right after the start of the last window.
+			DateRange:      []*time.Time{date(2025, 3, 1, 23, 0, time.UTC), ptr.To(date(2025, 3, 5, 23, 0, time.UTC).Add(time.Second))},
+			TimeRange:      []maintenance.TimeOfDay{{Hours: 23}, {Hours: 1}},
+			TimezoneOption: "UTC",
+		},
+		{
+			Title:           "Working days",
+			Strategy:        "cron",
+			Active:          true,
+			DateRange:       []*time.Time{date(2025, 3, 3, 6, 15, berlin), nil},
+			Cron:            "15 6 * * 1,2,3,4,5",
+			DurationMinutes: 15,
+			TimezoneOption:  "Europe/Berlin",
+		},
+		{
+			Title:          "Windows time zone",
+			Strategy:       "single",
+			Active:         true,
+			DateRange:      []*time.Time{date(2025, 1, 15, 8, 0, time.UTC), date(2025, 1, 15, 9, 0, time.UTC)},
+			TimezoneOption: "UTC",
+		},
+		{
+			Title:           "Multi day",
+			Strategy:        "cron",
+			Active:          true,
+			DateRange:       []*time.Time{date(2025, 3, 1, 0, 0, time.Local), nil},
+			Cron:            "0 0 1 3 *",
+			DurationMinutes: 2880,
+			TimezoneOption:  "SAME_AS_SERVER",
+		},
+	}
+
+	require.Len(t, maintenances, len(want))
+
+	for i := range want {
+		got := maintenances[i]
+		require.Equal(t, want[i].Title, got.Title)
+		require.Equal(t, want[i].Description, got.Description)
+		require.Equal(t, want[i].Strategy, got.Strategy, got.Title)
+		require.Equal(t, want[i].Active, got.Active, got.Title)
+		require.Equal(t, want[i].IntervalDay, got.IntervalDay, got.Title)
+		require.Equal(t, want[i].TimeRange, got.TimeRange, got.Title)
+		require.Equal(t, want[i].Weekdays, got.Weekdays, got.Title)
+		require.Equal(t, want[i].DaysOfMonth, got.DaysOfMonth, got.Title)
+		require.Equal(t, want[i].Cron, got.Cron, got.Title)
+		require.Equal(t, want[i].DurationMinutes, got.DurationMinutes, got.Title)
+		require.Equal(t, want[i].TimezoneOption, got.TimezoneOption, got.Title)
+
+		require.Len(t, got.DateRange, 2, got.Title)
+
+		for j := range want[i].DateRange {
+			if want[i].DateRange[j] == nil {
+				require.Nil(t, got.DateRange[j], got.Title)
+				continue
+			}
+
+			require.NotNil(t, got.DateRange[j], got.Title)
+			require.True(t, want[i].DateRange[j].Equal(*got.DateRange[j]),
+				"%s: want %s, got %s", got.Title, want[i].DateRange[j], got.DateRange[j])
+		}
+	}
+
+	var gotWarnings []string
+	for _, w := range warnings {
+		gotWarnings = append(gotWarnings, w.String())
+	}
+
+	require.Equal(t, []string{
+		`"Working days": excluded dates (EXDATE) are not supported and ignored`,
+		`"Windows time zone": unknown time zone "W. Europe Standard Time", converted to UTC with offset +0100`,
+		`"Bi-weekly": recurrence rule "FREQ=WEEKLY;INTERVAL=2": FREQ=WEEKLY with INTERVAL=2 is not supported, skipped`,
+		`"Canceled": event is canceled, skipped`,
+	}, gotWarnings)
+}
+
+func TestFromICS_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar string
+
+		wantErr string
+	}{
+		{
+			name:     "empty",
+			calendar: "",
+
+			wantErr: "from ics: no calendar found",
+		},
+		{
+			name:     "missing end",
+			calendar: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nEND:VEVENT\r\n",
+
+			wantErr: "from ics: missing END:VCALENDAR",
+		},
+		{
+			name:     "invalid content line",
+			calendar: "BEGIN:VCALENDAR\r\nINVALID\r\nEND:VCALENDAR\r\n",
+
+			wantErr: `from ics: line 2: invalid content line "INVALID"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := maintenance.FromICS(strings.NewReader(tc.calendar))
+			require.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestICS_RoundTrip(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	withStart := func(m *maintenance.Maintenance) *maintenance.Maintenance {
+		m.DateRange = []*time.Time{&start, nil}
+		return m
+	}
+
+	maintenances := []maintenance.Maintenance{
+		*withStart(maintenance.NewRecurringWeekdayMaintenance(
+			"Weekly", "Weekly maintenance", []int{1, 5}, []maintenance.TimeOfDay{{Hours: 22}, {Hours: 2}}, "America/New_York",
+		)),
+		*withStart(maintenance.NewRecurringDayOfMonthMaintenance(
+			"Monthly", "", []any{1, "lastDay2"}, []maintenance.TimeOfDay{{Hours: 1}, {Hours: 3}}, "UTC",
+		)),
+		*withStart(maintenance.NewCronMaintenance("Cron", "", "0 3 1 * SUN", 60, "Europe/Zurich")),
+	}
+
+	data, _, err := maintenance.ToICS(maintenances)
+	require.NoError(t, err)
+
+	got, warnings, err := maintenance.FromICS(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+	require.Len(t, got, len(maintenances))
+
+	for i, want := range maintenances {
+		require.Equal(t, want.Title, got[i].Title)
+		require.Equal(t, want.Description, got[i].Description)
+		require.Equal(t, want.Strategy, got[i].Strategy)
+		require.Equal(t, want.TimeRange, got[i].TimeRange)
+		require.Equal(t, want.Weekdays, got[i].Weekdays)
+		require.Equal(t, want.DaysOfMonth, got[i].DaysOfMonth)
+		require.Equal(t, want.Cron, got[i].Cron)
+		require.Equal(t, want.DurationMinutes, got[i].DurationMinutes)
+		require.Equal(t, want.TimezoneOption, got[i].TimezoneOption)
+
+		wantWindows, err := maintenance.NextWindows(&want, start, 10)
+		require.NoError(t, err)
+
+		gotWindows, err := maintenance.NextWindows(&got[i], start, 10)
+		require.NoError(t, err)
+
+		require.Len(t, gotWindows, len(wantWindows))
+
+		for j := range wantWindows {
+			require.True(t, wantWindows[j].StartDate.Equal(gotWindows[j].StartDate))
+			require.True(t, wantWindows[j].EndDate.Equal(gotWindows[j].EndDate))
+		}
+	}
+}
@@ -0,0 +1,415 @@
+package maintenance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxScheduleYears limits the period, which is searched for upcoming
+// maintenance windows. It ensures termination for schedules, which never or
+// only very rarely match, e.g. February 30th.
+const maxScheduleYears = 100
+
+// NextWindows computes up to n upcoming maintenance windows of m locally,
+// without contacting the server. A window is upcoming, if it ends after from,
+// so a window, which is in progress at from, is returned as well.
+//
+// All strategies are supported:
+//   - single: the window defined by DateRange.
+//   - recurring-interval: every IntervalDay days, starting at the first day
+//     of DateRange (or the day of from, if no start date is set), during
+//     TimeRange.
+//   - recurring-weekday: on every day in Weekdays during TimeRange.
+//   - recurring-day-of-month: on every day in DaysOfMonth during TimeRange.
+//     "lastDay1" is the last day of the month, "lastDay2" the day before, up
+//     to "lastDay4".
+//   - cron: at every time matching Cron for DurationMinutes.
+//   - manual: manual maintenance windows have no schedule, no windows are
+//     returned.
+//
+// For the recurring strategies and cron, only windows starting within the
+// optional DateRange are returned. If the end of TimeRange is not after its
+// start, the window ends on the next day.
+//
+// The times are computed and returned in the time zone defined by
+// TimezoneOption. For "SAME_AS_SERVER", the time zone resolved by the server
+// (Timezone) is used, if present, and the local time zone otherwise.
+// The Active flag is not taken into account, such that the schedule of a
+// paused maintenance can be previewed as well.
+func NextWindows(m *Maintenance, from time.Time, n int) ([]Timeslot, error) {
+	if m == nil {
+		return nil, errors.New("next windows: maintenance is nil")
+	}
+
+	if n <= 0 {
+		return nil, nil
+	}
+
+	loc, err := Location(m)
+	if err != nil {
+		return nil, fmt.Errorf("next windows: %w", err)
+	}
+
+	var windows []Timeslot
+
+	switch m.Strategy {
+	case "single":
+		windows, err = singleWindow(m, from, loc)
+
+	case "recurring-interval", "recurring-weekday", "recurring-day-of-month":
+		windows, err = recurringWindows(m, from, n, loc)
+
+	case "cron":
+		windows, err = cronWindows(m, from, n, loc)
+
+	case "manual":
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("next windows: unsupported strategy %q", m.Strategy)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("next windows: %s: %w", m.Strategy, err)
+	}
+
+	return windows, nil
+}
+
+// Location returns the time zone of the maintenance window as defined by
+// TimezoneOption. For "SAME_AS_SERVER" or an empty option, the time zone
+// resolved by the server (Timezone) is used, if present, and the local time
+// zone otherwise.
+func Location(m *Maintenance) (*time.Location, error) {
+	name := m.TimezoneOption
+	if name == "" || name == "SAME_AS_SERVER" {
+		name = m.Timezone
+	}
+
+	switch name {
+	case "", "SAME_AS_SERVER":
+		return time.Local, nil
+
+	case "UTC":
+		return time.UTC, nil
+
+	default:
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", name, err)
+		}
+
+		return loc, nil
+	}
+}
+
+func singleWindow(m *Maintenance, from time.Time, loc *time.Location) ([]Timeslot, error) {
+	start, end := dateRange(m)
+	if start == nil || end == nil {
+		return nil, errors.New("start and end date are required")
+	}
+
+	if !end.After(*start) {
+		return nil, errors.New("end date must be after start date")
+	}
+
+	if !end.After(from) {
+		return nil, nil
+	}
+
+	return []Timeslot{{StartDate: start.In(loc), EndDate: end.In(loc)}}, nil
+}
+
+func recurringWindows(m *Maintenance, from time.Time, n int, loc *time.Location) ([]Timeslot, error) {
+	if len(m.TimeRange) < 2 {
+		return nil, errors.New("time range with start and end time is required")
+	}
+
+	startTime, endTime := m.TimeRange[0], m.TimeRange[1]
+
+	var matches func(date time.Time) bool
+
+	switch m.Strategy {
+	case "recurring-interval":
+		if m.IntervalDay < 1 {
+			return nil, fmt.Errorf("interval day must be at least 1, got %d", m.IntervalDay)
+		}
+
+		anchor := civilDate(from.In(loc))
+		if start, _ := dateRange(m); start != nil {
+			anchor = civilDate(start.In(loc))
+		}
+
+		matches = func(date time.Time) bool {
+			days := int(date.Sub(anchor).Hours() / 24)
+			return days >= 0 && days%m.IntervalDay == 0
+		}
+
+	case "recurring-weekday":
+		if len(m.Weekdays) == 0 {
+			return nil, errors.New("at least one weekday is required")
+		}
+
+		weekdays := make([]bool, 7)
+		for _, wd := range m.Weekdays {
+			if wd < 0 || wd > 7 {
+				return nil, fmt.Errorf("invalid weekday %d", wd)
+			}
+
+			// 1=Monday, ..., 7=Sunday; 0 is accepted for Sunday as well.
+			weekdays[wd%7] = true
+		}
+
+		matches = func(date time.Time) bool {
+			return weekdays[int(date.Weekday())]
+		}
+
+	case "recurring-day-of-month":
+		days, lastDays, err := parseDaysOfMonth(m.DaysOfMonth)
+		if err != nil {
+			return nil, err
+		}
+
+		matches = func(date time.Time) bool {
+			if days[date.Day()] {
+				return true
+			}
+
+			for _, nth := range lastDays {
+				if isLastDayOfMonth(date, nth) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported strategy %q", m.Strategy)
+	}
+
+	overnight := todDuration(endTime) <= todDuration(startTime)
+
+	return collectWindows(m, from, n, loc, matches, func(date time.Time) []Timeslot {
+		endDate := date
+		if overnight {
+			endDate = date.AddDate(0, 0, 1)
+		}
+
+		start := wallClock(date, startTime, loc)
+		end := wallClock(endDate, endTime, loc)
+
+		return []Timeslot{{StartDate: start, EndDate: end}}
+	})
+}
+
+func cronWindows(m *Maintenance, from time.Time, n int, loc *time.Location) ([]Timeslot, error) {
+	if m.DurationMinutes <= 0 {
+		return nil, fmt.Errorf("duration minutes must be positive, got %d", m.DurationMinutes)
+	}
+
+	schedule, err := parseCron(m.Cron)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := time.Duration(m.DurationMinutes) * time.Minute
+
+	return collectWindows(m, from, n, loc, schedule.matchesDay, func(date time.Time) []Timeslot {
+		starts := schedule.timesOfDay(date, loc)
+
+		windows := make([]Timeslot, 0, len(starts))
+		for _, start := range starts {
+			windows = append(windows, Timeslot{StartDate: start, EndDate: start.Add(duration)})
+		}
+
+		return windows
+	})
+}
+
+// collectWindows iterates over the days starting shortly before from and
+// collects up to n windows, which end after from and start within the
+// optional date range of m. For every day, for which matches returns true,
+// windowsOfDay returns the windows starting on this day in ascending order.
+func collectWindows(
+	m *Maintenance,
+	from time.Time,
+	n int,
+	loc *time.Location,
+	matches func(date time.Time) bool,
+	windowsOfDay func(date time.Time) []Timeslot,
+) ([]Timeslot, error) {
+	rangeStart, rangeEnd := dateRange(m)
+
+	// Windows starting on the previous days might still be in progress.
+	// The lookback needs to cover the longest possible window.
+	lookback := 2
+	if m.Strategy == "cron" {
+		lookback += m.DurationMinutes / (24 * 60)
+	}
+
+	date := civilDate(from.In(loc)).AddDate(0, 0, -lookback)
+	if rangeStart != nil {
+		date = maxDate(date, civilDate(rangeStart.In(loc)))
+	}
+
+	limit := date.AddDate(maxScheduleYears, 0, 0)
+
+	var windows []Timeslot
+
+	for ; date.Before(limit) && len(windows) < n; date = date.AddDate(0, 0, 1) {
+		if !matches(date) {
+			continue
+		}
+
+		for _, window := range windowsOfDay(date) {
+			if rangeStart != nil && window.StartDate.Before(*rangeStart) {
+				continue
+			}
+
+			if rangeEnd != nil && !window.StartDate.Before(*rangeEnd) {
+				return windows, nil
+			}
+
+			if !window.EndDate.After(from) {
+				continue
+			}
+
+			windows = append(windows, window)
+			if len(windows) == n {
+				break
+			}
+		}
+	}
+
+	return windows, nil
+}
+
+// parseDaysOfMonth parses the DaysOfMonth of a recurring-day-of-month
+// maintenance. It returns the set of days of month as well as the list of
+// n-th last days of the month ("lastDay1" to "lastDay4").
+func parseDaysOfMonth(daysOfMonth []any) ([]bool, []int, error) {
+	if len(daysOfMonth) == 0 {
+		return nil, nil, errors.New("at least one day of month is required")
+	}
+
+	days := make([]bool, 32)
+
+	var lastDays []int
+
+	for _, d := range daysOfMonth {
+		var day int
+
+		switch v := d.(type) {
+		case int:
+			day = v
+
+		case int64:
+			day = int(v)
+
+		case float64:
+			day = int(v)
+
+		case json.Number:
+			n, err := v.Int64()
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid day of month %q", v)
+			}
+
+			day = int(n)
+
+		case string:
+			if nthExpr, ok := strings.CutPrefix(v, "lastDay"); ok {
+				nth, err := strconv.Atoi(nthExpr)
+				if err != nil || nth < 1 || nth > 4 {
+					return nil, nil, fmt.Errorf("invalid day of month %q", v)
+				}
+
+				if !slices.Contains(lastDays, nth) {
+					lastDays = append(lastDays, nth)
+				}
+
+				continue
+			}
+
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid day of month %q", v)
+			}
+
+			day = n
+
+		default:
+			return nil, nil, fmt.Errorf("invalid day of month %v (%T)", d, d)
+		}
+
+		if day < 1 || day > 31 {
+			return nil, nil, fmt.Errorf("day of month %d out of range [1, 31]", day)
+		}
+
+		days[day] = true
+	}
+
+	return days, lastDays, nil
+}
+
+// isLastDayOfMonth reports whether date is the nth last day of its month,
+// where nth=1 is the last day of the month.
+func isLastDayOfMonth(date time.Time, nth int) bool {
+	lastDay := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	return date.Day() == lastDay-nth+1
+}
+
+// dateRange returns the optional start and end date of m.
+func dateRange(m *Maintenance) (*time.Time, *time.Time) {
+	var start, end *time.Time
+
+	if len(m.DateRange) > 0 {
+		start = m.DateRange[0]
+	}
+
+	if len(m.DateRange) > 1 {
+		end = m.DateRange[1]
+	}
+
+	return start, end
+}
+
+// civilDate returns the calendar date of t as midnight UTC. Calculations
+// on civil dates are not affected by daylight saving time transitions.
+func civilDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func maxDate(a time.Time, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
+
+// wallClock returns the time at the given time of day on the civil date in
+// loc. Times, which do not exist because of a daylight saving time
+// transition, are moved forward by the length of the gap, e.g. 02:30 becomes
+// 03:30 on a day, where the clocks jump from 02:00 to 03:00.
+func wallClock(date time.Time, tod TimeOfDay, loc *time.Location) time.Time {
+	t := time.Date(date.Year(), date.Month(), date.Day(), tod.Hours, tod.Minutes, tod.Seconds, 0, loc)
+
+	// Compare the requested with the resulting wall clock time.
+	want := time.Date(date.Year(), date.Month(), date.Day(), tod.Hours, tod.Minutes, tod.Seconds, 0, time.UTC)
+	got := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+
+	return t.Add(want.Sub(got))
+}
+
+// todDuration returns the time of day as duration since midnight.
+func todDuration(t TimeOfDay) time.Duration {
+	return time.Duration(t.Hours)*time.Hour + time.Duration(t.Minutes)*time.Minute + time.Duration(t.Seconds)*time.Second
+}
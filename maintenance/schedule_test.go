@@ -0,0 +1,249 @@
+package maintenance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/maintenance"
+)
+
+func TestNextWindows(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	date := func(year int, month time.Month, day int, hour int, minute int, loc *time.Location) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, loc)
+	}
+
+	datePtr := func(year int, month time.Month, day int, hour int, minute int, loc *time.Location) *time.Time {
+		t := date(year, month, day, hour, minute, loc)
+		return &t
+	}
+
+	twoToFour := []maintenance.TimeOfDay{{Hours: 2}, {Hours: 4}}
+
+	tests := []struct {
+		name        string
+		maintenance *maintenance.Maintenance
+		from        time.Time
+		n           int
+
+		want    []maintenance.Timeslot
+		wantErr string
+	}{
+		{
+			name: "single upcoming",
+			maintenance: maintenance.NewSingleMaintenance(
+				"single", "",
+				date(2025, 3, 10, 8, 0, time.UTC), date(2025, 3, 10, 10, 0, time.UTC),
+				"Europe/Berlin",
+			),
+			from: date(2025, 3, 1, 0, 0, time.UTC),
+			n:    3,
+
+			want: []maintenance.Timeslot{
+				{StartDate: date(2025, 3, 10, 9, 0, berlin), EndDate: date(2025, 3, 10, 11, 0, berlin)},
+			},
+		},
+		{
+			name: "single in progress",
+			maintenance: maintenance.NewSingleMaintenance(
+				"single", "",
+				date(2025, 3, 10, 8, 0, time.UTC), date(2025, 3, 10, 10, 0, time.UTC),
+				"UTC",
+			),
+			from: date(2025, 3, 10, 9, 0, time.UTC),
+			n:    1,
+
+			want: []maintenance.Timeslot{
+				{StartDate: date(2025, 3, 10, 8, 0, time.UTC), EndDate: date(2025, 3, 10, 10, 0, time.UTC)},
+			},
+		},
+		{
+			name: "single ended",
+			maintenance: maintenance.NewSingleMaintenance(
+				"single", "",
+				date(2025, 3, 10, 8, 0, time.UTC), date(2025, 3, 10, 10, 0, time.UTC),
+				"UTC",
+			),
+			from: date(2025, 3, 11, 0, 0, time.UTC),
+			n:    1,
+		},
+		{
+			name: "recurring interval anchored at start date",
+			maintenance: func() *maintenance.Maintenance {
+				m := maintenance.NewRecurringIntervalMaintenance("interval", "", 3, twoToFour, "UTC")
+				m.DateRange = []*time.Time{datePtr(2025, 1, 1, 0, 0, time.UTC), nil}
+				return m
+			}(),
+			from: date(2025, 1, 5, 0, 0, time.UTC),
+			n:    3,
+
+			want: []maintenance.Timeslot{
+				{StartDate: date(2025, 1, 7, 2, 0, time.UTC), EndDate: date(2025, 1, 7, 4, 0, time.UTC)},
+				{StartDate: date(2025, 1, 10, 2, 0, time.UTC), EndDate: date(2025, 1, 10, 4, 0, time.UTC)},
+				{StartDate: date(2025, 1, 13, 2, 0, time.UTC), EndDate: date(2025, 1, 13, 4, 0, time.UTC)},
+			},
+		},
+		{
+			name: "recurring weekday across daylight saving time change",
+			maintenance: maintenance.NewRecurringWeekdayMaintenance(
+				"weekday", "", []int{1, 7}, twoToFour, "America/New_York",
+			),
+			from: date(2025, 3, 5, 0, 0, time.UTC),
+			n:    4,
+
+			// DST starts on Sunday, March 9th 2025 at 2 AM, 2 AM does not exist on this day.
+			want: []maintenance.Timeslot{
+				{StartDate: date(2025, 3, 9, 3, 0, newYork), EndDate: date(2025, 3, 9, 4, 0, newYork)},
+				{StartDate: date(2025, 3, 10, 2, 0, newYork), EndDate: date(2025, 3, 10, 4, 0, newYork)},
+				{StartDate: date(2025, 3, 16, 2, 0, newYork), EndDate: date(2025, 3, 16, 4, 0, newYork)},
+				{StartDate: date(2025, 3, 17, 2, 0, newYork), EndDate: date(2025, 3, 17, 4, 0, newYork)},
+			},
+		},
+		{
+			name: "recurring weekday overnight within date range",
+			maintenance: func() *maintenance.Maintenance {
+				m := maintenance.NewRecurringWeekdayMaintenance(
+					"weekday", "", []int{5}, []maintenance.TimeOfDay{{Hours: 22}, {Hours: 1, Minutes: 30}}, "UTC",
+				)
+				m.DateRange = []*time.Time{nil, datePtr(2025, 6, 14, 0, 0, time.UTC)}
+				return m
+			}(),
+			from: date(2025, 6, 1, 0, 0, time.UTC),
+			n:    5,
+
+			want: []maintenance.Timeslot{
+				{StartDate: date(2025, 6, 6, 22, 0, time.UTC), EndDate: date(2025, 6, 7, 1, 30, time.UTC)},
+				{StartDate: date(2025, 6, 13, 22, 0, time.UTC), EndDate: date(2025, 6, 14, 1, 30, time.UTC)},
+			},
+		},
+		{
+			name: "recurring day of month with last days",
+			maintenance: maintenance.NewRecurringDayOfMonthMaintenance(
+				"day of month", "", []any{float64(15), "lastDay1", "lastDay2"}, twoToFour, "UTC",
+			),
+			from: date(2024, 2, 1, 0, 0, time.UTC),
+			n:    4,
+
+			want: []maintenance.Timeslot{
+				{StartDate: date(2024, 2, 15, 2, 0, time.UTC), EndDate: date(2024, 2, 15, 4, 0, time.UTC)},
+				{StartDate: date(2024, 2, 28, 2, 0, time.UTC), EndDate: date(2024, 2, 28, 4, 0, time.UTC)},
+				{StartDate: date(2024, 2, 29, 2, 0, time.UTC), EndDate: date(2024, 2, 29, 4, 0, time.UTC)},
+				{StartDate: date(2024, 3, 15, 2, 0, time.UTC), EndDate: date(2024, 3, 15, 4, 0, time.UTC)},
+			},
+		},
+		{
+			name: "recurring day of month skips short months",
+			maintenance: maintenance.NewRecurringDayOfMonthMaintenance(
+				"day of month", "", []any{31}, twoToFour, "UTC",
+			),
+			from: date(2025, 4, 1, 0, 0, time.UTC),
+			n:    2,
+
+			want: []maintenance.Timeslot{
+				{StartDate: date(2025, 5, 31, 2, 0, time.UTC), EndDate: date(2025, 5, 31, 4, 0, time.UTC)},
+				{StartDate: date(2025, 7, 31, 2, 0, time.UTC), EndDate: date(2025, 7, 31, 4, 0, time.UTC)},
+			},
+		},
+		{
+			name:        "cron with duration",
+			maintenance: maintenance.NewCronMaintenance("cron", "", "30 23 * * MON-FRI", 90, "Europe/Berlin"),
+			from:        date(2025, 1, 3, 23, 0, berlin),
+			n:           3,
+
+			want: []maintenance.Timeslot{
+				{StartDate: date(2025, 1, 3, 23, 30, berlin), EndDate: date(2025, 1, 4, 1, 0, berlin)},
+				{StartDate: date(2025, 1, 6, 23, 30, berlin), EndDate: date(2025, 1, 7, 1, 0, berlin)},
+				{StartDate: date(2025, 1, 7, 23, 30, berlin), EndDate: date(2025, 1, 8, 1, 0, berlin)},
+			},
+		},
+		{
+			name:        "cron in progress from previous day",
+			maintenance: maintenance.NewCronMaintenance("cron", "", "0 22 * * *", 240, "UTC"),
+			from:        date(2025, 1, 2, 1, 0, time.UTC),
+			n:           2,
+
+			want: []maintenance.Timeslot{
+				{StartDate: date(2025, 1, 1, 22, 0, time.UTC), EndDate: date(2025, 1, 2, 2, 0, time.UTC)},
+				{StartDate: date(2025, 1, 2, 22, 0, time.UTC), EndDate: date(2025, 1, 3, 2, 0, time.UTC)},
+			},
+		},
+		{
+			name:        "cron last day of month and day of week",
+			maintenance: maintenance.NewCronMaintenance("cron", "", "0 12 L * SUN", 60, "UTC"),
+			from:        date(2025, 5, 26, 0, 0, time.UTC),
+			n:           3,
+
+			want: []maintenance.Timeslot{
+				{StartDate: date(2025, 5, 31, 12, 0, time.UTC), EndDate: date(2025, 5, 31, 13, 0, time.UTC)},
+				{StartDate: date(2025, 6, 1, 12, 0, time.UTC), EndDate: date(2025, 6, 1, 13, 0, time.UTC)},
+				{StartDate: date(2025, 6, 8, 12, 0, time.UTC), EndDate: date(2025, 6, 8, 13, 0, time.UTC)},
+			},
+		},
+		{
+			name:        "manual",
+			maintenance: maintenance.NewManualMaintenance("manual", ""),
+			from:        date(2025, 1, 1, 0, 0, time.UTC),
+			n:           3,
+		},
+		{
+			name:        "invalid timezone",
+			maintenance: maintenance.NewCronMaintenance("cron", "", "0 2 * * *", 60, "Mars/Olympus"),
+			from:        date(2025, 1, 1, 0, 0, time.UTC),
+			n:           1,
+
+			wantErr: `next windows: invalid timezone "Mars/Olympus": unknown time zone Mars/Olympus`,
+		},
+		{
+			name:        "invalid cron",
+			maintenance: maintenance.NewCronMaintenance("cron", "", "0 25 * * *", 60, "UTC"),
+			from:        date(2025, 1, 1, 0, 0, time.UTC),
+			n:           1,
+
+			wantErr: `next windows: cron: invalid cron expression "0 25 * * *": hours: value 25 out of range [0, 23]`,
+		},
+		{
+			name:        "cron without duration",
+			maintenance: maintenance.NewCronMaintenance("cron", "", "0 2 * * *", 0, "UTC"),
+			from:        date(2025, 1, 1, 0, 0, time.UTC),
+			n:           1,
+
+			wantErr: "next windows: cron: duration minutes must be positive, got 0",
+		},
+		{
+			name: "invalid last day",
+			maintenance: maintenance.NewRecurringDayOfMonthMaintenance(
+				"day of month", "", []any{"lastDay5"}, twoToFour, "UTC",
+			),
+			from: date(2025, 1, 1, 0, 0, time.UTC),
+			n:    1,
+
+			wantErr: `next windows: recurring-day-of-month: invalid day of month "lastDay5"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := maintenance.NextWindows(tc.maintenance, tc.from, tc.n)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, got, len(tc.want))
+
+			for i := range tc.want {
+				require.True(t, tc.want[i].StartDate.Equal(got[i].StartDate), "start %d: want %s, got %s", i, tc.want[i].StartDate, got[i].StartDate)
+				require.True(t, tc.want[i].EndDate.Equal(got[i].EndDate), "end %d: want %s, got %s", i, tc.want[i].EndDate, got[i].EndDate)
+				require.Equal(t, tc.want[i].StartDate.Location().String(), got[i].StartDate.Location().String())
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package kuma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/breml/go-uptime-kuma-client/maintenance"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+// ScopedMaintenanceMarker is contained in the description of every maintenance
+// window created by WithMaintenance. It allows to find windows, which have
+// been left over, e.g. because the process has been killed, with
+// GetScopedMaintenances.
+const ScopedMaintenanceMarker = "[go-uptime-kuma-client:scoped-maintenance]"
+
+// scopedMaintenanceCleanupTimeout limits the time for ending and deleting a
+// scoped maintenance window. The cleanup is not bound to the context passed
+// to WithMaintenance, such that it is performed even if the context is
+// canceled.
+const scopedMaintenanceCleanupTimeout = 30 * time.Second
+
+// MonitorSelector reports whether a monitor is selected. Selectors can be
+// parsed from expressions with monitor.ParseSelector.
+type MonitorSelector = monitor.Selector
+
+// SelectMonitorIDs returns a MonitorSelector, which selects the monitors with
+// the given IDs.
+func SelectMonitorIDs(ids ...int64) MonitorSelector {
+	selected := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		selected[id] = empty
+	}
+
+	return func(mon monitor.Base) bool {
+		_, ok := selected[mon.ID]
+		return ok
+	}
+}
+
+// ScopedMaintenanceOption is a functional option for WithMaintenance.
+type ScopedMaintenanceOption func(s *scopedMaintenance)
+
+type scopedMaintenance struct {
+	description   string
+	statusPageIDs []int64
+}
+
+// WithMaintenanceStatusPages additionally shows the scoped maintenance window
+// on the status pages with the given IDs.
+func WithMaintenanceStatusPages(statusPageIDs ...int64) ScopedMaintenanceOption {
+	return func(s *scopedMaintenance) {
+		s.statusPageIDs = append(s.statusPageIDs, statusPageIDs...)
+	}
+}
+
+// WithMaintenanceDescription sets the description of the scoped maintenance
+// window. The ScopedMaintenanceMarker is appended to the description.
+func WithMaintenanceDescription(description string) ScopedMaintenanceOption {
+	return func(s *scopedMaintenance) {
+		s.description = description
+	}
+}
+
+// WithMaintenance puts the monitors matched by selector under maintenance
+// while fn is running, e.g. during a deployment.
+//
+// A manual maintenance window with the given title is created and the
+// selected monitors (and optionally status pages, see
+// WithMaintenanceStatusPages) are attached to it. After fn returns, the maintenance window is always ended
+// and deleted, also if fn fails, panics or ctx is canceled. A panic of fn is
+// propagated after the cleanup.
+//
+// The description of the window contains ScopedMaintenanceMarker, such that
+// windows, which could not be cleaned up, can be found with
+// GetScopedMaintenances.
+//
+// The error returned by fn is returned unchanged, joined with the error of
+// the cleanup, if any.
+func (c *Client) WithMaintenance(
+	ctx context.Context,
+	title string,
+	selector MonitorSelector,
+	fn func(ctx context.Context) error,
+	opts ...ScopedMaintenanceOption,
+) (err error) {
+	if selector == nil {
+		return errors.New("with maintenance: selector is nil")
+	}
+
+	scope := scopedMaintenance{}
+	for _, opt := range opts {
+		opt(&scope)
+	}
+
+	monitors, err := c.GetMonitors(ctx)
+	if err != nil {
+		return fmt.Errorf("with maintenance: %w", err)
+	}
+
+	monitorIDs := make([]int64, 0, len(monitors))
+	for _, mon := range monitors {
+		if selector(mon) {
+			monitorIDs = append(monitorIDs, mon.ID)
+		}
+	}
+
+	if len(monitorIDs) == 0 {
+		return fmt.Errorf("with maintenance: no monitors match the selector: %w", ErrNotFound)
+	}
+
+	m := maintenance.NewManualMaintenance(title, scopedMaintenanceDescription(scope.description, time.Now()))
+
+	created, err := c.CreateMaintenance(ctx, m)
+	if err != nil {
+		return fmt.Errorf("with maintenance: %w", err)
+	}
+
+	defer func() {
+		cleanupErr := c.endScopedMaintenance(ctx, created.ID)
+		if cleanupErr != nil {
+			err = errors.Join(err, fmt.Errorf("with maintenance: %w", cleanupErr))
+		}
+	}()
+
+	err = c.SetMonitorMaintenance(ctx, created.ID, monitorIDs)
+	if err != nil {
+		return fmt.Errorf("with maintenance: %w", err)
+	}
+
+	if len(scope.statusPageIDs) > 0 {
+		err = c.SetMaintenanceStatusPage(ctx, created.ID, scope.statusPageIDs)
+		if err != nil {
+			return fmt.Errorf("with maintenance: %w", err)
+		}
+	}
+
+	return fn(ctx)
+}
+
+// GetScopedMaintenances retrieves all maintenance windows from the client
+// cache, which have been created by WithMaintenance and still exist. These are
+// windows, which are either still in use or which have been left over.
+// Left over windows can be removed with DeleteMaintenance.
+func (c *Client) GetScopedMaintenances(ctx context.Context) ([]maintenance.Maintenance, error) {
+	maintenances, err := c.GetMaintenances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get scoped maintenances: %w", err)
+	}
+
+	scoped := make([]maintenance.Maintenance, 0, len(maintenances))
+	for _, m := range maintenances {
+		if strings.Contains(m.Description, ScopedMaintenanceMarker) {
+			scoped = append(scoped, m)
+		}
+	}
+
+	return scoped, nil
+}
+
+// endScopedMaintenance ends and deletes the maintenance window. The cleanup
+// is performed, even if ctx is already canceled.
+func (c *Client) endScopedMaintenance(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), scopedMaintenanceCleanupTimeout)
+	defer cancel()
+
+	// Ending the window first ensures the monitors are no longer under
+	// maintenance, even if the deletion fails.
+	pauseErr := c.PauseMaintenance(ctx, id)
+
+	err := c.DeleteMaintenance(ctx, id)
+	if err != nil {
+		return errors.Join(pauseErr, fmt.Errorf("end maintenance %d: %w", id, err))
+	}
+
+	return nil
+}
+
+func scopedMaintenanceDescription(description string, started time.Time) string {
+	marker := fmt.Sprintf("%s started=%s", ScopedMaintenanceMarker, started.UTC().Format(time.RFC3339))
+	if description == "" {
+		return marker
+	}
+
+	return description + "\n\n" + marker
+}
@@ -2,11 +2,13 @@ package kuma_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	kuma "github.com/breml/go-uptime-kuma-client"
 	"github.com/breml/go-uptime-kuma-client/maintenance"
 	"github.com/breml/go-uptime-kuma-client/monitor"
 )
@@ -526,3 +528,111 @@ func TestClient_MaintenanceEdgeCases(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestClient_WithMaintenance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 60*time.Second)
+	defer cancel()
+
+	httpMonitor := monitor.HTTP{
+		Base: monitor.Base{
+			Name:          "Scoped Maintenance Test Monitor",
+			Interval:      60,
+			RetryInterval: 60,
+			MaxRetries:    3,
+			IsActive:      true,
+		},
+		HTTPDetails: monitor.HTTPDetails{
+			URL:                 "https://httpbin.org/status/200",
+			Timeout:             48,
+			Method:              "GET",
+			MaxRedirects:        10,
+			AcceptedStatusCodes: []string{"200-299"},
+			AuthMethod:          monitor.AuthMethodNone,
+		},
+	}
+
+	monitorID, err := client.CreateMonitor(ctx, &httpMonitor)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.DeleteMonitor(ctx, monitorID)
+	}()
+
+	t.Run("cleanup_after_success", func(t *testing.T) {
+		var maintenanceID int64
+
+		err := client.WithMaintenance(ctx, "Deployment", kuma.SelectMonitorIDs(monitorID), func(ctx context.Context) error {
+			scoped, err := client.GetScopedMaintenances(ctx)
+			require.NoError(t, err)
+			require.Len(t, scoped, 1)
+			require.Equal(t, "Deployment", scoped[0].Title)
+			require.Equal(t, "manual", scoped[0].Strategy)
+			require.Contains(t, scoped[0].Description, kuma.ScopedMaintenanceMarker)
+
+			maintenanceID = scoped[0].ID
+
+			monitorIDs, err := client.GetMonitorMaintenance(ctx, maintenanceID)
+			require.NoError(t, err)
+			require.Equal(t, []int64{monitorID}, monitorIDs)
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Positive(t, maintenanceID)
+
+		scoped, err := client.GetScopedMaintenances(ctx)
+		require.NoError(t, err)
+		require.Empty(t, scoped)
+	})
+
+	t.Run("cleanup_after_error", func(t *testing.T) {
+		errDeploy := errors.New("deployment failed")
+
+		err := client.WithMaintenance(ctx, "Deployment", kuma.SelectMonitorIDs(monitorID), func(context.Context) error {
+			return errDeploy
+		})
+		require.ErrorIs(t, err, errDeploy)
+
+		scoped, err := client.GetScopedMaintenances(ctx)
+		require.NoError(t, err)
+		require.Empty(t, scoped)
+	})
+
+	t.Run("cleanup_after_panic", func(t *testing.T) {
+		require.Panics(t, func() {
+			_ = client.WithMaintenance(ctx, "Deployment", kuma.SelectMonitorIDs(monitorID), func(context.Context) error {
+				panic("deployment panicked")
+			})
+		})
+
+		scoped, err := client.GetScopedMaintenances(ctx)
+		require.NoError(t, err)
+		require.Empty(t, scoped)
+	})
+
+	t.Run("cleanup_after_cancel", func(t *testing.T) {
+		cancelCtx, cancelFn := context.WithCancel(ctx)
+
+		err := client.WithMaintenance(cancelCtx, "Deployment", kuma.SelectMonitorIDs(monitorID), func(ctx context.Context) error {
+			cancelFn()
+
+			return ctx.Err()
+		})
+		require.ErrorIs(t, err, context.Canceled)
+
+		scoped, err := client.GetScopedMaintenances(ctx)
+		require.NoError(t, err)
+		require.Empty(t, scoped)
+	})
+
+	t.Run("no_matching_monitors", func(t *testing.T) {
+		err := client.WithMaintenance(ctx, "Deployment", kuma.SelectMonitorIDs(-1), func(context.Context) error {
+			return nil
+		})
+		require.ErrorIs(t, err, kuma.ErrNotFound)
+	})
+}
@@ -0,0 +1,219 @@
+package manifest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/notification"
+)
+
+// decodeSpec checks the properties of the spec against the schema of the
+// kind and decodes the spec into target.
+func decodeSpec(kind Kind, spec map[string]any, target any) error {
+	err := checkProperties(specSchema(kind, ""), spec)
+	if err != nil {
+		return err
+	}
+
+	return convert(spec, target)
+}
+
+// convert converts src to target by a JSON round trip.
+func convert(src any, target any) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return fmt.Errorf("encode spec: %w", err)
+	}
+
+	err = json.Unmarshal(data, target)
+	if err != nil {
+		return fmt.Errorf("decode spec: %w", err)
+	}
+
+	return nil
+}
+
+// specType returns the type discriminator of the spec of a monitor or
+// notification.
+func specType(spec map[string]any) (string, error) {
+	typ, ok := spec["type"].(string)
+	if !ok || typ == "" {
+		return "", errors.New("spec.type is required")
+	}
+
+	return typ, nil
+}
+
+// popReference removes the given key from the spec and decodes its value
+// into target.
+func popReference(spec map[string]any, key string, target any) error {
+	value, ok := spec[key]
+	if !ok {
+		return nil
+	}
+
+	delete(spec, key)
+
+	err := convert(value, target)
+	if err != nil {
+		return fmt.Errorf("spec.%s: %w", key, err)
+	}
+
+	return nil
+}
+
+func decodeMonitor(name string, spec map[string]any) (Monitor, error) {
+	typ, err := specType(spec)
+	if err != nil {
+		return Monitor{}, err
+	}
+
+	mon, ok := monitor.New(typ)
+	if !ok {
+		return Monitor{}, fmt.Errorf("unsupported monitor type %q", typ)
+	}
+
+	err = checkProperties(monitorSpecSchema(typ), spec)
+	if err != nil {
+		return Monitor{}, err
+	}
+
+	res := Monitor{Name: name, Monitor: mon}
+
+	for key, target := range map[string]any{
+		"parent":        &res.Parent,
+		"proxy":         &res.Proxy,
+		"docker_host":   &res.DockerHost,
+		"notifications": &res.Notifications,
+		"tags":          &res.Tags,
+	} {
+		err = popReference(spec, key, target)
+		if err != nil {
+			return Monitor{}, err
+		}
+	}
+
+	spec["name"] = name
+
+	// Monitors are active, unless explicitly paused.
+	_, ok = spec["active"]
+	if !ok {
+		spec["active"] = true
+	}
+
+	err = convert(spec, res.Monitor)
+	if err != nil {
+		return Monitor{}, err
+	}
+
+	return res, nil
+}
+
+func decodeNotification(name string, spec map[string]any) (Notification, error) {
+	typ, err := specType(spec)
+	if err != nil {
+		return Notification{}, err
+	}
+
+	n, ok := notification.New(typ)
+	if !ok {
+		return Notification{}, fmt.Errorf("unsupported notification type %q", typ)
+	}
+
+	err = checkProperties(notificationSpecSchema(typ), spec)
+	if err != nil {
+		return Notification{}, err
+	}
+
+	spec["name"] = name
+
+	// Notifications are active, unless explicitly disabled.
+	_, ok = spec["active"]
+	if !ok {
+		spec["active"] = true
+	}
+
+	config, err := json.Marshal(spec)
+	if err != nil {
+		return Notification{}, fmt.Errorf("encode spec: %w", err)
+	}
+
+	// The server format contains the configuration as JSON encoded string.
+	wire := map[string]any{
+		"name":      name,
+		"active":    spec["active"],
+		"isDefault": spec["isDefault"],
+		"config":    string(config),
+	}
+
+	err = convert(wire, n)
+	if err != nil {
+		return Notification{}, err
+	}
+
+	return Notification{Name: name, Notification: n}, nil
+}
+
+func decodeStatusPage(name string, spec map[string]any) (StatusPage, error) {
+	err := checkProperties(specSchema(KindStatusPage, ""), spec)
+	if err != nil {
+		return StatusPage{}, err
+	}
+
+	res := StatusPage{Name: name}
+
+	err = popReference(spec, "groups", &res.Groups)
+	if err != nil {
+		return StatusPage{}, err
+	}
+
+	err = convert(spec, &res.StatusPage)
+	if err != nil {
+		return StatusPage{}, err
+	}
+
+	res.StatusPage.Slug = name
+	if res.StatusPage.Title == "" {
+		res.StatusPage.Title = name
+	}
+
+	return res, nil
+}
+
+func decodeMaintenance(name string, spec map[string]any) (Maintenance, error) {
+	err := checkProperties(specSchema(KindMaintenance, ""), spec)
+	if err != nil {
+		return Maintenance{}, err
+	}
+
+	res := Maintenance{Name: name}
+
+	err = popReference(spec, "monitors", &res.Monitors)
+	if err != nil {
+		return Maintenance{}, err
+	}
+
+	err = popReference(spec, "statusPages", &res.StatusPages)
+	if err != nil {
+		return Maintenance{}, err
+	}
+
+	// Maintenances are active, unless explicitly paused.
+	_, ok := spec["active"]
+	if !ok {
+		spec["active"] = true
+	}
+
+	err = convert(spec, &res.Maintenance)
+	if err != nil {
+		return Maintenance{}, err
+	}
+
+	if res.Maintenance.Title == "" {
+		res.Maintenance.Title = name
+	}
+
+	return res, nil
+}
@@ -0,0 +1,68 @@
+// Package manifest provides a versioned, declarative file format for Uptime Kuma resources.
+//
+// A manifest consists of one or more YAML (or JSON) documents, each describing
+// a single resource. Resources reference each other by name instead of by
+// numeric ID, such that a manifest can be applied to any Uptime Kuma instance.
+//
+// Supported Kinds:
+//   - Monitor: all monitor types of the monitor package, selected by spec.type
+//   - Notification: all notification providers of the notification package, selected by spec.type
+//   - StatusPage: status pages, the name is the slug
+//   - Maintenance: maintenance windows
+//   - Tag: tags, which can be assigned to monitors
+//   - Proxy: HTTP proxies, which can be used by HTTP monitors
+//   - DockerHost: docker hosts, which are used by docker monitors
+//
+// The JSON Schema of the format is generated from the Go types (see Schema)
+// and is available as kuma-v1.schema.json, which allows to validate manifests
+// in editors and CI pipelines.
+//
+// Example manifest:
+//
+//	apiVersion: kuma/v1
+//	kind: Notification
+//	metadata:
+//	  name: ops
+//	spec:
+//	  type: slack
+//	  slackwebhookURL: https://hooks.slack.com/services/...
+//	---
+//	apiVersion: kuma/v1
+//	kind: Monitor
+//	metadata:
+//	  name: website
+//	spec:
+//	  type: http
+//	  url: https://example.com
+//	  interval: 60
+//	  notifications: [ops]
+//
+// Example usage:
+//
+//	m, err := manifest.LoadFile("kuma.yaml")
+//	if err != nil {
+//	    return err
+//	}
+//
+//	ids := &manifest.IDs{}
+//	for _, n := range m.Notifications {
+//	    id, err := client.CreateNotification(ctx, n.Notification)
+//	    if err != nil {
+//	        return err
+//	    }
+//
+//	    ids.Set(manifest.KindNotification, n.Name, id)
+//	}
+//
+//	for _, mon := range m.Monitors {
+//	    resolved, err := mon.Resolve(ids)
+//	    if err != nil {
+//	        return err
+//	    }
+//
+//	    _, err = client.CreateMonitor(ctx, resolved)
+//	    if err != nil {
+//	        return err
+//	    }
+//	}
+package manifest
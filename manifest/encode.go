@@ -0,0 +1,327 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlDocument is the YAML representation of a Document with the fields in
+// their canonical order.
+type yamlDocument struct {
+	APIVersion string         `yaml:"apiVersion"`
+	Kind       Kind           `yaml:"kind"`
+	Metadata   Metadata       `yaml:"metadata"`
+	Spec       map[string]any `yaml:"spec,omitempty"`
+}
+
+// Documents returns the resources of the manifest as documents. The
+// documents are ordered by kind as returned by Kinds, such that the
+// resulting manifest can be applied in order. Properties with null values
+// are omitted.
+func (m *Manifest) Documents() ([]Document, error) {
+	var docs []Document
+
+	for _, kind := range Kinds() {
+		specs, err := m.specs(kind)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range specs {
+			data, err := json.Marshal(s.spec)
+			if err != nil {
+				return nil, fmt.Errorf("%s %q: encode spec: %w", kind, s.name, err)
+			}
+
+			docs = append(docs, Document{
+				APIVersion: APIVersion,
+				Kind:       kind,
+				Metadata:   Metadata{Name: s.name},
+				Spec:       data,
+			})
+		}
+	}
+
+	return docs, nil
+}
+
+// Encode writes the manifest as YAML documents separated by "---" to w.
+func (m *Manifest) Encode(w io.Writer) error {
+	docs, err := m.Documents()
+	if err != nil {
+		return fmt.Errorf("encode manifest: %w", err)
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+
+	for _, doc := range docs {
+		spec, err := decodeNumbers(doc.Spec)
+		if err != nil {
+			return fmt.Errorf("encode manifest: %s %q: %w", doc.Kind, doc.Metadata.Name, err)
+		}
+
+		err = enc.Encode(yamlDocument{
+			APIVersion: doc.APIVersion,
+			Kind:       doc.Kind,
+			Metadata:   doc.Metadata,
+			Spec:       spec,
+		})
+		if err != nil {
+			return fmt.Errorf("encode manifest: %s %q: %w", doc.Kind, doc.Metadata.Name, err)
+		}
+	}
+
+	err = enc.Close()
+	if err != nil {
+		return fmt.Errorf("encode manifest: %w", err)
+	}
+
+	return nil
+}
+
+type namedSpec struct {
+	name string
+	spec map[string]any
+}
+
+func (m *Manifest) specs(kind Kind) ([]namedSpec, error) {
+	var specs []namedSpec
+
+	add := func(name string, spec map[string]any, err error) error {
+		if err != nil {
+			return fmt.Errorf("%s %q: %w", kind, name, err)
+		}
+
+		specs = append(specs, namedSpec{name: name, spec: spec})
+
+		return nil
+	}
+
+	var err error
+
+	switch kind {
+	case KindMonitor:
+		for _, res := range m.Monitors {
+			spec, encErr := res.spec()
+			err = add(res.Name, spec, encErr)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+	case KindNotification:
+		for _, res := range m.Notifications {
+			spec, encErr := encodeSpec(notificationSpecSchema(res.Notification.Type()), res.Notification)
+			err = add(res.Name, spec, encErr)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+	case KindStatusPage:
+		for _, res := range m.StatusPages {
+			spec, encErr := encodeSpec(specSchema(kind, ""), res.StatusPage)
+			if encErr == nil && len(res.Groups) > 0 {
+				spec["groups"] = res.Groups
+			}
+
+			err = add(res.Name, spec, encErr)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+	case KindMaintenance:
+		for _, res := range m.Maintenances {
+			spec, encErr := encodeSpec(specSchema(kind, ""), res.Maintenance)
+			if encErr == nil {
+				setNames(spec, "monitors", res.Monitors)
+				setNames(spec, "statusPages", res.StatusPages)
+			}
+
+			err = add(res.Name, spec, encErr)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+	case KindTag:
+		for _, res := range m.Tags {
+			spec, encErr := encodeSpec(specSchema(kind, ""), res.Tag)
+			err = add(res.Name, spec, encErr)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+	case KindProxy:
+		for _, res := range m.Proxies {
+			spec, encErr := encodeSpec(specSchema(kind, ""), res.Proxy)
+			err = add(res.Name, spec, encErr)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+	case KindDockerHost:
+		for _, res := range m.DockerHosts {
+			spec, encErr := encodeSpec(specSchema(kind, ""), res.DockerHost)
+			err = add(res.Name, spec, encErr)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported kind %q", kind)
+	}
+
+	return specs, nil
+}
+
+// spec returns the spec of the monitor as it is written to a manifest
+// document, with the references to other resources by name.
+func (m Monitor) spec() (map[string]any, error) {
+	if m.Monitor == nil {
+		return nil, fmt.Errorf("monitor %q: no monitor", m.Name)
+	}
+
+	typ := m.Monitor.Type()
+
+	s := monitorSpecSchema(typ)
+	if s == nil {
+		return nil, fmt.Errorf("unsupported monitor type %q", typ)
+	}
+
+	spec, err := encodeSpec(s, m.Monitor)
+	if err != nil {
+		return nil, err
+	}
+
+	// The references are stored by ID in the typed monitor, replace them
+	// with the names.
+	delete(spec, "parent")
+	delete(spec, "docker_host")
+	delete(spec, "tags")
+
+	if m.Parent != "" {
+		spec["parent"] = m.Parent
+	}
+
+	if m.Proxy != "" {
+		spec["proxy"] = m.Proxy
+	}
+
+	if m.DockerHost != "" {
+		spec["docker_host"] = m.DockerHost
+	}
+
+	setNames(spec, "notifications", m.Notifications)
+
+	if len(m.Tags) > 0 {
+		spec["tags"] = m.Tags
+	}
+
+	spec["type"] = typ
+
+	return spec, nil
+}
+
+// encodeSpec encodes v as spec. Only the properties defined by the schema
+// s are kept and properties with null values are omitted.
+func encodeSpec(s *jsonSchema, v any) (map[string]any, error) {
+	if s == nil {
+		return nil, fmt.Errorf("no schema for %T", v)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encode spec: %w", err)
+	}
+
+	raw := map[string]any{}
+
+	err = json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, fmt.Errorf("encode spec: %w", err)
+	}
+
+	spec := make(map[string]any, len(raw))
+	for key, value := range raw {
+		_, ok := s.Properties[key]
+		if !ok || value == nil {
+			continue
+		}
+
+		spec[key] = value
+	}
+
+	return spec, nil
+}
+
+func setNames(spec map[string]any, key string, names []string) {
+	if len(names) > 0 {
+		spec[key] = names
+	}
+}
+
+// decodeNumbers decodes the JSON encoded spec such that integers are kept
+// as integers instead of being converted to float64.
+func decodeNumbers(data json.RawMessage) (map[string]any, error) {
+	if len(data) == 0 {
+		return nil, nil //nolint:nilnil // An empty spec is omitted.
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var spec map[string]any
+
+	err := dec.Decode(&spec)
+	if err != nil {
+		return nil, fmt.Errorf("decode spec: %w", err)
+	}
+
+	value, ok := normalizeNumbers(spec).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("decode spec: unexpected type %T", spec)
+	}
+
+	return value, nil
+}
+
+func normalizeNumbers(v any) any {
+	switch value := v.(type) {
+	case json.Number:
+		i, err := value.Int64()
+		if err == nil {
+			return i
+		}
+
+		f, _ := value.Float64()
+
+		return f
+
+	case map[string]any:
+		for key, elem := range value {
+			value[key] = normalizeNumbers(elem)
+		}
+
+		return value
+
+	case []any:
+		for i, elem := range value {
+			value[i] = normalizeNumbers(elem)
+		}
+
+		return value
+
+	default:
+		return v
+	}
+}
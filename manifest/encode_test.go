@@ -0,0 +1,43 @@
+package manifest_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/manifest"
+)
+
+func TestManifest_Encode(t *testing.T) {
+	m, err := manifest.Load(strings.NewReader(testManifest))
+	require.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	require.NoError(t, m.Encode(&buf))
+
+	out := buf.String()
+	require.Contains(t, out, "apiVersion: kuma/v1\nkind: Tag\nmetadata:\n  name: env\n")
+	require.Contains(t, out, "  interval: 30\n")
+	require.Contains(t, out, "  parent: production\n")
+	require.Contains(t, out, "  notifications:\n    - ops\n")
+	require.NotContains(t, out, "notificationIDList")
+
+	// The encoded manifest decodes to the same resources.
+	roundTrip, err := manifest.Load(&buf)
+	require.NoError(t, err)
+
+	want, err := m.Documents()
+	require.NoError(t, err)
+
+	got, err := roundTrip.Documents()
+	require.NoError(t, err)
+	require.Len(t, got, len(want))
+
+	for i := range want {
+		require.Equal(t, want[i].Kind, got[i].Kind)
+		require.Equal(t, want[i].Metadata, got[i].Metadata)
+		require.JSONEq(t, string(want[i].Spec), string(got[i].Spec))
+	}
+}
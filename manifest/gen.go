@@ -0,0 +1,23 @@
+//go:build ignore
+
+// gen writes the JSON Schema of the manifest format to kuma-v1.schema.json.
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/breml/go-uptime-kuma-client/manifest"
+)
+
+func main() {
+	schema, err := manifest.Schema()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = os.WriteFile("kuma-v1.schema.json", schema, 0o644) //nolint:gosec // The schema is public.
+	if err != nil {
+		log.Fatal(err)
+	}
+}
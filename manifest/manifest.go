@@ -0,0 +1,356 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/breml/go-uptime-kuma-client/dockerhost"
+	"github.com/breml/go-uptime-kuma-client/maintenance"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/notification"
+	"github.com/breml/go-uptime-kuma-client/proxy"
+	"github.com/breml/go-uptime-kuma-client/statuspage"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+// APIVersion is the version of the manifest format.
+const APIVersion = "kuma/v1"
+
+// Kind is the kind of resource described by a manifest document.
+type Kind string
+
+// Kinds of resources.
+const (
+	KindMonitor      Kind = "Monitor"
+	KindNotification Kind = "Notification"
+	KindStatusPage   Kind = "StatusPage"
+	KindMaintenance  Kind = "Maintenance"
+	KindTag          Kind = "Tag"
+	KindProxy        Kind = "Proxy"
+	KindDockerHost   Kind = "DockerHost"
+)
+
+// Kinds returns all kinds of resources in the order, in which they need to
+// be created, such that references can be resolved.
+func Kinds() []Kind {
+	return []Kind{
+		KindTag,
+		KindProxy,
+		KindDockerHost,
+		KindNotification,
+		KindMonitor,
+		KindStatusPage,
+		KindMaintenance,
+	}
+}
+
+// Metadata identifies a resource within the manifest.
+type Metadata struct {
+	// Name is the name of the resource. Other resources reference the
+	// resource by this name. For status pages, the name is the slug.
+	Name string `json:"name"`
+}
+
+// Document is a single document of a manifest in its generic form.
+type Document struct {
+	APIVersion string          `json:"apiVersion"`
+	Kind       Kind            `json:"kind"`
+	Metadata   Metadata        `json:"metadata"`
+	Spec       json.RawMessage `json:"spec"`
+}
+
+// Manifest is a set of resources loaded from one or more manifest
+// documents.
+type Manifest struct {
+	Monitors      []Monitor
+	Notifications []Notification
+	StatusPages   []StatusPage
+	Maintenances  []Maintenance
+	Tags          []Tag
+	Proxies       []Proxy
+	DockerHosts   []DockerHost
+}
+
+// Monitor is a monitor resource.
+type Monitor struct {
+	Name string
+
+	// Monitor is the typed monitor, e.g. *monitor.HTTP. The references to
+	// other resources are not resolved, see Resolve.
+	Monitor monitor.Monitor
+
+	// Parent is the name of the parent group monitor.
+	Parent string
+
+	// Proxy is the name of the proxy.
+	Proxy string
+
+	// DockerHost is the name of the docker host for docker monitors.
+	DockerHost string
+
+	// Notifications are the names of the notifications.
+	Notifications []string
+
+	// Tags are the tags of the monitor.
+	Tags []TagRef
+}
+
+// TagRef references a tag by name together with the value for a monitor.
+type TagRef struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+// Notification is a notification resource.
+type Notification struct {
+	Name string
+
+	// Notification is the typed notification, e.g. *notification.Slack.
+	Notification notification.Notification
+}
+
+// StatusPage is a status page resource.
+type StatusPage struct {
+	Name string
+
+	// StatusPage is the status page without the public group list, which
+	// references the monitors by name, see Groups.
+	StatusPage statuspage.StatusPage
+
+	// Groups are the groups of monitors shown on the status page.
+	Groups []StatusPageGroup
+}
+
+// StatusPageGroup is a group of monitors shown on a status page.
+type StatusPageGroup struct {
+	Name string `json:"name"`
+
+	// Monitors are the names of the monitors.
+	Monitors []string `json:"monitors"`
+}
+
+// Maintenance is a maintenance resource.
+type Maintenance struct {
+	Name string
+
+	Maintenance maintenance.Maintenance
+
+	// Monitors are the names of the monitors affected by the maintenance.
+	Monitors []string
+
+	// StatusPages are the names of the status pages, which show the
+	// maintenance.
+	StatusPages []string
+}
+
+// Tag is a tag resource.
+type Tag struct {
+	Name string
+
+	Tag tag.Tag
+}
+
+// Proxy is a proxy resource. Proxies do not have a name in Uptime Kuma, the
+// name is only used for references within the manifest.
+type Proxy struct {
+	Name string
+
+	Proxy proxy.Config
+}
+
+// DockerHost is a docker host resource.
+type DockerHost struct {
+	Name string
+
+	DockerHost dockerhost.Config
+}
+
+// Load reads a manifest from r. The manifest consists of one or more YAML
+// documents separated by "---". Because JSON is a subset of YAML, JSON
+// documents are accepted as well.
+func Load(r io.Reader) (*Manifest, error) {
+	m := &Manifest{}
+
+	dec := yaml.NewDecoder(r)
+	for i := 0; ; i++ {
+		var doc any
+
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("load manifest: document %d: %w", i, err)
+		}
+
+		// Skip empty documents.
+		if doc == nil {
+			continue
+		}
+
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return nil, fmt.Errorf("load manifest: document %d: %w", i, err)
+		}
+
+		err = m.AddDocument(data)
+		if err != nil {
+			return nil, fmt.Errorf("load manifest: document %d: %w", i, err)
+		}
+	}
+
+	return m, nil
+}
+
+// LoadFile reads a manifest from the file with the given path.
+func LoadFile(path string) (*Manifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("load manifest: %w", err)
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	m, err := Load(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return m, nil
+}
+
+// AddDocument decodes a single manifest document in JSON format and adds the
+// resource to the manifest.
+func (m *Manifest) AddDocument(data []byte) error {
+	var doc Document
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	err := dec.Decode(&doc)
+	if err != nil {
+		return fmt.Errorf("decode document: %w", err)
+	}
+
+	if doc.APIVersion != APIVersion {
+		return fmt.Errorf("unsupported apiVersion %q, expected %q", doc.APIVersion, APIVersion)
+	}
+
+	if doc.Metadata.Name == "" {
+		return fmt.Errorf("%s: metadata.name is required", doc.Kind)
+	}
+
+	spec := map[string]any{}
+	if len(doc.Spec) > 0 && string(doc.Spec) != "null" {
+		err = json.Unmarshal(doc.Spec, &spec)
+		if err != nil {
+			return fmt.Errorf("%s %q: decode spec: %w", doc.Kind, doc.Metadata.Name, err)
+		}
+	}
+
+	err = m.add(doc.Kind, doc.Metadata.Name, spec)
+	if err != nil {
+		return fmt.Errorf("%s %q: %w", doc.Kind, doc.Metadata.Name, err)
+	}
+
+	return nil
+}
+
+func (m *Manifest) add(kind Kind, name string, spec map[string]any) error {
+	switch kind {
+	case KindMonitor:
+		res, err := decodeMonitor(name, spec)
+		if err != nil {
+			return err
+		}
+
+		m.Monitors = append(m.Monitors, res)
+
+	case KindNotification:
+		res, err := decodeNotification(name, spec)
+		if err != nil {
+			return err
+		}
+
+		m.Notifications = append(m.Notifications, res)
+
+	case KindStatusPage:
+		res, err := decodeStatusPage(name, spec)
+		if err != nil {
+			return err
+		}
+
+		m.StatusPages = append(m.StatusPages, res)
+
+	case KindMaintenance:
+		res, err := decodeMaintenance(name, spec)
+		if err != nil {
+			return err
+		}
+
+		m.Maintenances = append(m.Maintenances, res)
+
+	case KindTag:
+		res := Tag{Name: name}
+
+		err := decodeSpec(KindTag, spec, &res.Tag)
+		if err != nil {
+			return err
+		}
+
+		res.Tag.Name = name
+		m.Tags = append(m.Tags, res)
+
+	case KindProxy:
+		res := Proxy{Name: name}
+
+		err := decodeSpec(KindProxy, spec, &res.Proxy)
+		if err != nil {
+			return err
+		}
+
+		m.Proxies = append(m.Proxies, res)
+
+	case KindDockerHost:
+		res := DockerHost{Name: name}
+
+		err := decodeSpec(KindDockerHost, spec, &res.DockerHost)
+		if err != nil {
+			return err
+		}
+
+		res.DockerHost.Name = name
+		m.DockerHosts = append(m.DockerHosts, res)
+
+	default:
+		return fmt.Errorf("unsupported kind %q", kind)
+	}
+
+	return nil
+}
+
+// Merge adds all resources of other to the manifest.
+func (m *Manifest) Merge(other *Manifest) {
+	m.Monitors = append(m.Monitors, other.Monitors...)
+	m.Notifications = append(m.Notifications, other.Notifications...)
+	m.StatusPages = append(m.StatusPages, other.StatusPages...)
+	m.Maintenances = append(m.Maintenances, other.Maintenances...)
+	m.Tags = append(m.Tags, other.Tags...)
+	m.Proxies = append(m.Proxies, other.Proxies...)
+	m.DockerHosts = append(m.DockerHosts, other.DockerHosts...)
+}
+
+// Len returns the number of resources in the manifest.
+func (m *Manifest) Len() int {
+	return len(m.Monitors) + len(m.Notifications) + len(m.StatusPages) + len(m.Maintenances) +
+		len(m.Tags) + len(m.Proxies) + len(m.DockerHosts)
+}
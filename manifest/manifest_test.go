@@ -0,0 +1,327 @@
+package manifest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/manifest"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/notification"
+	"github.com/breml/go-uptime-kuma-client/statuspage"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+const testManifest = `
+apiVersion: kuma/v1
+kind: Tag
+metadata:
+  name: env
+spec:
+  color: "#ff0000"
+---
+apiVersion: kuma/v1
+kind: Proxy
+metadata:
+  name: corporate
+spec:
+  protocol: http
+  host: proxy.example.com
+  port: 3128
+---
+apiVersion: kuma/v1
+kind: DockerHost
+metadata:
+  name: local
+spec:
+  dockerDaemon: unix:///var/run/docker.sock
+  dockerType: socket
+---
+apiVersion: kuma/v1
+kind: Notification
+metadata:
+  name: ops
+spec:
+  type: ntfy
+  ntfyserverurl: https://ntfy.sh
+  ntfytopic: ops
+  ntfyPriority: 5
+---
+apiVersion: kuma/v1
+kind: Monitor
+metadata:
+  name: production
+spec:
+  type: group
+---
+apiVersion: kuma/v1
+kind: Monitor
+metadata:
+  name: website
+spec:
+  type: http
+  url: https://example.com
+  interval: 30
+  parent: production
+  proxy: corporate
+  notifications: [ops]
+  tags:
+    - name: env
+      value: prod
+---
+apiVersion: kuma/v1
+kind: Monitor
+metadata:
+  name: container
+spec:
+  type: docker
+  docker_container: app
+  docker_host: local
+  active: false
+---
+apiVersion: kuma/v1
+kind: StatusPage
+metadata:
+  name: public
+spec:
+  description: Public status
+  groups:
+    - name: Services
+      monitors: [website, container]
+---
+apiVersion: kuma/v1
+kind: Maintenance
+metadata:
+  name: upgrade
+spec:
+  strategy: manual
+  monitors: [website]
+  statusPages: [public]
+`
+
+func TestLoad(t *testing.T) {
+	m, err := manifest.Load(strings.NewReader(testManifest))
+	require.NoError(t, err)
+	require.Equal(t, 9, m.Len())
+	require.NoError(t, m.Validate())
+	require.Empty(t, m.UnresolvedReferences())
+
+	require.Equal(t, []manifest.Tag{{Name: "env", Tag: tag.Tag{Name: "env", Color: "#ff0000"}}}, m.Tags)
+	require.Equal(t, "proxy.example.com", m.Proxies[0].Proxy.Host)
+	require.Equal(t, "local", m.DockerHosts[0].DockerHost.Name)
+
+	require.Len(t, m.Notifications, 1)
+	ntfy := notification.Ntfy{}
+	require.NoError(t, m.Notifications[0].Notification.As(&ntfy))
+	require.Equal(t, "ops", ntfy.Name)
+	require.True(t, ntfy.IsActive)
+	require.Equal(t, "ops", ntfy.Topic)
+	require.Equal(t, int64(5), ntfy.Priority)
+
+	require.Len(t, m.Monitors, 3)
+	website := m.Monitors[1]
+	require.Equal(t, "website", website.Name)
+	require.Equal(t, "production", website.Parent)
+	require.Equal(t, "corporate", website.Proxy)
+	require.Equal(t, []string{"ops"}, website.Notifications)
+	require.Equal(t, []manifest.TagRef{{Name: "env", Value: "prod"}}, website.Tags)
+
+	httpMonitor, ok := website.Monitor.(*monitor.HTTP)
+	require.True(t, ok)
+	require.Equal(t, "website", httpMonitor.Name)
+	require.Equal(t, "https://example.com", httpMonitor.URL)
+	require.Equal(t, int64(30), httpMonitor.Interval)
+	require.True(t, httpMonitor.IsActive)
+
+	docker, ok := m.Monitors[2].Monitor.(*monitor.Docker)
+	require.True(t, ok)
+	require.False(t, docker.IsActive)
+	require.Equal(t, "local", m.Monitors[2].DockerHost)
+
+	require.Equal(t, "public", m.StatusPages[0].StatusPage.Slug)
+	require.Equal(t, "public", m.StatusPages[0].StatusPage.Title)
+	require.Equal(t, []manifest.StatusPageGroup{
+		{Name: "Services", Monitors: []string{"website", "container"}},
+	}, m.StatusPages[0].Groups)
+
+	require.Equal(t, "upgrade", m.Maintenances[0].Maintenance.Title)
+	require.True(t, m.Maintenances[0].Maintenance.Active)
+	require.Equal(t, []string{"website"}, m.Maintenances[0].Monitors)
+	require.Equal(t, []string{"public"}, m.Maintenances[0].StatusPages)
+}
+
+func TestLoad_JSON(t *testing.T) {
+	m, err := manifest.Load(strings.NewReader(
+		`{"apiVersion": "kuma/v1", "kind": "Monitor", "metadata": {"name": "ping"}, ` +
+			`"spec": {"type": "ping", "hostname": "10.0.0.1"}}`,
+	))
+	require.NoError(t, err)
+	require.Len(t, m.Monitors, 1)
+	require.Equal(t, "ping", m.Monitors[0].Monitor.Type())
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name:    "invalid yaml",
+			data:    "apiVersion: [",
+			wantErr: "document 0",
+		},
+		{
+			name:    "unsupported apiVersion",
+			data:    "apiVersion: kuma/v2\nkind: Tag\nmetadata:\n  name: a\n",
+			wantErr: `unsupported apiVersion "kuma/v2"`,
+		},
+		{
+			name:    "unknown kind",
+			data:    "apiVersion: kuma/v1\nkind: User\nmetadata:\n  name: a\n",
+			wantErr: `unsupported kind "User"`,
+		},
+		{
+			name:    "unknown document field",
+			data:    "apiVersion: kuma/v1\nkind: Tag\nmetadata:\n  name: a\nstatus: {}\n",
+			wantErr: "unknown field",
+		},
+		{
+			name:    "missing name",
+			data:    "apiVersion: kuma/v1\nkind: Tag\nmetadata: {}\n",
+			wantErr: "metadata.name is required",
+		},
+		{
+			name:    "missing monitor type",
+			data:    "apiVersion: kuma/v1\nkind: Monitor\nmetadata:\n  name: a\nspec:\n  url: https://example.com\n",
+			wantErr: "spec.type is required",
+		},
+		{
+			name:    "unsupported monitor type",
+			data:    "apiVersion: kuma/v1\nkind: Monitor\nmetadata:\n  name: a\nspec:\n  type: gopher\n",
+			wantErr: `unsupported monitor type "gopher"`,
+		},
+		{
+			name:    "unknown monitor field",
+			data:    "apiVersion: kuma/v1\nkind: Monitor\nmetadata:\n  name: a\nspec:\n  type: ping\n  url: x\n",
+			wantErr: "unknown fields in spec: url",
+		},
+		{
+			name: "unknown notification field",
+			data: "---\napiVersion: kuma/v1\nkind: Tag\nmetadata:\n  name: a\n---\n" +
+				"apiVersion: kuma/v1\nkind: Notification\nmetadata:\n  name: a\nspec:\n  type: ntfy\n  topic: x\n",
+			wantErr: "document 1: Notification \"a\": unknown fields in spec: topic",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := manifest.Load(strings.NewReader(tc.data))
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestManifest_Validate(t *testing.T) {
+	m, err := manifest.Load(strings.NewReader(`
+apiVersion: kuma/v1
+kind: Monitor
+metadata:
+  name: a
+spec:
+  type: ping
+  hostname: 10.0.0.1
+---
+apiVersion: kuma/v1
+kind: Monitor
+metadata:
+  name: a
+spec:
+  type: ping
+  hostname: 10.0.0.2
+  parent: a
+---
+apiVersion: kuma/v1
+kind: Monitor
+metadata:
+  name: b
+spec:
+  type: ping
+  hostname: 10.0.0.3
+  parent: a
+  notifications: [missing]
+`))
+	require.NoError(t, err)
+
+	err = m.Validate()
+	require.ErrorContains(t, err, `Monitor "a": duplicate name`)
+	require.ErrorContains(t, err, `Monitor "a": monitor is its own parent`)
+	require.ErrorContains(t, err, `Monitor "b": parent "a" is not a group monitor`)
+
+	require.Equal(t, []manifest.Reference{
+		{Kind: manifest.KindMonitor, Name: "b", TargetKind: manifest.KindNotification, Target: "missing"},
+	}, m.UnresolvedReferences())
+}
+
+func TestResolve(t *testing.T) {
+	m, err := manifest.Load(strings.NewReader(testManifest))
+	require.NoError(t, err)
+
+	ids := &manifest.IDs{}
+	ids.Set(manifest.KindTag, "env", 1)
+	ids.Set(manifest.KindProxy, "corporate", 2)
+	ids.Set(manifest.KindDockerHost, "local", 3)
+	ids.Set(manifest.KindNotification, "ops", 4)
+	ids.Set(manifest.KindMonitor, "production", 5)
+	ids.Set(manifest.KindMonitor, "website", 6)
+	ids.Set(manifest.KindMonitor, "container", 7)
+	ids.Set(manifest.KindStatusPage, "public", 8)
+
+	resolved, err := m.Monitors[1].Resolve(ids)
+	require.NoError(t, err)
+
+	httpMonitor, ok := resolved.(*monitor.HTTP)
+	require.True(t, ok)
+	require.Equal(t, int64(6), httpMonitor.ID)
+	require.Equal(t, int64(5), *httpMonitor.Parent)
+	require.Equal(t, int64(2), *httpMonitor.ProxyID)
+	require.Equal(t, []int64{4}, httpMonitor.NotificationIDs)
+	require.Equal(t, "https://example.com", httpMonitor.URL)
+
+	// The unresolved monitor is not modified.
+	require.Nil(t, m.Monitors[1].Monitor.(*monitor.HTTP).Parent)
+
+	resolved, err = m.Monitors[2].Resolve(ids)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), resolved.(*monitor.Docker).DockerHost)
+
+	tags, err := m.Monitors[1].ResolveTags(ids)
+	require.NoError(t, err)
+	require.Equal(t, []tag.MonitorTag{{TagID: 1, Name: "env", Value: "prod"}}, tags)
+
+	page, err := m.StatusPages[0].Resolve(ids)
+	require.NoError(t, err)
+	require.Equal(t, []statuspage.PublicGroup{
+		{Name: "Services", Weight: 1, MonitorList: []statuspage.PublicMonitor{{ID: 6}, {ID: 7}}},
+	}, page.PublicGroupList)
+
+	monitorIDs, err := m.Maintenances[0].ResolveMonitors(ids)
+	require.NoError(t, err)
+	require.Equal(t, []int64{6}, monitorIDs)
+
+	statusPageIDs, err := m.Maintenances[0].ResolveStatusPages(ids)
+	require.NoError(t, err)
+	require.Equal(t, []int64{8}, statusPageIDs)
+
+	n, err := m.Notifications[0].Resolve(ids)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), n.GetID())
+
+	ntfy := notification.Ntfy{}
+	require.NoError(t, n.As(&ntfy))
+	require.Equal(t, "ops", ntfy.Topic)
+
+	_, err = m.Monitors[1].Resolve(&manifest.IDs{})
+	require.ErrorIs(t, err, manifest.ErrUnresolvedReference)
+}
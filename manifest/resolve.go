@@ -0,0 +1,410 @@
+package manifest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/notification"
+	"github.com/breml/go-uptime-kuma-client/statuspage"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+// ErrUnresolvedReference is returned, if a resource references another
+// resource by a name, which is not known.
+var ErrUnresolvedReference = errors.New("unresolved reference")
+
+// IDs maps the names of the resources to their IDs in Uptime Kuma. It is
+// used to resolve the references between the resources.
+type IDs struct {
+	Monitors      map[string]int64
+	Notifications map[string]int64
+	StatusPages   map[string]int64
+	Maintenances  map[string]int64
+	Tags          map[string]int64
+	Proxies       map[string]int64
+	DockerHosts   map[string]int64
+}
+
+// Set sets the ID of the resource of the given kind with the given name.
+func (ids *IDs) Set(kind Kind, name string, id int64) {
+	m := ids.index(kind)
+	if *m == nil {
+		*m = map[string]int64{}
+	}
+
+	(*m)[name] = id
+}
+
+// Get returns the ID of the resource of the given kind with the given name.
+func (ids *IDs) Get(kind Kind, name string) (int64, error) {
+	m := ids.index(kind)
+
+	id, ok := (*m)[name]
+	if !ok {
+		return 0, fmt.Errorf("%s %q: %w", kind, name, ErrUnresolvedReference)
+	}
+
+	return id, nil
+}
+
+func (ids *IDs) getAll(kind Kind, names []string) ([]int64, error) {
+	result := make([]int64, 0, len(names))
+	for _, name := range names {
+		id, err := ids.Get(kind, name)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, id)
+	}
+
+	return result, nil
+}
+
+func (ids *IDs) index(kind Kind) *map[string]int64 {
+	switch kind {
+	case KindMonitor:
+		return &ids.Monitors
+	case KindNotification:
+		return &ids.Notifications
+	case KindStatusPage:
+		return &ids.StatusPages
+	case KindMaintenance:
+		return &ids.Maintenances
+	case KindTag:
+		return &ids.Tags
+	case KindProxy:
+		return &ids.Proxies
+	case KindDockerHost:
+		return &ids.DockerHosts
+	default:
+		return new(map[string]int64)
+	}
+}
+
+// Resolve returns the monitor with the references to the parent group, the
+// proxy, the docker host and the notifications replaced by their IDs. If the
+// ID of the monitor itself is known, it is set as well, such that the
+// monitor can be updated. The tags are resolved separately, see ResolveTags.
+func (m Monitor) Resolve(ids *IDs) (monitor.Monitor, error) {
+	data, err := json.Marshal(m.Monitor)
+	if err != nil {
+		return nil, fmt.Errorf("resolve monitor %q: %w", m.Name, err)
+	}
+
+	raw := map[string]any{}
+
+	err = json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, fmt.Errorf("resolve monitor %q: %w", m.Name, err)
+	}
+
+	raw["id"] = ids.Monitors[m.Name]
+
+	raw["parent"] = nil
+	if m.Parent != "" {
+		raw["parent"], err = ids.Get(KindMonitor, m.Parent)
+		if err != nil {
+			return nil, fmt.Errorf("resolve monitor %q: parent: %w", m.Name, err)
+		}
+	}
+
+	raw["proxyId"] = nil
+	if m.Proxy != "" {
+		raw["proxyId"], err = ids.Get(KindProxy, m.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("resolve monitor %q: proxy: %w", m.Name, err)
+		}
+	}
+
+	if m.DockerHost != "" {
+		raw["docker_host"], err = ids.Get(KindDockerHost, m.DockerHost)
+		if err != nil {
+			return nil, fmt.Errorf("resolve monitor %q: docker host: %w", m.Name, err)
+		}
+	}
+
+	notificationIDs, err := ids.getAll(KindNotification, m.Notifications)
+	if err != nil {
+		return nil, fmt.Errorf("resolve monitor %q: notifications: %w", m.Name, err)
+	}
+
+	notificationIDList := map[string]bool{}
+	for _, id := range notificationIDs {
+		notificationIDList[strconv.FormatInt(id, 10)] = true
+	}
+
+	raw["notificationIDList"] = notificationIDList
+
+	resolved, ok := monitor.New(m.Monitor.Type())
+	if !ok {
+		return nil, fmt.Errorf("resolve monitor %q: unsupported monitor type %q", m.Name, m.Monitor.Type())
+	}
+
+	err = convert(raw, resolved)
+	if err != nil {
+		return nil, fmt.Errorf("resolve monitor %q: %w", m.Name, err)
+	}
+
+	return resolved, nil
+}
+
+// Resolve returns the notification with its ID set, if the ID is known,
+// such that the notification can be updated.
+func (n Notification) Resolve(ids *IDs) (notification.Notification, error) {
+	id, ok := ids.Notifications[n.Name]
+	if !ok {
+		return n.Notification, nil
+	}
+
+	data, err := json.Marshal(n.Notification)
+	if err != nil {
+		return nil, fmt.Errorf("resolve notification %q: %w", n.Name, err)
+	}
+
+	raw := map[string]any{}
+
+	err = json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, fmt.Errorf("resolve notification %q: %w", n.Name, err)
+	}
+
+	raw["id"] = id
+
+	config, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("resolve notification %q: %w", n.Name, err)
+	}
+
+	// The server format contains the configuration as JSON encoded string.
+	wire := map[string]any{
+		"id":        id,
+		"name":      raw["name"],
+		"active":    raw["active"],
+		"isDefault": raw["isDefault"],
+		"config":    string(config),
+	}
+
+	resolved, ok := notification.New(n.Notification.Type())
+	if !ok {
+		return nil, fmt.Errorf(
+			"resolve notification %q: unsupported notification type %q", n.Name, n.Notification.Type(),
+		)
+	}
+
+	err = convert(wire, resolved)
+	if err != nil {
+		return nil, fmt.Errorf("resolve notification %q: %w", n.Name, err)
+	}
+
+	return resolved, nil
+}
+
+// ResolveTags returns the tags of the monitor with the tag names replaced by
+// their IDs. The monitor ID of the returned tags is not set.
+func (m Monitor) ResolveTags(ids *IDs) ([]tag.MonitorTag, error) {
+	tags := make([]tag.MonitorTag, 0, len(m.Tags))
+	for _, ref := range m.Tags {
+		id, err := ids.Get(KindTag, ref.Name)
+		if err != nil {
+			return nil, fmt.Errorf("resolve monitor %q: tags: %w", m.Name, err)
+		}
+
+		tags = append(tags, tag.MonitorTag{TagID: id, Name: ref.Name, Value: ref.Value})
+	}
+
+	return tags, nil
+}
+
+// Resolve returns the status page with the public group list, where the
+// monitor names are replaced by their IDs.
+func (s StatusPage) Resolve(ids *IDs) (statuspage.StatusPage, error) {
+	page := s.StatusPage
+	page.PublicGroupList = make([]statuspage.PublicGroup, 0, len(s.Groups))
+
+	for i, group := range s.Groups {
+		monitorIDs, err := ids.getAll(KindMonitor, group.Monitors)
+		if err != nil {
+			return statuspage.StatusPage{}, fmt.Errorf("resolve status page %q: group %q: %w", s.Name, group.Name, err)
+		}
+
+		publicGroup := statuspage.PublicGroup{
+			Name:        group.Name,
+			Weight:      i + 1,
+			MonitorList: make([]statuspage.PublicMonitor, 0, len(monitorIDs)),
+		}
+
+		for _, id := range monitorIDs {
+			publicGroup.MonitorList = append(publicGroup.MonitorList, statuspage.PublicMonitor{ID: id})
+		}
+
+		page.PublicGroupList = append(page.PublicGroupList, publicGroup)
+	}
+
+	return page, nil
+}
+
+// ResolveMonitors returns the IDs of the monitors affected by the
+// maintenance.
+func (m Maintenance) ResolveMonitors(ids *IDs) ([]int64, error) {
+	monitorIDs, err := ids.getAll(KindMonitor, m.Monitors)
+	if err != nil {
+		return nil, fmt.Errorf("resolve maintenance %q: monitors: %w", m.Name, err)
+	}
+
+	return monitorIDs, nil
+}
+
+// ResolveStatusPages returns the IDs of the status pages, which show the
+// maintenance.
+func (m Maintenance) ResolveStatusPages(ids *IDs) ([]int64, error) {
+	statusPageIDs, err := ids.getAll(KindStatusPage, m.StatusPages)
+	if err != nil {
+		return nil, fmt.Errorf("resolve maintenance %q: status pages: %w", m.Name, err)
+	}
+
+	return statusPageIDs, nil
+}
+
+// Reference is a reference from a resource to another resource by name.
+type Reference struct {
+	// Kind and Name identify the referencing resource.
+	Kind Kind
+	Name string
+
+	// TargetKind and Target identify the referenced resource.
+	TargetKind Kind
+	Target     string
+}
+
+func (r Reference) String() string {
+	return fmt.Sprintf("%s %q references %s %q", r.Kind, r.Name, r.TargetKind, r.Target)
+}
+
+// References returns all references between the resources of the manifest.
+func (m *Manifest) References() []Reference {
+	var refs []Reference
+
+	add := func(kind Kind, name string, targetKind Kind, targets ...string) {
+		for _, target := range targets {
+			if target != "" {
+				refs = append(refs, Reference{Kind: kind, Name: name, TargetKind: targetKind, Target: target})
+			}
+		}
+	}
+
+	for _, mon := range m.Monitors {
+		add(KindMonitor, mon.Name, KindMonitor, mon.Parent)
+		add(KindMonitor, mon.Name, KindProxy, mon.Proxy)
+		add(KindMonitor, mon.Name, KindDockerHost, mon.DockerHost)
+		add(KindMonitor, mon.Name, KindNotification, mon.Notifications...)
+
+		for _, ref := range mon.Tags {
+			add(KindMonitor, mon.Name, KindTag, ref.Name)
+		}
+	}
+
+	for _, page := range m.StatusPages {
+		for _, group := range page.Groups {
+			add(KindStatusPage, page.Name, KindMonitor, group.Monitors...)
+		}
+	}
+
+	for _, mt := range m.Maintenances {
+		add(KindMaintenance, mt.Name, KindMonitor, mt.Monitors...)
+		add(KindMaintenance, mt.Name, KindStatusPage, mt.StatusPages...)
+	}
+
+	return refs
+}
+
+// UnresolvedReferences returns the references to resources, which are not
+// defined in the manifest. These references need to be resolved against the
+// existing resources in Uptime Kuma.
+func (m *Manifest) UnresolvedReferences() []Reference {
+	defined := m.names()
+
+	var unresolved []Reference
+	for _, ref := range m.References() {
+		if !slices.Contains(defined[ref.TargetKind], ref.Target) {
+			unresolved = append(unresolved, ref)
+		}
+	}
+
+	return unresolved
+}
+
+// Validate checks, that the names of the resources are unique per kind and
+// that monitors reference a parent group monitor, if the parent is defined
+// in the manifest.
+func (m *Manifest) Validate() error {
+	var errs []error
+
+	for _, kind := range Kinds() {
+		seen := map[string]bool{}
+		for _, name := range m.names()[kind] {
+			if seen[name] {
+				errs = append(errs, fmt.Errorf("%s %q: duplicate name", kind, name))
+			}
+
+			seen[name] = true
+		}
+	}
+
+	types := make(map[string]string, len(m.Monitors))
+	for _, mon := range m.Monitors {
+		types[mon.Name] = mon.Monitor.Type()
+	}
+
+	for _, mon := range m.Monitors {
+		if mon.Parent == mon.Name {
+			errs = append(errs, fmt.Errorf("%s %q: monitor is its own parent", KindMonitor, mon.Name))
+			continue
+		}
+
+		typ, ok := types[mon.Parent]
+		if ok && typ != "group" {
+			errs = append(errs, fmt.Errorf("%s %q: parent %q is not a group monitor", KindMonitor, mon.Name, mon.Parent))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m *Manifest) names() map[Kind][]string {
+	names := map[Kind][]string{}
+
+	for _, res := range m.Monitors {
+		names[KindMonitor] = append(names[KindMonitor], res.Name)
+	}
+
+	for _, res := range m.Notifications {
+		names[KindNotification] = append(names[KindNotification], res.Name)
+	}
+
+	for _, res := range m.StatusPages {
+		names[KindStatusPage] = append(names[KindStatusPage], res.Name)
+	}
+
+	for _, res := range m.Maintenances {
+		names[KindMaintenance] = append(names[KindMaintenance], res.Name)
+	}
+
+	for _, res := range m.Tags {
+		names[KindTag] = append(names[KindTag], res.Name)
+	}
+
+	for _, res := range m.Proxies {
+		names[KindProxy] = append(names[KindProxy], res.Name)
+	}
+
+	for _, res := range m.DockerHosts {
+		names[KindDockerHost] = append(names[KindDockerHost], res.Name)
+	}
+
+	return names
+}
@@ -0,0 +1,381 @@
+package manifest
+
+//go:generate go run gen.go
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/breml/go-uptime-kuma-client/dockerhost"
+	"github.com/breml/go-uptime-kuma-client/maintenance"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/notification"
+	"github.com/breml/go-uptime-kuma-client/proxy"
+	"github.com/breml/go-uptime-kuma-client/statuspage"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+// SchemaID is the ID of the JSON Schema of the manifest format.
+const SchemaID = "https://github.com/breml/go-uptime-kuma-client/manifest/kuma-v1.schema.json"
+
+// jsonSchema is the subset of JSON Schema (draft 2020-12) used for the
+// manifest format.
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	ID                   string                 `json:"$id,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Type                 any                    `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Const                any                    `json:"const,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties any                    `json:"additionalProperties,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	AllOf                []*jsonSchema          `json:"allOf,omitempty"`
+	If                   *jsonSchema            `json:"if,omitempty"`
+	Then                 *jsonSchema            `json:"then,omitempty"`
+	Defs                 map[string]*jsonSchema `json:"$defs,omitempty"`
+}
+
+// Schema returns the JSON Schema of a manifest document. The schema is
+// generated from the Go structs of the monitor, notification, statuspage,
+// maintenance, tag, proxy and dockerhost packages.
+func Schema() ([]byte, error) {
+	defs := map[string]*jsonSchema{
+		"metadata": {
+			Type:                 "object",
+			Properties:           map[string]*jsonSchema{"name": {Type: "string"}},
+			Required:             []string{"name"},
+			AdditionalProperties: false,
+		},
+	}
+
+	root := &jsonSchema{
+		Schema: "https://json-schema.org/draft/2020-12/schema",
+		ID:     SchemaID,
+		Title:  "Uptime Kuma manifest " + APIVersion,
+		Type:   "object",
+		Properties: map[string]*jsonSchema{
+			"apiVersion": {Const: APIVersion},
+			"kind":       {Enum: kindNames()},
+			"metadata":   {Ref: "#/$defs/metadata"},
+			"spec":       {Type: "object"},
+		},
+		Required:             []string{"apiVersion", "kind", "metadata"},
+		AdditionalProperties: false,
+		Defs:                 defs,
+	}
+
+	for _, kind := range Kinds() {
+		var spec *jsonSchema
+
+		switch kind {
+		case KindMonitor:
+			spec = typedSchema(defs, "monitor", monitorTypes(), monitorSpecSchema)
+
+		case KindNotification:
+			spec = typedSchema(defs, "notification", notificationTypes(), notificationSpecSchema)
+
+		default:
+			spec = specSchema(kind, "")
+		}
+
+		defs[string(kind)] = spec
+		root.AllOf = append(root.AllOf, &jsonSchema{
+			If: &jsonSchema{
+				Properties: map[string]*jsonSchema{"kind": {Const: string(kind)}},
+				Required:   []string{"kind"},
+			},
+			Then: &jsonSchema{
+				Properties: map[string]*jsonSchema{"spec": {Ref: "#/$defs/" + string(kind)}},
+			},
+		})
+	}
+
+	data, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal schema: %w", err)
+	}
+
+	return append(data, '\n'), nil
+}
+
+// typedSchema returns the schema of a kind with a type discriminator, e.g.
+// monitors. The schemas of the individual types are added to defs.
+func typedSchema(
+	defs map[string]*jsonSchema,
+	prefix string,
+	types []string,
+	typeSchema func(typ string) *jsonSchema,
+) *jsonSchema {
+	spec := &jsonSchema{
+		Type:       "object",
+		Properties: map[string]*jsonSchema{"type": {Enum: types}},
+		Required:   []string{"type"},
+	}
+
+	for _, typ := range types {
+		name := prefix + "." + typ
+		defs[name] = typeSchema(typ)
+
+		spec.AllOf = append(spec.AllOf, &jsonSchema{
+			If: &jsonSchema{
+				Properties: map[string]*jsonSchema{"type": {Const: typ}},
+				Required:   []string{"type"},
+			},
+			Then: &jsonSchema{Ref: "#/$defs/" + name},
+		})
+	}
+
+	return spec
+}
+
+// specSchema returns the schema of the spec of a resource. For monitors and
+// notifications, typ is the type of the monitor or notification.
+func specSchema(kind Kind, typ string) *jsonSchema {
+	switch kind {
+	case KindMonitor:
+		return monitorSpecSchema(typ)
+
+	case KindNotification:
+		return notificationSpecSchema(typ)
+
+	case KindStatusPage:
+		s := reflectSchema(reflect.TypeFor[statuspage.StatusPage]())
+		removeProperties(s, "id", "slug", "publicGroupList")
+		s.Properties["groups"] = arrayOf(reflectSchema(reflect.TypeFor[StatusPageGroup]()))
+
+		return s
+
+	case KindMaintenance:
+		s := reflectSchema(reflect.TypeFor[maintenance.Maintenance]())
+		removeProperties(s, "id", "status", "timeslotList", "timezone", "timezoneOffset")
+		s.Properties["monitors"] = arrayOf(&jsonSchema{Type: "string"})
+		s.Properties["statusPages"] = arrayOf(&jsonSchema{Type: "string"})
+
+		return s
+
+	case KindTag:
+		s := reflectSchema(reflect.TypeFor[tag.Tag]())
+		removeProperties(s, "id", "name")
+
+		return s
+
+	case KindProxy:
+		s := reflectSchema(reflect.TypeFor[proxy.Config]())
+		removeProperties(s, "id")
+
+		return s
+
+	case KindDockerHost:
+		s := reflectSchema(reflect.TypeFor[dockerhost.Config]())
+		removeProperties(s, "id", "name")
+
+		return s
+
+	default:
+		return nil
+	}
+}
+
+func monitorSpecSchema(typ string) *jsonSchema {
+	mon, ok := monitor.New(typ)
+	if !ok {
+		return nil
+	}
+
+	s := reflectSchema(reflect.TypeOf(mon))
+	removeProperties(s, "id", "name", "pathName", "proxyId", "tags")
+
+	s.Properties["type"] = &jsonSchema{Const: typ}
+	s.Properties["parent"] = &jsonSchema{Type: "string"}
+	s.Properties["proxy"] = &jsonSchema{Type: "string"}
+	s.Properties["notifications"] = arrayOf(&jsonSchema{Type: "string"})
+	s.Properties["tags"] = arrayOf(reflectSchema(reflect.TypeFor[TagRef]()))
+
+	_, ok = s.Properties["docker_host"]
+	if ok {
+		s.Properties["docker_host"] = &jsonSchema{Type: "string"}
+	}
+
+	s.Required = []string{"type"}
+
+	return s
+}
+
+func notificationSpecSchema(typ string) *jsonSchema {
+	n, ok := notification.New(typ)
+	if !ok {
+		return nil
+	}
+
+	s := reflectSchema(reflect.TypeOf(n))
+	removeProperties(s, "id", "name", "userId")
+
+	s.Properties["type"] = &jsonSchema{Const: typ}
+	s.Required = []string{"type"}
+
+	return s
+}
+
+// reflectSchema returns the JSON Schema of a Go type based on its JSON
+// encoding.
+func reflectSchema(t reflect.Type) *jsonSchema {
+	if t.Kind() == reflect.Pointer {
+		s := reflectSchema(t.Elem())
+
+		typ, ok := s.Type.(string)
+		if ok && typ != "object" && typ != "array" {
+			s.Type = []string{typ, "null"}
+		}
+
+		return s
+	}
+
+	if t == reflect.TypeFor[time.Time]() {
+		return &jsonSchema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &jsonSchema{Type: "boolean"}
+
+	case reflect.String:
+		return &jsonSchema{Type: "string"}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &jsonSchema{Type: "integer"}
+
+	case reflect.Float32, reflect.Float64:
+		return &jsonSchema{Type: "number"}
+
+	case reflect.Slice, reflect.Array:
+		return arrayOf(reflectSchema(t.Elem()))
+
+	case reflect.Map:
+		return &jsonSchema{Type: "object", AdditionalProperties: reflectSchema(t.Elem())}
+
+	case reflect.Struct:
+		s := &jsonSchema{
+			Type:                 "object",
+			Properties:           map[string]*jsonSchema{},
+			AdditionalProperties: false,
+		}
+
+		addFields(s, t)
+
+		return s
+
+	default:
+		// Interfaces and other types accept any value.
+		return &jsonSchema{}
+	}
+}
+
+// addFields adds the exported fields of the struct type t as properties to
+// s. The fields of embedded structs are added as if they were fields of t,
+// following the rules of encoding/json.
+func addFields(s *jsonSchema, t reflect.Type) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		tagValue := field.Tag.Get("json")
+		if tagValue == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tagValue, ",")
+
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+
+			if ft.Kind() == reflect.Struct {
+				addFields(s, ft)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		s.Properties[name] = reflectSchema(field.Type)
+	}
+}
+
+func arrayOf(items *jsonSchema) *jsonSchema {
+	return &jsonSchema{Type: "array", Items: items}
+}
+
+func removeProperties(s *jsonSchema, names ...string) {
+	for _, name := range names {
+		delete(s.Properties, name)
+	}
+}
+
+func kindNames() []string {
+	kinds := Kinds()
+
+	names := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		names = append(names, string(kind))
+	}
+
+	return names
+}
+
+func monitorTypes() []string {
+	var types []string
+	for _, mon := range monitor.Types() {
+		types = append(types, mon.Type())
+	}
+
+	slices.Sort(types)
+
+	return types
+}
+
+func notificationTypes() []string {
+	var types []string
+	for _, n := range notification.Types() {
+		types = append(types, n.Type())
+	}
+
+	slices.Sort(types)
+
+	return types
+}
+
+// checkProperties returns an error, if spec contains a property, which is
+// not defined by the schema.
+func checkProperties(s *jsonSchema, spec map[string]any) error {
+	var unknown []string
+	for key := range spec {
+		_, ok := s.Properties[key]
+		if !ok {
+			unknown = append(unknown, key)
+		}
+	}
+
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+
+		return fmt.Errorf("unknown fields in spec: %s", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package manifest_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/manifest"
+)
+
+func TestSchema(t *testing.T) {
+	schema, err := manifest.Schema()
+	require.NoError(t, err)
+
+	golden, err := os.ReadFile("kuma-v1.schema.json")
+	require.NoError(t, err)
+	require.Equal(t, string(golden), string(schema), "kuma-v1.schema.json is outdated, run go generate ./manifest")
+
+	var s struct {
+		ID   string                     `json:"$id"`
+		Defs map[string]json.RawMessage `json:"$defs"`
+	}
+
+	require.NoError(t, json.Unmarshal(schema, &s))
+	require.Equal(t, manifest.SchemaID, s.ID)
+
+	for _, def := range []string{"metadata", "Monitor", "Notification", "StatusPage", "monitor.http", "notification.ntfy"} {
+		require.Contains(t, s.Defs, def)
+	}
+
+	var httpSchema struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+
+	require.NoError(t, json.Unmarshal(s.Defs["monitor.http"], &httpSchema))
+	require.Contains(t, httpSchema.Properties, "url")
+	require.Contains(t, httpSchema.Properties, "notifications")
+	require.NotContains(t, httpSchema.Properties, "id")
+	require.NotContains(t, httpSchema.Properties, "proxyId")
+}
@@ -19,8 +19,10 @@ func (c *Client) GetMonitors(ctx context.Context) ([]monitor.Base, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	monitors := make([]monitor.Base, len(c.state.monitors))
-	copy(monitors, c.state.monitors)
+	cached := c.monitorsLocked()
+
+	monitors := make([]monitor.Base, len(cached))
+	copy(monitors, cached)
 
 	return monitors, nil
 }
@@ -33,7 +35,7 @@ func (c *Client) GetMonitor(ctx context.Context, monitorID int64) (monitor.Base,
 	}
 
 	if response.Monitor == nil {
-		return monitor.Base{}, fmt.Errorf("get monitor %d: monitor not found in response", monitorID)
+		return monitor.Base{}, fmt.Errorf("get monitor %d: %w", monitorID, ErrNotFound)
 	}
 
 	// Convert the monitor data to a monitor.Base.
@@ -62,7 +64,13 @@ func (c *Client) GetMonitorAs(ctx context.Context, monitorID int64, target any)
 }
 
 // CreateMonitor creates a new monitor.
+// Secret references are resolved, if a SecretResolver is configured.
 func (c *Client) CreateMonitor(ctx context.Context, mon monitor.Monitor) (int64, error) {
+	mon, err := resolveSecrets(ctx, c.secretResolver, mon)
+	if err != nil {
+		return 0, fmt.Errorf("create monitor: %w", err)
+	}
+
 	monitorData, err := structToMap(mon)
 	if err != nil {
 		return 0, fmt.Errorf("create monitor: %w", err)
@@ -90,7 +98,13 @@ func (c *Client) CreateMonitor(ctx context.Context, mon monitor.Monitor) (int64,
 }
 
 // UpdateMonitor updates an existing monitor.
+// Secret references are resolved, if a SecretResolver is configured.
 func (c *Client) UpdateMonitor(ctx context.Context, mon monitor.Monitor) error {
+	mon, err := resolveSecrets(ctx, c.secretResolver, mon)
+	if err != nil {
+		return fmt.Errorf("update monitor: %w", err)
+	}
+
 	monitorData, err := structToMap(mon)
 	if err != nil {
 		return fmt.Errorf("update monitor: %w", err)
@@ -141,3 +155,27 @@ func (c *Client) ResumeMonitor(ctx context.Context, monitorID int64) error {
 
 	return nil
 }
+
+// FindMonitors retrieves the monitors from the client cache, which match the
+// selector expression, e.g. `type=http and tag:env=prod`.
+// See monitor.ParseSelector for the syntax of the expression.
+func (c *Client) FindMonitors(_ context.Context, selector string) ([]monitor.Base, error) {
+	match, err := monitor.ParseSelector(selector)
+	if err != nil {
+		return nil, fmt.Errorf("find monitors: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached := c.monitorsLocked()
+
+	monitors := make([]monitor.Base, 0, len(cached))
+	for _, mon := range cached {
+		if match(mon) {
+			monitors = append(monitors, mon)
+		}
+	}
+
+	return monitors, nil
+}
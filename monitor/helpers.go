@@ -2,12 +2,15 @@ package monitor
 
 import (
 	"cmp"
+	"encoding/json"
 	"fmt"
 	"iter"
 	"reflect"
 	"slices"
 	"strconv"
 	"strings"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // formatMonitor formats a monitor instance as a string representation.
@@ -55,6 +58,9 @@ func formatMonitor( //nolint:revive // includeType is not a control coupling fla
 		// a pointer address.
 		var valueStr string
 		switch {
+		case secret.IsSecret(field) && secret.IsMasked(value):
+			valueStr = fmt.Sprintf("%q", secret.Mask)
+
 		case value.Kind() == reflect.String:
 			valueStr = fmt.Sprintf("%q", value.String())
 
@@ -67,6 +73,9 @@ func formatMonitor( //nolint:revive // includeType is not a control coupling fla
 		case value.Kind() == reflect.Pointer:
 			valueStr = fmt.Sprintf("%v", value.Elem().Interface())
 
+		case value.Kind() == reflect.Struct:
+			valueStr = fmt.Sprintf("%v", secret.Redact(value.Interface()))
+
 		default:
 			valueStr = fmt.Sprintf("%v", value.Interface())
 		}
@@ -94,6 +103,45 @@ func notificationIDMap(ids []int64) map[string]bool {
 	return m
 }
 
+// secretJSONNames returns the JSON names of the secret fields of all known
+// monitor types, see Types. It is used to redact raw monitor data, whose
+// type is not known at compile time.
+func secretJSONNames() map[string]bool {
+	types := Types()
+
+	values := make([]any, 0, len(types))
+	for _, t := range types {
+		values = append(values, t)
+	}
+
+	return secret.JSONNames(values...)
+}
+
+// redactRaw returns a copy of the raw JSON object data, where the values of
+// all secret fields are masked. If data is not a valid JSON object, nil is
+// returned.
+func redactRaw(data []byte) []byte {
+	if data == nil {
+		return nil
+	}
+
+	raw := map[string]any{}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil
+	}
+
+	secret.RedactMap(raw, secretJSONNames())
+
+	redacted, err := json.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+
+	return redacted
+}
+
 // orderedByKey returns an iterator over a map's key-value pairs in sorted order.
 // Keys must be of a comparable ordered type.
 func orderedByKey[K cmp.Ordered, E any](m map[K]E) iter.Seq2[K, E] {
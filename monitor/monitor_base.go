@@ -46,6 +46,14 @@ func (b Base) String() string {
 	return formatMonitor(b, true)
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+// This includes the secret values contained in the raw monitor data.
+func (b Base) Redacted() Base {
+	b.raw = redactRaw(b.raw)
+
+	return b
+}
+
 // UnmarshalJSON unmarshals a monitor from JSON data.
 func (b *Base) UnmarshalJSON(data []byte) error {
 	raw := struct {
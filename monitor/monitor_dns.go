@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // DNS represents a DNS monitor.
@@ -23,6 +25,13 @@ func (d DNS) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(d.Base, false), formatMonitor(d.DNSDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (d DNS) Redacted() DNS {
+	d.Base = d.Base.Redacted()
+
+	return secret.Redact(d)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a DNS monitor.
 func (d *DNS) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Docker represents a Docker container monitor.
@@ -22,6 +24,13 @@ func (d Docker) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(d.Base, false), formatMonitor(d.DockerDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (d Docker) Redacted() Docker {
+	d.Base = d.Base.Redacted()
+
+	return secret.Redact(d)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a Docker monitor.
 func (d *Docker) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // GameDig represents a GameDig monitor that checks the status of game servers using the GameDig protocol.
@@ -22,6 +24,13 @@ func (g GameDig) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(g.Base, false), formatMonitor(g.GameDigDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (g GameDig) Redacted() GameDig {
+	g.Base = g.Base.Redacted()
+
+	return secret.Redact(g)
+}
+
 // UnmarshalJSON unmarshals a GameDig monitor from JSON data.
 func (g *GameDig) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -107,7 +116,7 @@ type GameDigDetails struct {
 	// GameDigGivenPortOnly indicates whether to use only the given port without auto-detection.
 	GameDigGivenPortOnly bool `json:"gamedigGivenPortOnly"`
 	// GameDigToken is an optional authentication token for game servers that require it.
-	GameDigToken *string `json:"gamedigToken,omitempty"`
+	GameDigToken *string `json:"gamedigToken,omitempty" kuma:"secret"`
 }
 
 // Type returns the monitor type string.
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Globalping represents a globalping monitor.
@@ -28,6 +30,13 @@ func (g Globalping) String() string {
 	)
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (g Globalping) Redacted() Globalping {
+	g.Base = g.Base.Redacted()
+
+	return secret.Redact(g)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a monitor.
 func (g *Globalping) UnmarshalJSON(data []byte) error {
 	base := Base{}
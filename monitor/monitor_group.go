@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Group represents a group monitor.
@@ -22,6 +24,13 @@ func (g Group) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(g.Base, false), formatMonitor(g.GroupDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (g Group) Redacted() Group {
+	g.Base = g.Base.Redacted()
+
+	return secret.Redact(g)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a monitor.
 func (g *Group) UnmarshalJSON(data []byte) error {
 	base := Base{}
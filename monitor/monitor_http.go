@@ -3,6 +3,8 @@ package monitor
 import (
 	"encoding/json"
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // HTTP represents a http monitor.
@@ -21,6 +23,13 @@ func (h HTTP) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(h.Base, false), formatMonitor(h.HTTPDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (h HTTP) Redacted() HTTP {
+	h.Base = h.Base.Redacted()
+
+	return secret.Redact(h)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a monitor.
 func (h *HTTP) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -113,20 +122,20 @@ type HTTPDetails struct {
 	Method              string     `json:"method"`
 	HTTPBodyEncoding    string     `json:"httpBodyEncoding"`
 	Body                string     `json:"body"`
-	Headers             string     `json:"headers"`
+	Headers             string     `json:"headers" kuma:"secret"`
 	AuthMethod          AuthMethod `json:"authMethod"`
-	BearerToken         string     `json:"bearer_token"`
+	BearerToken         string     `json:"bearer_token" kuma:"secret"`
 	BasicAuthUser       string     `json:"basic_auth_user"`
-	BasicAuthPass       string     `json:"basic_auth_pass"`
+	BasicAuthPass       string     `json:"basic_auth_pass" kuma:"secret"`
 	AuthDomain          string     `json:"authDomain"`
 	AuthWorkstation     string     `json:"authWorkstation"`
 	TLSCert             string     `json:"tlsCert"`
-	TLSKey              string     `json:"tlsKey"`
+	TLSKey              string     `json:"tlsKey" kuma:"secret"`
 	TLSCa               string     `json:"tlsCa"`
 	OAuthAuthMethod     string     `json:"oauth_auth_method"`
 	OAuthTokenURL       string     `json:"oauth_token_url"`
 	OAuthClientID       string     `json:"oauth_client_id"`
-	OAuthClientSecret   string     `json:"oauth_client_secret"`
+	OAuthClientSecret   string     `json:"oauth_client_secret" kuma:"secret"`
 	OAuthScopes         string     `json:"oauth_scopes"`
 	OAuthAudience       string     `json:"oauth_audience"`
 	CacheBust           bool       `json:"cacheBust"`
@@ -3,6 +3,8 @@ package monitor
 import (
 	"encoding/json"
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // HTTPJSONQuery represents a httpjsonquery monitor.
@@ -27,6 +29,13 @@ func (h HTTPJSONQuery) String() string {
 	)
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (h HTTPJSONQuery) Redacted() HTTPJSONQuery {
+	h.Base = h.Base.Redacted()
+
+	return secret.Redact(h)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a monitor.
 func (h *HTTPJSONQuery) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // KafkaProducer represents a Kafka Producer monitor for testing Kafka connectivity.
@@ -22,6 +24,13 @@ func (k KafkaProducer) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(k.Base, false), formatMonitor(k.KafkaProducerDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (k KafkaProducer) Redacted() KafkaProducer {
+	k.Base = k.Base.Redacted()
+
+	return secret.Redact(k)
+}
+
 // UnmarshalJSON unmarshals a KafkaProducer monitor from JSON data.
 func (k *KafkaProducer) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -104,7 +113,7 @@ type KafkaProducerDetails struct {
 	// AllowAutoTopicCreation allows automatic topic creation.
 	AllowAutoTopicCreation bool `json:"kafkaProducerAllowAutoTopicCreation"`
 	// SASLOptions is an optional map containing string with SASL configuration.
-	SASLOptions *map[string]any `json:"kafkaProducerSaslOptions"`
+	SASLOptions *map[string]any `json:"kafkaProducerSaslOptions" kuma:"secret"`
 }
 
 // Type returns the monitor type.
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // MongoDB represents a MongoDB monitor for testing database connectivity and commands.
@@ -22,6 +24,13 @@ func (m MongoDB) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(m.Base, false), formatMonitor(m.MongoDBDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (m MongoDB) Redacted() MongoDB {
+	m.Base = m.Base.Redacted()
+
+	return secret.Redact(m)
+}
+
 // UnmarshalJSON unmarshals a MongoDB monitor from JSON data.
 func (m *MongoDB) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -92,7 +101,7 @@ func (m MongoDB) MarshalJSON() ([]byte, error) {
 // MongoDBDetails contains MongoDB-specific monitor configuration.
 type MongoDBDetails struct {
 	// DatabaseConnectionString is the MongoDB connection string.
-	DatabaseConnectionString string `json:"databaseConnectionString"`
+	DatabaseConnectionString string `json:"databaseConnectionString" kuma:"secret"`
 	// DatabaseQuery is an optional MongoDB command as JSON (default: {ping: 1}).
 	DatabaseQuery *string `json:"databaseQuery"`
 	// JSONPath is an optional JSONata expression for result validation.
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // MQTT represents an MQTT monitor that checks the status of MQTT brokers and topics.
@@ -22,6 +24,13 @@ func (m MQTT) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(m.Base, false), formatMonitor(m.MQTTDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (m MQTT) Redacted() MQTT {
+	m.Base = m.Base.Redacted()
+
+	return secret.Redact(m)
+}
+
 // UnmarshalJSON unmarshals an MQTT monitor from JSON data.
 func (m *MQTT) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -106,7 +115,7 @@ type MQTTDetails struct {
 	// MQTTUsername is the optional username for MQTT authentication.
 	MQTTUsername *string `json:"mqttUsername"`
 	// MQTTPassword is the optional password for MQTT authentication.
-	MQTTPassword *string `json:"mqttPassword"`
+	MQTTPassword *string `json:"mqttPassword" kuma:"secret"`
 	// MQTTWebsocketPath is the optional WebSocket path for WebSocket connections.
 	MQTTWebsocketPath *string `json:"mqttWebsocketPath"`
 	// MQTTCheckType is the check type: keyword or json-query.
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // MySQL represents a MySQL/MariaDB monitor for testing database connectivity and queries.
@@ -22,6 +24,13 @@ func (m MySQL) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(m.Base, false), formatMonitor(m.MySQLDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (m MySQL) Redacted() MySQL {
+	m.Base = m.Base.Redacted()
+
+	return secret.Redact(m)
+}
+
 // UnmarshalJSON unmarshals a MySQL monitor from JSON data.
 func (m *MySQL) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -90,7 +99,7 @@ func (m MySQL) MarshalJSON() ([]byte, error) {
 // MySQLDetails contains MySQL-specific monitor configuration.
 type MySQLDetails struct {
 	// DatabaseConnectionString is the MySQL connection string.
-	DatabaseConnectionString string `json:"databaseConnectionString"`
+	DatabaseConnectionString string `json:"databaseConnectionString" kuma:"secret"`
 	// DatabaseQuery is an optional SQL query to execute (default: SELECT 1).
 	DatabaseQuery *string `json:"databaseQuery"`
 	// Conditions is an optional list of assertion clauses evaluated against the
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // OracleDB represents an OracleDB monitor for testing Oracle Database connectivity and queries.
@@ -22,6 +24,13 @@ func (o OracleDB) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(o.Base, false), formatMonitor(o.OracleDBDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (o OracleDB) Redacted() OracleDB {
+	o.Base = o.Base.Redacted()
+
+	return secret.Redact(o)
+}
+
 // UnmarshalJSON unmarshals an OracleDB monitor from JSON data.
 func (o *OracleDB) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -92,13 +101,13 @@ func (o OracleDB) MarshalJSON() ([]byte, error) {
 // OracleDBDetails contains OracleDB-specific monitor configuration.
 type OracleDBDetails struct {
 	// DatabaseConnectionString is the Oracle EZCONNECT string, e.g. host:port/service.
-	DatabaseConnectionString string `json:"databaseConnectionString"`
+	DatabaseConnectionString string `json:"databaseConnectionString" kuma:"secret"`
 	// DatabaseQuery is an optional SQL query to execute (default: SELECT 1 FROM DUAL).
 	DatabaseQuery *string `json:"databaseQuery"`
 	// Username is the Oracle Database user.
 	Username string `json:"basic_auth_user"`
 	// Password is the Oracle Database password.
-	Password string `json:"basic_auth_pass"`
+	Password string `json:"basic_auth_pass" kuma:"secret"`
 	// Conditions is an optional list of assertion clauses evaluated against the
 	// query result. When set, the query is expected to return a single value
 	// that is matched against the conditions.
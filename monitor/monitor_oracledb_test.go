@@ -180,10 +180,10 @@ func TestMonitorOracleDB_String(t *testing.T) {
 				Password:                 "secret",
 			},
 			wantContains: []string{
-				`databaseConnectionString: "localhost:1521/XEPDB1"`,
+				`databaseConnectionString: "***"`,
 				`databaseQuery: "SELECT 1 FROM DUAL"`,
 				`basic_auth_user: "oracle"`,
-				`basic_auth_pass: "secret"`,
+				`basic_auth_pass: "***"`,
 			},
 			wantNotContains: []string{"0x"},
 		},
@@ -196,10 +196,10 @@ func TestMonitorOracleDB_String(t *testing.T) {
 				Password:                 "secret",
 			},
 			wantContains: []string{
-				`databaseConnectionString: "localhost:1521/XEPDB1"`,
+				`databaseConnectionString: "***"`,
 				"databaseQuery: <nil>",
 				`basic_auth_user: "oracle"`,
-				`basic_auth_pass: "secret"`,
+				`basic_auth_pass: "***"`,
 			},
 			wantNotContains: []string{"0x"},
 		},
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Ping represents a ping monitor.
@@ -22,6 +24,13 @@ func (p Ping) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(p.Base, false), formatMonitor(p.PingDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (p Ping) Redacted() Ping {
+	p.Base = p.Base.Redacted()
+
+	return secret.Redact(p)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a monitor.
 func (p *Ping) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Postgres represents a postgres monitor.
@@ -22,6 +24,13 @@ func (p Postgres) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(p.Base, false), formatMonitor(p.PostgresDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (p Postgres) Redacted() Postgres {
+	p.Base = p.Base.Redacted()
+
+	return secret.Redact(p)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a monitor.
 func (p *Postgres) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -90,7 +99,7 @@ func (p Postgres) MarshalJSON() ([]byte, error) {
 // PostgresDetails contains postgres-specific monitor configuration.
 type PostgresDetails struct {
 	// DatabaseConnectionString is the PostgreSQL connection string.
-	DatabaseConnectionString string `json:"databaseConnectionString"`
+	DatabaseConnectionString string `json:"databaseConnectionString" kuma:"secret"`
 	// DatabaseQuery is an optional SQL query to execute (default: SELECT 1).
 	DatabaseQuery *string `json:"databaseQuery"`
 	// Conditions is an optional list of assertion clauses evaluated against the
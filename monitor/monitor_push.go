@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Push represents a push monitor.
@@ -22,6 +24,13 @@ func (p Push) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(p.Base, false), formatMonitor(p.PushDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (p Push) Redacted() Push {
+	p.Base = p.Base.Redacted()
+
+	return secret.Redact(p)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a monitor.
 func (p *Push) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -88,7 +97,7 @@ func (p Push) MarshalJSON() ([]byte, error) {
 
 // PushDetails contains push-specific monitor configuration.
 type PushDetails struct {
-	PushToken string `json:"pushToken"`
+	PushToken string `json:"pushToken" kuma:"secret"`
 }
 
 // Type returns the monitor type.
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // RabbitMQ represents a RabbitMQ monitor for checking RabbitMQ cluster health.
@@ -22,6 +24,13 @@ func (r RabbitMQ) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(r.Base, false), formatMonitor(r.RabbitMQDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (r RabbitMQ) Redacted() RabbitMQ {
+	r.Base = r.Base.Redacted()
+
+	return secret.Redact(r)
+}
+
 // UnmarshalJSON unmarshals a RabbitMQ monitor from JSON data.
 func (r *RabbitMQ) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -96,7 +105,7 @@ type RabbitMQDetails struct {
 	// Username is an optional username for HTTP Basic authentication.
 	Username *string `json:"rabbitmqUsername"`
 	// Password is an optional password for HTTP Basic authentication.
-	Password *string `json:"rabbitmqPassword"`
+	Password *string `json:"rabbitmqPassword" kuma:"secret"`
 	// Timeout is an optional request timeout in seconds.
 	Timeout *int64 `json:"timeout"`
 }
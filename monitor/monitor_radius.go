@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Radius represents a Radius monitor for testing Radius server authentication.
@@ -22,6 +24,13 @@ func (r Radius) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(r.Base, false), formatMonitor(r.RadiusDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (r Radius) Redacted() Radius {
+	r.Base = r.Base.Redacted()
+
+	return secret.Redact(r)
+}
+
 // UnmarshalJSON unmarshals a Radius monitor from JSON data.
 func (r *Radius) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -101,9 +110,9 @@ type RadiusDetails struct {
 	// Username is the username for Radius authentication.
 	Username string `json:"radiusUsername"`
 	// Password is the password for Radius authentication.
-	Password string `json:"radiusPassword"`
+	Password string `json:"radiusPassword" kuma:"secret"`
 	// Secret is the shared secret for Radius server.
-	Secret string `json:"radiusSecret"`
+	Secret string `json:"radiusSecret" kuma:"secret"`
 	// CalledStationID is the optional Called-Station-ID attribute.
 	CalledStationID *string `json:"radiusCalledStationId"`
 	// CallingStationID is the optional Calling-Station-ID attribute.
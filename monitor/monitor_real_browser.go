@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // RealBrowser represents a realbrowser monitor.
@@ -22,6 +24,13 @@ func (r RealBrowser) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(r.Base, false), formatMonitor(r.RealBrowserDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (r RealBrowser) Redacted() RealBrowser {
+	r.Base = r.Base.Redacted()
+
+	return secret.Redact(r)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a monitor.
 func (r *RealBrowser) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Redis represents a redis monitor.
@@ -22,6 +24,13 @@ func (r Redis) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(r.Base, false), formatMonitor(r.RedisDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (r Redis) Redacted() Redis {
+	r.Base = r.Base.Redacted()
+
+	return secret.Redact(r)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a monitor.
 func (r *Redis) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -89,7 +98,7 @@ func (r Redis) MarshalJSON() ([]byte, error) {
 
 // RedisDetails contains redis-specific monitor configuration.
 type RedisDetails struct {
-	ConnectionString string `json:"databaseConnectionString"`
+	ConnectionString string `json:"databaseConnectionString" kuma:"secret"`
 	IgnoreTLS        bool   `json:"ignoreTls"`
 	// Conditions is an optional list of assertion clauses evaluated against the
 	// Redis command result.
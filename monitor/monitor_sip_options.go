@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SIPOptions represents a sip-options monitor.
@@ -22,6 +24,13 @@ func (s SIPOptions) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(s.Base, false), formatMonitor(s.SIPOptionsDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (s SIPOptions) Redacted() SIPOptions {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a monitor.
 func (s *SIPOptions) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SMTP represents an SMTP monitor for testing SMTP server connectivity.
@@ -22,6 +24,13 @@ func (s SMTP) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(s.Base, false), formatMonitor(s.SMTPDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (s SMTP) Redacted() SMTP {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals an SMTP monitor from JSON data.
 func (s *SMTP) UnmarshalJSON(data []byte) error {
 	base := Base{}
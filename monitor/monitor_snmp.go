@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SNMP represents a Simple Network Management Protocol monitor.
@@ -22,6 +24,13 @@ func (s SNMP) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(s.Base, false), formatMonitor(s.SNMPDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (s SNMP) Redacted() SNMP {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a monitor.
 func (s *SNMP) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -100,7 +109,7 @@ type SNMPDetails struct {
 	Port             *int64  `json:"port"`
 	SNMPVersion      string  `json:"snmpVersion"`
 	SNMPOID          string  `json:"snmpOid"`
-	SNMPCommunity    string  `json:"radiusPassword"`
+	SNMPCommunity    string  `json:"radiusPassword" kuma:"secret"`
 	SNMPV3Username   *string `json:"snmpV3Username"`
 	JSONPath         *string `json:"jsonPath"`
 	JSONPathOperator *string `json:"jsonPathOperator"`
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SQLServer represents a SQL Server monitor for testing SQL Server connectivity and queries.
@@ -22,6 +24,13 @@ func (s SQLServer) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(s.Base, false), formatMonitor(s.SQLServerDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (s SQLServer) Redacted() SQLServer {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals a SQL Server monitor from JSON data.
 func (s *SQLServer) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -90,7 +99,7 @@ func (s SQLServer) MarshalJSON() ([]byte, error) {
 // SQLServerDetails contains SQL Server-specific monitor configuration.
 type SQLServerDetails struct {
 	// DatabaseConnectionString is the SQL Server connection string.
-	DatabaseConnectionString string `json:"databaseConnectionString"`
+	DatabaseConnectionString string `json:"databaseConnectionString" kuma:"secret"`
 	// DatabaseQuery is an optional SQL query to execute (default: basic connection test).
 	DatabaseQuery *string `json:"databaseQuery"`
 	// Conditions is an optional list of assertion clauses evaluated against the
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Steam represents a Steam Game Server monitor that checks the status of a game server via Steam API.
@@ -22,6 +24,13 @@ func (s Steam) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(s.Base, false), formatMonitor(s.SteamDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (s Steam) Redacted() Steam {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals a Steam monitor from JSON data.
 func (s *Steam) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SystemService represents a system-service monitor.
@@ -22,6 +24,13 @@ func (s SystemService) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(s.Base, false), formatMonitor(s.SystemServiceDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (s SystemService) Redacted() SystemService {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a monitor.
 func (s *SystemService) UnmarshalJSON(data []byte) error {
 	base := Base{}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // TailscalePing represents a Tailscale Ping monitor for checking Tailscale node availability.
@@ -22,6 +24,13 @@ func (t TailscalePing) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(t.Base, false), formatMonitor(t.TailscalePingDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (t TailscalePing) Redacted() TailscalePing {
+	t.Base = t.Base.Redacted()
+
+	return secret.Redact(t)
+}
+
 // UnmarshalJSON unmarshals a Tailscale Ping monitor from JSON data.
 func (t *TailscalePing) UnmarshalJSON(data []byte) error {
 	base := Base{}
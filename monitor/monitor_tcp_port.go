@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // TCPPort represents a tcpport monitor.
@@ -22,6 +24,13 @@ func (t TCPPort) String() string {
 	return fmt.Sprintf("%s, %s", formatMonitor(t.Base, false), formatMonitor(t.TCPPortDetails, true))
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (t TCPPort) Redacted() TCPPort {
+	t.Base = t.Base.Redacted()
+
+	return secret.Redact(t)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a monitor.
 func (t *TCPPort) UnmarshalJSON(data []byte) error {
 	base := Base{}
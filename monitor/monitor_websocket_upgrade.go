@@ -3,6 +3,8 @@ package monitor
 import (
 	"encoding/json"
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // WebsocketUpgrade represents a websocket-upgrade monitor.
@@ -27,6 +29,13 @@ func (w WebsocketUpgrade) String() string {
 	)
 }
 
+// Redacted returns a copy of the monitor, where all secret values are masked.
+func (w WebsocketUpgrade) Redacted() WebsocketUpgrade {
+	w.Base = w.Base.Redacted()
+
+	return secret.Redact(w)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a monitor.
 func (w *WebsocketUpgrade) UnmarshalJSON(data []byte) error {
 	base := Base{}
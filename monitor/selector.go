@@ -0,0 +1,578 @@
+package monitor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Selector reports whether a monitor is selected.
+type Selector func(mon Base) bool
+
+// ParseSelector parses a selector expression.
+//
+// An expression consists of comparisons of the form <field><op><value>,
+// which can be combined with "and", "or", "not" (or "&&", "||", "!") and
+// parentheses. Comparisons separated by whitespace only are combined with
+// "and". The operators are "=" (equal), "!=" (not equal), "~" (glob match
+// with "*" and "?") and "!~" (no glob match). Values containing whitespace or
+// special characters need to be quoted with double quotes.
+//
+// The following fields are supported:
+//   - id: the monitor ID.
+//   - name: the monitor name.
+//   - type: the monitor type, e.g. "http".
+//   - parent: the ID of the parent group, "none" for monitors without parent.
+//   - active: "true" or "false".
+//   - path: the path name, e.g. "group / monitor".
+//   - description: the description.
+//   - tag:<name>: the value of the tag with the given name. Without operator
+//     and value, the comparison matches monitors with the tag. The tag name
+//     may be quoted, e.g. tag:"my tag".
+//
+// Other fields are looked up in the raw monitor data of monitors received
+// from the server, e.g. url or hostname. The names of the built-in fields are
+// case insensitive, the names of other fields are case sensitive, e.g.
+// retryInterval. An empty expression selects all monitors.
+//
+// Examples:
+//
+//	type=http and tag:env=prod
+//	name~"api-*" or parent=12
+//	not active=true
+//	(type=http or type=keyword) tag:critical
+func ParseSelector(expr string) (Selector, error) {
+	tokens, err := lexSelector(expr)
+	if err != nil {
+		return nil, fmt.Errorf("parse selector %q: %w", expr, err)
+	}
+
+	if len(tokens) == 0 {
+		return func(Base) bool { return true }, nil
+	}
+
+	p := &selectorParser{tokens: tokens}
+
+	sel, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("parse selector %q: %w", expr, err)
+	}
+
+	if !p.done() {
+		return nil, fmt.Errorf("parse selector %q: unexpected %s at position %d", expr, p.peek(), p.peek().pos)
+	}
+
+	return func(mon Base) bool {
+		return sel(&selectorMonitor{Base: mon})
+	}, nil
+}
+
+// selectorFunc reports whether a monitor is selected by a part of a selector
+// expression.
+type selectorFunc func(mon *selectorMonitor) bool
+
+// selectorMonitor is the monitor, which is evaluated by a selector. The raw
+// monitor data is decoded at most once for all comparisons of the selector.
+type selectorMonitor struct {
+	Base
+
+	rawFields map[string]any
+	decoded   bool
+}
+
+// MustParseSelector is like ParseSelector but panics, if the expression
+// can not be parsed.
+func MustParseSelector(expr string) Selector {
+	sel, err := ParseSelector(expr)
+	if err != nil {
+		panic(err)
+	}
+
+	return sel
+}
+
+type selectorTokenKind int
+
+const (
+	selectorWord selectorTokenKind = iota
+	selectorString
+	selectorOperator
+	selectorLParen
+	selectorRParen
+	selectorAnd
+	selectorOr
+	selectorNot
+)
+
+type selectorToken struct {
+	kind  selectorTokenKind
+	value string
+	pos   int
+}
+
+func (t selectorToken) String() string {
+	return strconv.Quote(t.value)
+}
+
+// isSelectorSpecial reports whether r terminates a word.
+func isSelectorSpecial(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune(`()=!~"&|`, r)
+}
+
+func lexSelector(expr string) ([]selectorToken, error) {
+	var tokens []selectorToken
+
+	runes := []rune(expr)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(':
+			tokens = append(tokens, selectorToken{kind: selectorLParen, value: "(", pos: i})
+			i++
+
+		case r == ')':
+			tokens = append(tokens, selectorToken{kind: selectorRParen, value: ")", pos: i})
+			i++
+
+		case r == '=' || r == '~':
+			tokens = append(tokens, selectorToken{kind: selectorOperator, value: string(r), pos: i})
+			i++
+
+		case r == '!':
+			if i+1 < len(runes) && (runes[i+1] == '=' || runes[i+1] == '~') {
+				tokens = append(tokens, selectorToken{kind: selectorOperator, value: string(runes[i : i+2]), pos: i})
+				i += 2
+
+				continue
+			}
+
+			tokens = append(tokens, selectorToken{kind: selectorNot, value: "!", pos: i})
+			i++
+
+		case r == '&' || r == '|':
+			if i+1 >= len(runes) || runes[i+1] != r {
+				return nil, fmt.Errorf("unexpected %q at position %d", r, i)
+			}
+
+			kind := selectorAnd
+			if r == '|' {
+				kind = selectorOr
+			}
+
+			tokens = append(tokens, selectorToken{kind: kind, value: string(runes[i : i+2]), pos: i})
+			i += 2
+
+		case r == '"':
+			start := i
+
+			var b strings.Builder
+
+			i++
+
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+
+				b.WriteRune(runes[i])
+			}
+
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %d", start)
+			}
+
+			i++
+
+			tokens = append(tokens, selectorToken{kind: selectorString, value: b.String(), pos: start})
+
+		default:
+			start := i
+			for i < len(runes) && !isSelectorSpecial(runes[i]) {
+				i++
+			}
+
+			word := string(runes[start:i])
+
+			kind := selectorWord
+
+			switch strings.ToLower(word) {
+			case "and":
+				kind = selectorAnd
+
+			case "or":
+				kind = selectorOr
+
+			case "not":
+				kind = selectorNot
+
+			default:
+			}
+
+			tokens = append(tokens, selectorToken{kind: kind, value: word, pos: start})
+		}
+	}
+
+	return tokens, nil
+}
+
+type selectorParser struct {
+	tokens []selectorToken
+	pos    int
+}
+
+func (p *selectorParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *selectorParser) peek() selectorToken {
+	return p.tokens[p.pos]
+}
+
+func (p *selectorParser) next() selectorToken {
+	t := p.tokens[p.pos]
+	p.pos++
+
+	return t
+}
+
+func (p *selectorParser) errUnexpected() error {
+	if p.done() {
+		return errors.New("unexpected end of expression")
+	}
+
+	return fmt.Errorf("unexpected %s at position %d", p.peek(), p.peek().pos)
+}
+
+func (p *selectorParser) parseOr() (selectorFunc, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for !p.done() && p.peek().kind == selectorOr {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		l := left
+		left = func(mon *selectorMonitor) bool {
+			return l(mon) || right(mon)
+		}
+	}
+
+	return left, nil
+}
+
+func (p *selectorParser) parseAnd() (selectorFunc, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for !p.done() {
+		kind := p.peek().kind
+
+		// Terms separated by whitespace only are combined with "and".
+		if kind == selectorAnd {
+			p.next()
+		} else if kind != selectorWord && kind != selectorNot && kind != selectorLParen {
+			break
+		}
+
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		l := left
+		left = func(mon *selectorMonitor) bool {
+			return l(mon) && right(mon)
+		}
+	}
+
+	return left, nil
+}
+
+func (p *selectorParser) parseNot() (selectorFunc, error) {
+	if !p.done() && p.peek().kind == selectorNot {
+		p.next()
+
+		sel, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(mon *selectorMonitor) bool {
+			return !sel(mon)
+		}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *selectorParser) parsePrimary() (selectorFunc, error) {
+	if p.done() {
+		return nil, p.errUnexpected()
+	}
+
+	if p.peek().kind == selectorLParen {
+		p.next()
+
+		sel, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.done() || p.peek().kind != selectorRParen {
+			return nil, p.errUnexpected()
+		}
+
+		p.next()
+
+		return sel, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *selectorParser) parseComparison() (selectorFunc, error) {
+	if p.peek().kind != selectorWord {
+		return nil, p.errUnexpected()
+	}
+
+	fieldToken := p.next()
+	field := fieldToken.value
+
+	tagName, isTag := strings.CutPrefix(field, "tag:")
+	if isTag && tagName == "" && !p.done() && p.peek().kind == selectorString {
+		tagName = p.next().value
+	}
+
+	if isTag && tagName == "" {
+		return nil, fmt.Errorf("missing tag name at position %d", fieldToken.pos)
+	}
+
+	if p.done() || p.peek().kind != selectorOperator {
+		if isTag {
+			return func(mon *selectorMonitor) bool {
+				_, ok := tagValue(mon.Base, tagName)
+				return ok
+			}, nil
+		}
+
+		return nil, fmt.Errorf("missing operator after %q at position %d", field, fieldToken.pos)
+	}
+
+	op := p.next().value
+
+	if p.done() || (p.peek().kind != selectorWord && p.peek().kind != selectorString) {
+		return nil, p.errUnexpected()
+	}
+
+	value := p.next().value
+
+	match, err := selectorMatcher(op, value)
+	if err != nil {
+		return nil, fmt.Errorf("field %q: %w", field, err)
+	}
+
+	if isTag {
+		return func(mon *selectorMonitor) bool {
+			v, ok := tagValue(mon.Base, tagName)
+			return match(v, ok)
+		}, nil
+	}
+
+	get, err := selectorField(field, value, op)
+	if err != nil {
+		return nil, fmt.Errorf("field %q: %w", field, err)
+	}
+
+	return func(mon *selectorMonitor) bool {
+		v, ok := get(mon)
+		return match(v, ok)
+	}, nil
+}
+
+// selectorMatcher returns a function, which compares a field value with the
+// value of the comparison. ok is false, if the field is not present.
+func selectorMatcher(op string, value string) (func(v string, ok bool) bool, error) {
+	switch op {
+	case "=":
+		return func(v string, ok bool) bool { return ok && v == value }, nil
+
+	case "!=":
+		return func(v string, ok bool) bool { return !ok || v != value }, nil
+
+	case "~", "!~":
+		re, err := globRegexp(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if op == "!~" {
+			return func(v string, ok bool) bool { return !ok || !re.MatchString(v) }, nil
+		}
+
+		return func(v string, ok bool) bool { return ok && re.MatchString(v) }, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported operator %q", op)
+	}
+}
+
+// selectorField returns a function, which returns the value of the field of
+// a monitor as string. value and op are used to validate the comparison.
+func selectorField(field string, value string, op string) (func(mon *selectorMonitor) (string, bool), error) {
+	exact := op == "=" || op == "!="
+
+	// The names of the built-in fields are case insensitive, the names of the
+	// raw fields are case sensitive, e.g. maxRetries.
+	switch strings.ToLower(field) {
+	case "id":
+		if exact {
+			_, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ID %q", value)
+			}
+		}
+
+		return func(mon *selectorMonitor) (string, bool) {
+			return strconv.FormatInt(mon.ID, 10), true
+		}, nil
+
+	case "name":
+		return func(mon *selectorMonitor) (string, bool) {
+			return mon.Name, true
+		}, nil
+
+	case "type":
+		return func(mon *selectorMonitor) (string, bool) {
+			return mon.Type(), mon.Type() != ""
+		}, nil
+
+	case "parent":
+		if exact && value != "none" {
+			_, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid parent ID %q, expected ID or \"none\"", value)
+			}
+		}
+
+		return func(mon *selectorMonitor) (string, bool) {
+			if mon.Parent == nil {
+				return "none", true
+			}
+
+			return strconv.FormatInt(*mon.Parent, 10), true
+		}, nil
+
+	case "active":
+		if exact && value != "true" && value != "false" {
+			return nil, fmt.Errorf("invalid value %q, expected \"true\" or \"false\"", value)
+		}
+
+		return func(mon *selectorMonitor) (string, bool) {
+			return strconv.FormatBool(mon.IsActive), true
+		}, nil
+
+	case "path":
+		return func(mon *selectorMonitor) (string, bool) {
+			return mon.PathName, true
+		}, nil
+
+	case "description":
+		return func(mon *selectorMonitor) (string, bool) {
+			if mon.Description == nil {
+				return "", false
+			}
+
+			return *mon.Description, true
+		}, nil
+
+	default:
+		return func(mon *selectorMonitor) (string, bool) {
+			return mon.rawValue(field)
+		}, nil
+	}
+}
+
+// tagValue returns the value of the tag with the given name. ok is false, if
+// the monitor does not have the tag.
+func tagValue(mon Base, name string) (string, bool) {
+	for _, t := range mon.Tags {
+		if t.Name == name {
+			return t.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// rawValue returns the value of the field in the raw monitor data as string.
+// Objects, arrays and null values are not supported.
+func (mon *selectorMonitor) rawValue(field string) (string, bool) {
+	if !mon.decoded {
+		mon.decoded = true
+
+		if mon.raw != nil {
+			_ = json.Unmarshal(mon.raw, &mon.rawFields)
+		}
+	}
+
+	switch v := mon.rawFields[field].(type) {
+	case string:
+		return v, true
+
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+
+	case bool:
+		return strconv.FormatBool(v), true
+
+	default:
+		return "", false
+	}
+}
+
+// globRegexp converts a glob pattern with "*" and "?" to an anchored regular
+// expression.
+func globRegexp(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+
+	b.WriteString("(?s)^")
+
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+
+		case '?':
+			b.WriteString(".")
+
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	b.WriteString("$")
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+
+	return re, nil
+}
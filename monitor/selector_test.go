@@ -0,0 +1,154 @@
+package monitor_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func TestParseSelector(t *testing.T) {
+	var monitors []monitor.Base
+
+	err := json.Unmarshal([]byte(`[
+		{"id":1,"name":"group","type":"group","parent":null,"active":true,"tags":[]},
+		{"id":2,"name":"api-users","type":"http","parent":1,"active":true,"url":"https://api.example.com/users","retryInterval":30,"tags":[{"name":"env","value":"prod"},{"name":"critical","value":""}]},
+		{"id":3,"name":"api-orders","type":"http","parent":1,"active":false,"url":"https://api.example.com/orders","retryInterval":60,"tags":[{"name":"env","value":"staging"}]},
+		{"id":4,"name":"db primary","type":"postgres","parent":null,"active":true,"description":"Primary \"main\" database","tags":[{"name":"my tag","value":"x"}]},
+		{"id":5,"name":"web","type":"keyword","parent":null,"active":true,"url":"https://www.example.com","tags":[{"name":"env","value":"prod"}]}
+	]`), &monitors)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		expr string
+
+		want    []int64
+		wantErr string
+	}{
+		{name: "empty selects all", expr: "  ", want: []int64{1, 2, 3, 4, 5}},
+		{name: "type", expr: "type=http", want: []int64{2, 3}},
+		{name: "type not equal", expr: "type!=http", want: []int64{1, 4, 5}},
+		{name: "tag value", expr: "tag:env=prod", want: []int64{2, 5}},
+		{name: "tag exists", expr: "tag:critical", want: []int64{2}},
+		{name: "quoted tag name", expr: `tag:"my tag"=x`, want: []int64{4}},
+		{name: "name glob", expr: `name~"api-*"`, want: []int64{2, 3}},
+		{name: "name no glob match", expr: `name!~api-*`, want: []int64{1, 4, 5}},
+		{name: "quoted name with space", expr: `name="db primary"`, want: []int64{4}},
+		{name: "parent", expr: "parent=1", want: []int64{2, 3}},
+		{name: "no parent", expr: "parent=none", want: []int64{1, 4, 5}},
+		{name: "active", expr: "active=false", want: []int64{3}},
+		{name: "id", expr: "id=4", want: []int64{4}},
+		{name: "description", expr: "description~*database", want: []int64{4}},
+		{name: "raw field", expr: `url~"https://api.example.com/*"`, want: []int64{2, 3}},
+		{name: "raw field camel case", expr: "retryInterval=60", want: []int64{3}},
+		{name: "raw field case sensitive", expr: "retryinterval=60", want: nil},
+		{name: "raw and built-in field", expr: "Type=http retryInterval!=60", want: []int64{2}},
+		{name: "and", expr: "type=http and tag:env=prod", want: []int64{2}},
+		{name: "implicit and", expr: "type=http active=true", want: []int64{2}},
+		{name: "or", expr: "type=postgres or type=keyword", want: []int64{4, 5}},
+		{name: "not", expr: "not active=true", want: []int64{3}},
+		{name: "symbolic operators", expr: "!(type=http || type=group) && tag:env=prod", want: []int64{5}},
+		{name: "precedence", expr: "type=group or type=http and active=false", want: []int64{1, 3}},
+		{name: "parentheses", expr: "(type=group or type=http) and active=true", want: []int64{1, 2}},
+		{name: "keywords case insensitive", expr: "type=group OR NOT active=true", want: []int64{1, 3}},
+
+		// Precedence and parentheses.
+		{name: "and before or", expr: "type=http and active=false or type=group", want: []int64{1, 3}},
+		{name: "implicit and before or", expr: "type=group or type=http active=false", want: []int64{1, 3}},
+		{name: "or is left associative", expr: "type=group or type=postgres or type=keyword", want: []int64{1, 4, 5}},
+		{name: "nested parentheses", expr: "((type=http))", want: []int64{2, 3}},
+		{name: "parentheses change precedence", expr: "type=http and (active=false or tag:critical)", want: []int64{2, 3}},
+		{name: "parentheses without space", expr: "(type=group)or(type=keyword)", want: []int64{1, 5}},
+
+		// Negation.
+		{name: "not parentheses", expr: "not (type=http or type=group)", want: []int64{4, 5}},
+		{name: "double not", expr: "not not active=false", want: []int64{3}},
+		{name: "bang", expr: "!active=true", want: []int64{3}},
+		{name: "double bang", expr: "!!active=false", want: []int64{3}},
+		{name: "not binds tighter than and", expr: "not type=http and active=true", want: []int64{1, 4, 5}},
+		{name: "not tag exists", expr: "not tag:env", want: []int64{1, 4}},
+		{name: "bang tag value", expr: "type=http && !tag:env=prod", want: []int64{3}},
+
+		// Quoted values.
+		{name: "quoted value with escaped quotes", expr: `description="Primary \"main\" database"`, want: []int64{4}},
+		{name: "quoted value with escaped character", expr: `name="db primar\y"`, want: []int64{4}},
+		{name: "quoted value with special characters", expr: `url="https://api.example.com/users"`, want: []int64{2}},
+		{name: "quoted tag value", expr: `tag:env="prod"`, want: []int64{2, 5}},
+		{name: "whitespace around operator", expr: `name = "db primary"`, want: []int64{4}},
+		{name: "quoted keyword as value", expr: `name="or"`, want: nil},
+
+		// Glob.
+		{name: "glob single character", expr: `name~"api-?????"`, want: []int64{2}},
+		{name: "glob all", expr: "name~*", want: []int64{1, 2, 3, 4, 5}},
+		{name: "glob case sensitive", expr: "name~API-*", want: nil},
+		{name: "glob literal dot", expr: `name~"db.primary"`, want: nil},
+		{name: "glob question mark for dot", expr: `url~"https://api?example?com/*"`, want: []int64{2, 3}},
+		{name: "glob tag value", expr: `tag:env~"*"`, want: []int64{2, 3, 5}},
+		{name: "no glob match tag value", expr: "tag:env!~prod", want: []int64{1, 3, 4}},
+		{name: "no glob match missing field", expr: "description!~*database", want: []int64{1, 2, 3, 5}},
+
+		// Parent.
+		{name: "with parent", expr: "parent!=none", want: []int64{2, 3}},
+		{name: "not parent", expr: "parent!=1", want: []int64{1, 4, 5}},
+		{name: "parent glob", expr: "parent~n*", want: []int64{1, 4, 5}},
+
+		// Field names.
+		{name: "built-in field case insensitive", expr: "NAME=web", want: []int64{5}},
+		{name: "raw field upper case", expr: "URL~*", want: nil},
+		{name: "raw field lower case", expr: "url~*", want: []int64{2, 3, 5}},
+		{name: "raw field case sensitive not equal", expr: "RetryInterval!=60", want: []int64{1, 2, 3, 4, 5}},
+		{name: "unknown field", expr: "unknown=x", want: nil},
+		{name: "unknown field not equal", expr: "unknown!=x", want: []int64{1, 2, 3, 4, 5}},
+
+		{name: "missing operator", expr: "type", wantErr: `parse selector "type": missing operator after "type" at position 0`},
+		{name: "missing value", expr: "type=", wantErr: `parse selector "type=": unexpected end of expression`},
+		{name: "missing tag name", expr: "tag:=x", wantErr: `parse selector "tag:=x": missing tag name at position 0`},
+		{name: "unbalanced parentheses", expr: "(type=http", wantErr: `parse selector "(type=http": unexpected end of expression`},
+		{name: "unexpected parenthesis", expr: "type=http)", wantErr: `parse selector "type=http)": unexpected ")" at position 9`},
+		{name: "unterminated string", expr: `name="api`, wantErr: `parse selector "name=\"api": unterminated string at position 5`},
+		{name: "single ampersand", expr: "type=http & active=true", wantErr: `parse selector "type=http & active=true": unexpected '&' at position 10`},
+		{name: "invalid active", expr: "active=yes", wantErr: `parse selector "active=yes": field "active": invalid value "yes", expected "true" or "false"`},
+		{name: "invalid parent", expr: "parent=abc", wantErr: `parse selector "parent=abc": field "parent": invalid parent ID "abc", expected ID or "none"`},
+		{name: "invalid id", expr: "id=abc", wantErr: `parse selector "id=abc": field "id": invalid ID "abc"`},
+		{name: "unterminated string after expression", expr: `type=http and name="api`, wantErr: `parse selector "type=http and name=\"api": unterminated string at position 19`},
+		{name: "unterminated escaped quote", expr: `name="api\"`, wantErr: `parse selector "name=\"api\\\"": unterminated string at position 5`},
+		{name: "unterminated tag name", expr: `tag:"my tag=x`, wantErr: `parse selector "tag:\"my tag=x": unterminated string at position 4`},
+		{name: "dangling and", expr: "type=http and", wantErr: `parse selector "type=http and": unexpected end of expression`},
+		{name: "dangling or", expr: "type=http ||", wantErr: `parse selector "type=http ||": unexpected end of expression`},
+		{name: "dangling not", expr: "type=http not", wantErr: `parse selector "type=http not": unexpected end of expression`},
+		{name: "leading or", expr: "or type=http", wantErr: `parse selector "or type=http": unexpected "or" at position 0`},
+		{name: "double and", expr: "type=http and and active=true", wantErr: `parse selector "type=http and and active=true": unexpected "and" at position 14`},
+		{name: "leading operator", expr: "=http", wantErr: `parse selector "=http": unexpected "=" at position 0`},
+		{name: "double operator", expr: "type==http", wantErr: `parse selector "type==http": unexpected "=" at position 5`},
+		{name: "operator without field", expr: "type=http !~x", wantErr: `parse selector "type=http !~x": unexpected "!~" at position 10`},
+		{name: "empty parentheses", expr: "()", wantErr: `parse selector "()": unexpected ")" at position 1`},
+		{name: "single pipe", expr: "type=http | active=true", wantErr: `parse selector "type=http | active=true": unexpected '|' at position 10`},
+		{name: "quoted field", expr: `"name"=x`, wantErr: `parse selector "\"name\"=x": unexpected "name" at position 0`},
+		{name: "unknown field without operator", expr: "unknown", wantErr: `parse selector "unknown": missing operator after "unknown" at position 0`},
+		{name: "position in runes", expr: "name=ä)", wantErr: `parse selector "name=ä)": unexpected ")" at position 6`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sel, err := monitor.ParseSelector(tc.expr)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			var got []int64
+			for _, mon := range monitors {
+				if sel(mon) {
+					got = append(got, mon.ID)
+				}
+			}
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
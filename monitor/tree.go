@@ -0,0 +1,224 @@
+package monitor
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// PathSeparator is the separator used by Uptime Kuma between the names of the
+// groups in the path name of a monitor.
+const PathSeparator = " / "
+
+// ErrCycle is returned if the parent relations of the monitors form a cycle.
+var ErrCycle = errors.New("cycle in monitor hierarchy")
+
+// TreeNode is a monitor in the monitor hierarchy together with its children.
+type TreeNode struct {
+	Monitor Base
+
+	// Parent is the node of the parent group, nil for top level monitors.
+	Parent *TreeNode
+
+	// Children are the child monitors of a group, ordered by name.
+	Children []*TreeNode
+
+	// Path contains the names of the parent groups starting from the top
+	// level and the name of the monitor itself as the last element.
+	Path []string
+}
+
+// PathName returns the path of the node in the same format as Uptime Kuma,
+// e.g. "region / service / check".
+func (n *TreeNode) PathName() string {
+	return strings.Join(n.Path, PathSeparator)
+}
+
+// Depth returns the depth of the node in the tree, top level monitors have
+// a depth of 0.
+func (n *TreeNode) Depth() int {
+	return len(n.Path) - 1
+}
+
+// All returns an iterator over the node and all its descendants in
+// depth-first pre-order.
+func (n *TreeNode) All() iter.Seq[*TreeNode] {
+	return func(yield func(*TreeNode) bool) {
+		n.walk(yield)
+	}
+}
+
+// Descendants returns an iterator over all descendants of the node in
+// depth-first pre-order, excluding the node itself.
+func (n *TreeNode) Descendants() iter.Seq[*TreeNode] {
+	return func(yield func(*TreeNode) bool) {
+		for _, child := range n.Children {
+			if !child.walk(yield) {
+				return
+			}
+		}
+	}
+}
+
+// PostOrder returns an iterator over the node and all its descendants, where
+// the children are visited before their parent. This is the order, in which
+// a group can be deleted.
+func (n *TreeNode) PostOrder() iter.Seq[*TreeNode] {
+	return func(yield func(*TreeNode) bool) {
+		n.walkPostOrder(yield)
+	}
+}
+
+func (n *TreeNode) walk(yield func(*TreeNode) bool) bool {
+	if !yield(n) {
+		return false
+	}
+
+	for _, child := range n.Children {
+		if !child.walk(yield) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (n *TreeNode) walkPostOrder(yield func(*TreeNode) bool) bool {
+	for _, child := range n.Children {
+		if !child.walkPostOrder(yield) {
+			return false
+		}
+	}
+
+	return yield(n)
+}
+
+// IsDescendantOf reports whether the node is a (transitive) child of the
+// node with the given ID.
+func (n *TreeNode) IsDescendantOf(id int64) bool {
+	for p := n.Parent; p != nil; p = p.Parent {
+		if p.Monitor.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Tree is the hierarchy of monitors formed by the parent relation of the
+// monitors.
+type Tree struct {
+	// Roots are the top level monitors, ordered by name.
+	Roots []*TreeNode
+
+	nodes map[int64]*TreeNode
+}
+
+// NewTree builds the monitor hierarchy from a flat list of monitors.
+// Monitors, which reference a parent not contained in the list, are treated
+// as top level monitors. If the parent relations form a cycle, ErrCycle is
+// returned.
+func NewTree(monitors []Base) (*Tree, error) {
+	t := &Tree{
+		nodes: make(map[int64]*TreeNode, len(monitors)),
+	}
+
+	for _, mon := range monitors {
+		t.nodes[mon.ID] = &TreeNode{Monitor: mon}
+	}
+
+	for _, mon := range monitors {
+		node := t.nodes[mon.ID]
+
+		parent, ok := t.parentNode(mon)
+		if !ok {
+			t.Roots = append(t.Roots, node)
+			continue
+		}
+
+		node.Parent = parent
+		parent.Children = append(parent.Children, node)
+	}
+
+	sortNodes(t.Roots)
+
+	visited := 0
+	for _, root := range t.Roots {
+		for node := range root.All() {
+			sortNodes(node.Children)
+
+			node.Path = []string{node.Monitor.Name}
+			if node.Parent != nil {
+				node.Path = append(slices.Clone(node.Parent.Path), node.Monitor.Name)
+			}
+
+			visited++
+		}
+	}
+
+	// Monitors, which are not reachable from a top level monitor, are part
+	// of a cycle.
+	if visited != len(t.nodes) {
+		var ids []int64
+		for id, node := range t.nodes {
+			if node.Path == nil {
+				ids = append(ids, id)
+			}
+		}
+
+		slices.Sort(ids)
+
+		return nil, fmt.Errorf("build monitor tree: monitors %v: %w", ids, ErrCycle)
+	}
+
+	return t, nil
+}
+
+// parentNode returns the node of the parent group of the monitor. A monitor,
+// which is its own parent, is linked to itself and is therefore detected as
+// part of a cycle.
+func (t *Tree) parentNode(mon Base) (*TreeNode, bool) {
+	if mon.Parent == nil || *mon.Parent == 0 {
+		return nil, false
+	}
+
+	parent, ok := t.nodes[*mon.Parent]
+
+	return parent, ok
+}
+
+// Node returns the node of the monitor with the given ID.
+func (t *Tree) Node(id int64) (*TreeNode, bool) {
+	node, ok := t.nodes[id]
+
+	return node, ok
+}
+
+// Len returns the number of monitors in the tree.
+func (t *Tree) Len() int {
+	return len(t.nodes)
+}
+
+// All returns an iterator over all nodes of the tree in depth-first
+// pre-order.
+func (t *Tree) All() iter.Seq[*TreeNode] {
+	return func(yield func(*TreeNode) bool) {
+		for _, root := range t.Roots {
+			if !root.walk(yield) {
+				return
+			}
+		}
+	}
+}
+
+func sortNodes(nodes []*TreeNode) {
+	slices.SortFunc(nodes, func(a, b *TreeNode) int {
+		return cmp.Or(
+			cmp.Compare(a.Monitor.Name, b.Monitor.Name),
+			cmp.Compare(a.Monitor.ID, b.Monitor.ID),
+		)
+	})
+}
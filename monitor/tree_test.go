@@ -0,0 +1,114 @@
+package monitor_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func TestNewTree(t *testing.T) {
+	var monitors []monitor.Base
+
+	err := json.Unmarshal([]byte(`[
+		{"id":1,"name":"eu","type":"group","parent":null},
+		{"id":2,"name":"api","type":"group","parent":1},
+		{"id":3,"name":"users","type":"http","parent":2},
+		{"id":4,"name":"orders","type":"http","parent":2},
+		{"id":5,"name":"db","type":"postgres","parent":1},
+		{"id":6,"name":"standalone","type":"ping","parent":null},
+		{"id":7,"name":"orphan","type":"ping","parent":42}
+	]`), &monitors)
+	require.NoError(t, err)
+
+	tree, err := monitor.NewTree(monitors)
+	require.NoError(t, err)
+	require.Equal(t, 7, tree.Len())
+
+	var preOrder []int64
+	for node := range tree.All() {
+		preOrder = append(preOrder, node.Monitor.ID)
+	}
+
+	require.Equal(t, []int64{1, 2, 4, 3, 5, 7, 6}, preOrder)
+
+	node, ok := tree.Node(3)
+	require.True(t, ok)
+	require.Equal(t, "eu / api / users", node.PathName())
+	require.Equal(t, 2, node.Depth())
+	require.True(t, node.IsDescendantOf(1))
+	require.False(t, node.IsDescendantOf(5))
+
+	group, ok := tree.Node(1)
+	require.True(t, ok)
+
+	var postOrder []int64
+	for node := range group.PostOrder() {
+		postOrder = append(postOrder, node.Monitor.ID)
+	}
+
+	require.Equal(t, []int64{4, 3, 2, 5, 1}, postOrder)
+
+	var descendants []int64
+	for node := range group.Descendants() {
+		descendants = append(descendants, node.Monitor.ID)
+	}
+
+	require.Equal(t, []int64{2, 4, 3, 5}, descendants)
+
+	// Stop early.
+	var first []int64
+	for node := range tree.All() {
+		first = append(first, node.Monitor.ID)
+		if len(first) == 2 {
+			break
+		}
+	}
+
+	require.Equal(t, []int64{1, 2}, first)
+
+	_, ok = tree.Node(99)
+	require.False(t, ok)
+}
+
+func TestNewTree_Cycle(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "self reference",
+			data: `[{"id":1,"name":"a","type":"group","parent":1}]`,
+		},
+		{
+			name: "two groups",
+			data: `[
+				{"id":1,"name":"root","type":"group","parent":null},
+				{"id":2,"name":"a","type":"group","parent":3},
+				{"id":3,"name":"b","type":"group","parent":2}
+			]`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var monitors []monitor.Base
+
+			err := json.Unmarshal([]byte(tc.data), &monitors)
+			require.NoError(t, err)
+
+			_, err = monitor.NewTree(monitors)
+			require.ErrorIs(t, err, monitor.ErrCycle)
+		})
+	}
+}
+
+func TestNewTree_Empty(t *testing.T) {
+	tree, err := monitor.NewTree(nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, tree.Len())
+	require.Empty(t, slices.Collect(tree.All()))
+}
@@ -0,0 +1,51 @@
+package monitor
+
+// Types returns a zero value of every known monitor type, e.g. &DNS{}.
+// The types are returned as pointers, such that they can be used as target
+// for unmarshaling.
+func Types() []Monitor {
+	return []Monitor{
+		&DNS{},
+		&Docker{},
+		&GameDig{},
+		&Globalping{},
+		&Group{},
+		&GrpcKeyword{},
+		&HTTP{},
+		&HTTPJSONQuery{},
+		&HTTPKeyword{},
+		&KafkaProducer{},
+		&MongoDB{},
+		&MQTT{},
+		&MySQL{},
+		&OracleDB{},
+		&Ping{},
+		&Postgres{},
+		&Push{},
+		&RabbitMQ{},
+		&Radius{},
+		&RealBrowser{},
+		&Redis{},
+		&SIPOptions{},
+		&SMTP{},
+		&SNMP{},
+		&SQLServer{},
+		&Steam{},
+		&SystemService{},
+		&TailscalePing{},
+		&TCPPort{},
+		&WebsocketUpgrade{},
+	}
+}
+
+// New returns a zero value of the monitor type with the given type name,
+// e.g. "http". False is returned, if the type is not known.
+func New(typ string) (Monitor, bool) {
+	for _, t := range Types() {
+		if t.Type() == typ {
+			return t, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,30 @@
+package monitor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func TestTypes(t *testing.T) {
+	seen := map[string]bool{}
+	for _, typ := range monitor.Types() {
+		require.NotEmpty(t, typ.Type(), "%T", typ)
+		require.False(t, seen[typ.Type()], "duplicate type %q", typ.Type())
+
+		seen[typ.Type()] = true
+
+		n, ok := monitor.New(typ.Type())
+		require.True(t, ok)
+		require.IsType(t, typ, n)
+	}
+
+	n, ok := monitor.New("http")
+	require.True(t, ok)
+	require.Equal(t, "http", n.Type())
+
+	_, ok = monitor.New("unknown")
+	require.False(t, ok)
+}
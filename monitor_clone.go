@@ -0,0 +1,147 @@
+package kuma
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+// CloneOverrides defines the fields, which are changed on a cloned monitor.
+type CloneOverrides struct {
+	// Name is the name of the clone. If empty, the name is "Clone of <name>",
+	// following the Uptime Kuma web interface.
+	Name string
+
+	// URL replaces the URL of the monitor, if set. Only applies to monitor
+	// types with an URL, e.g. HTTP.
+	URL string
+
+	// Parent moves the clone to the group with the given ID, if set. A value
+	// of 0 creates the clone without parent group.
+	Parent *int64
+
+	// Maintenance additionally adds the clone to all maintenance windows, the
+	// original monitor is part of.
+	Maintenance bool
+}
+
+// cloneResetFields are the fields of the raw monitor data, which are
+// generated by the server or unique per monitor and must not be copied to the
+// clone.
+//
+//nolint:gochecknoglobals // list of fields, which are reset on clone.
+var cloneResetFields = []string{
+	"id", "pathName", "path", "childrenIDs", "includeSensitiveData", "maintenance", "screenshot", "tags",
+	"pushToken",
+}
+
+// CloneMonitor creates a copy of the monitor with the given ID and returns
+// the ID of the clone.
+//
+// The full configuration of the monitor is copied, including the fields,
+// which are not part of the typed monitor structs, as well as the
+// notifications. The tags are added to the clone with their values.
+// The overrides are applied to the clone. A push monitor gets a new push
+// token, because the server identifies push monitors by their token.
+//
+// If the clone has been created, but copying the tags or the maintenance
+// membership fails, the ID of the clone is returned together with the error.
+func (c *Client) CloneMonitor(ctx context.Context, monitorID int64, overrides CloneOverrides) (int64, error) {
+	source, err := c.GetMonitor(ctx, monitorID)
+	if err != nil {
+		return 0, fmt.Errorf("clone monitor %d: %w", monitorID, err)
+	}
+
+	data, err := structToMap(source)
+	if err != nil {
+		return 0, fmt.Errorf("clone monitor %d: %w", monitorID, err)
+	}
+
+	for _, field := range cloneResetFields {
+		delete(data, field)
+	}
+
+	data["name"] = "Clone of " + source.Name
+	if overrides.Name != "" {
+		data["name"] = overrides.Name
+	}
+
+	if overrides.URL != "" {
+		data["url"] = overrides.URL
+	}
+
+	if source.Type() == "push" {
+		data["pushToken"] = rand.Text()
+	}
+
+	if overrides.Parent != nil {
+		data["parent"] = overrides.Parent
+		if *overrides.Parent == 0 {
+			data["parent"] = nil
+		}
+	}
+
+	var clone monitor.Base
+	err = convertToStruct(data, &clone)
+	if err != nil {
+		return 0, fmt.Errorf("clone monitor %d: %w", monitorID, err)
+	}
+
+	cloneID, err := c.CreateMonitor(ctx, &clone)
+	if err != nil {
+		return 0, fmt.Errorf("clone monitor %d: %w", monitorID, err)
+	}
+
+	var errs []error
+
+	for _, t := range source.Tags {
+		_, err = c.AddMonitorTag(ctx, t.TagID, cloneID, t.Value)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if overrides.Maintenance {
+		err = c.copyMonitorMaintenance(ctx, monitorID, cloneID)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return cloneID, fmt.Errorf("clone monitor %d: clone %d created: %w", monitorID, cloneID, errors.Join(errs...))
+	}
+
+	return cloneID, nil
+}
+
+// copyMonitorMaintenance adds the monitor with ID targetID to all maintenance
+// windows, the monitor with ID sourceID is part of.
+func (c *Client) copyMonitorMaintenance(ctx context.Context, sourceID int64, targetID int64) error {
+	maintenances, err := c.GetMaintenances(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range maintenances {
+		monitorIDs, err := c.GetMonitorMaintenance(ctx, m.ID)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Contains(monitorIDs, sourceID) || slices.Contains(monitorIDs, targetID) {
+			continue
+		}
+
+		err = c.SetMonitorMaintenance(ctx, m.ID, append(monitorIDs, targetID))
+		if err != nil {
+			return fmt.Errorf("maintenance %d: %w", m.ID, err)
+		}
+	}
+
+	return nil
+}
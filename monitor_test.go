@@ -3,14 +3,17 @@ package kuma_test
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	kuma "github.com/breml/go-uptime-kuma-client"
 	"github.com/breml/go-uptime-kuma-client/dockerhost"
 	"github.com/breml/go-uptime-kuma-client/internal/ptr"
 	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/tag"
 )
 
 // monitorTestCase defines a single monitor type's CRUD test scenario.
@@ -1910,3 +1913,257 @@ func TestMonitorCRUD(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_FindMonitors(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	defer cancel()
+
+	group := monitor.Group{
+		Base: monitor.Base{Name: "find-group", Interval: 60, RetryInterval: 60, IsActive: true},
+	}
+
+	groupID, err := client.CreateMonitor(ctx, &group)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.DeleteMonitor(ctx, groupID)
+	}()
+
+	var ids []int64
+	for _, name := range []string{"find-api-users", "find-api-orders"} {
+		httpMonitor := monitor.HTTP{
+			Base: monitor.Base{
+				Name:          name,
+				Parent:        &groupID,
+				Interval:      60,
+				RetryInterval: 60,
+				MaxRetries:    1,
+				IsActive:      true,
+			},
+			HTTPDetails: monitor.HTTPDetails{
+				URL:                 "https://example.com",
+				Timeout:             48,
+				Method:              "GET",
+				MaxRedirects:        10,
+				AcceptedStatusCodes: []string{"200-299"},
+				AuthMethod:          monitor.AuthMethodNone,
+			},
+		}
+
+		id, err := client.CreateMonitor(ctx, &httpMonitor)
+		require.NoError(t, err)
+
+		ids = append(ids, id)
+	}
+
+	defer func() {
+		for _, id := range ids {
+			_ = client.DeleteMonitor(ctx, id)
+		}
+	}()
+
+	monitors, err := client.FindMonitors(ctx, `type=http and name~"find-api-*"`)
+	require.NoError(t, err)
+	require.ElementsMatch(t, ids, monitorIDs(monitors))
+
+	monitors, err = client.FindMonitors(ctx, "parent="+strconv.FormatInt(groupID, 10)+` and name="find-api-users"`)
+	require.NoError(t, err)
+	require.Equal(t, []int64{ids[0]}, monitorIDs(monitors))
+
+	_, err = client.FindMonitors(ctx, "type=")
+	require.Error(t, err)
+}
+
+func TestClient_CloneMonitor(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	defer cancel()
+
+	tagID, err := client.CreateTag(ctx, tag.Tag{Name: "clone", Color: "#00ff00"})
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.DeleteTag(ctx, tagID)
+	}()
+
+	httpMonitor := monitor.HTTP{
+		Base: monitor.Base{
+			Name:          "clone-source",
+			Description:   ptr.To("source monitor"),
+			Interval:      60,
+			RetryInterval: 60,
+			MaxRetries:    1,
+			IsActive:      true,
+		},
+		HTTPDetails: monitor.HTTPDetails{
+			URL:                 "https://example.com",
+			Timeout:             48,
+			Method:              "GET",
+			MaxRedirects:        10,
+			AcceptedStatusCodes: []string{"200-299"},
+			AuthMethod:          monitor.AuthMethodNone,
+		},
+	}
+
+	sourceID, err := client.CreateMonitor(ctx, &httpMonitor)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.DeleteMonitor(ctx, sourceID)
+	}()
+
+	_, err = client.AddMonitorTag(ctx, tagID, sourceID, "production")
+	require.NoError(t, err)
+
+	cloneID, err := client.CloneMonitor(ctx, sourceID, kuma.CloneOverrides{
+		URL: "https://example.org",
+	})
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.DeleteMonitor(ctx, cloneID)
+	}()
+
+	require.NotEqual(t, sourceID, cloneID)
+
+	var clone monitor.HTTP
+	err = client.GetMonitorAs(ctx, cloneID, &clone)
+	require.NoError(t, err)
+
+	require.Equal(t, "Clone of clone-source", clone.Name)
+	require.Equal(t, "https://example.org", clone.URL)
+	require.Equal(t, httpMonitor.Description, clone.Description)
+	require.Equal(t, httpMonitor.Timeout, clone.Timeout)
+	require.Len(t, clone.Tags, 1)
+	require.Equal(t, tagID, clone.Tags[0].TagID)
+	require.Equal(t, "production", clone.Tags[0].Value)
+
+	_, err = client.CloneMonitor(ctx, 999999, kuma.CloneOverrides{})
+	require.Error(t, err)
+}
+
+func TestClient_CloneMonitor_Push(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	defer cancel()
+
+	pushMonitor := monitor.Push{
+		Base: monitor.Base{
+			Name:          "clone-push-source",
+			Interval:      60,
+			RetryInterval: 60,
+			IsActive:      true,
+		},
+		PushDetails: monitor.PushDetails{
+			PushToken: "clonesourcetoken",
+		},
+	}
+
+	sourceID, err := client.CreateMonitor(ctx, &pushMonitor)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.DeleteMonitor(ctx, sourceID)
+	}()
+
+	cloneID, err := client.CloneMonitor(ctx, sourceID, kuma.CloneOverrides{})
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.DeleteMonitor(ctx, cloneID)
+	}()
+
+	var source monitor.Push
+	err = client.GetMonitorAs(ctx, sourceID, &source)
+	require.NoError(t, err)
+
+	var clone monitor.Push
+	err = client.GetMonitorAs(ctx, cloneID, &clone)
+	require.NoError(t, err)
+
+	require.Equal(t, "clonesourcetoken", source.PushToken)
+	require.NotEmpty(t, clone.PushToken)
+	require.NotEqual(t, source.PushToken, clone.PushToken)
+}
+
+func TestClient_MonitorTree(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	defer cancel()
+
+	createGroup := func(name string, parent *int64) int64 {
+		group := monitor.Group{
+			Base: monitor.Base{Name: name, Parent: parent, Interval: 60, RetryInterval: 60, IsActive: true},
+		}
+
+		id, err := client.CreateMonitor(ctx, &group)
+		require.NoError(t, err)
+
+		return id
+	}
+
+	regionID := createGroup("tree-region", nil)
+	serviceID := createGroup("tree-service", &regionID)
+	otherID := createGroup("tree-other", nil)
+
+	defer func() {
+		_ = client.DeleteMonitor(ctx, otherID)
+	}()
+
+	tree, err := client.MonitorTree(ctx)
+	require.NoError(t, err)
+
+	node, ok := tree.Node(serviceID)
+	require.True(t, ok)
+	require.Equal(t, "tree-region / tree-service", node.PathName())
+
+	// Moving a group into its own child is refused.
+	err = client.MoveMonitor(ctx, regionID, &serviceID)
+	require.ErrorIs(t, err, monitor.ErrCycle)
+
+	err = client.MoveMonitor(ctx, serviceID, &otherID)
+	require.NoError(t, err)
+
+	mon, err := client.GetMonitor(ctx, serviceID)
+	require.NoError(t, err)
+	require.Equal(t, &otherID, mon.Parent)
+
+	err = client.MoveMonitor(ctx, serviceID, &regionID)
+	require.NoError(t, err)
+
+	ids, err := client.DeleteGroupRecursive(ctx, regionID, kuma.WithDeleteGroupDryRun())
+	require.NoError(t, err)
+	require.Equal(t, []int64{serviceID, regionID}, ids)
+
+	_, err = client.GetMonitor(ctx, regionID)
+	require.NoError(t, err)
+
+	ids, err = client.DeleteGroupRecursive(ctx, regionID)
+	require.NoError(t, err)
+	require.Equal(t, []int64{serviceID, regionID}, ids)
+
+	_, err = client.GetMonitor(ctx, serviceID)
+	require.Error(t, err)
+}
+
+func monitorIDs(monitors []monitor.Base) []int64 {
+	ids := make([]int64, 0, len(monitors))
+	for _, mon := range monitors {
+		ids = append(ids, mon.ID)
+	}
+
+	return ids
+}
@@ -0,0 +1,138 @@
+package kuma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+// MonitorTree returns the hierarchy of all monitors, which is formed by the
+// parent groups of the monitors.
+func (c *Client) MonitorTree(ctx context.Context) (*monitor.Tree, error) {
+	monitors, err := c.GetMonitors(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("monitor tree: %w", err)
+	}
+
+	tree, err := monitor.NewTree(monitors)
+	if err != nil {
+		return nil, fmt.Errorf("monitor tree: %w", err)
+	}
+
+	return tree, nil
+}
+
+// MoveMonitor moves the monitor with the given ID to the group with the ID
+// newParent. If newParent is nil, the monitor is moved to the top level.
+//
+// The new parent must be a group monitor. Moving a group into itself or into
+// one of its descendants is refused with an error wrapping monitor.ErrCycle.
+func (c *Client) MoveMonitor(ctx context.Context, monitorID int64, newParent *int64) error {
+	tree, err := c.MonitorTree(ctx)
+	if err != nil {
+		return fmt.Errorf("move monitor %d: %w", monitorID, err)
+	}
+
+	_, ok := tree.Node(monitorID)
+	if !ok {
+		return fmt.Errorf("move monitor %d: %w", monitorID, ErrNotFound)
+	}
+
+	if newParent != nil && *newParent == 0 {
+		newParent = nil
+	}
+
+	if newParent != nil {
+		parent, ok := tree.Node(*newParent)
+		if !ok {
+			return fmt.Errorf("move monitor %d: parent %d: %w", monitorID, *newParent, ErrNotFound)
+		}
+
+		if parent.Monitor.Type() != "group" {
+			return fmt.Errorf("move monitor %d: parent %d is not a group monitor", monitorID, *newParent)
+		}
+
+		if *newParent == monitorID || parent.IsDescendantOf(monitorID) {
+			return fmt.Errorf("move monitor %d: into %d: %w", monitorID, *newParent, monitor.ErrCycle)
+		}
+	}
+
+	// Retrieve the full monitor, the monitor list does not contain all
+	// fields required by the server for an update.
+	mon, err := c.GetMonitor(ctx, monitorID)
+	if err != nil {
+		return fmt.Errorf("move monitor %d: %w", monitorID, err)
+	}
+
+	mon.Parent = newParent
+
+	err = c.UpdateMonitor(ctx, &mon)
+	if err != nil {
+		return fmt.Errorf("move monitor %d: %w", monitorID, err)
+	}
+
+	return nil
+}
+
+// DeleteGroupOption is a functional option for DeleteGroupRecursive.
+type DeleteGroupOption func(d *deleteGroup)
+
+type deleteGroup struct {
+	dryRun bool
+}
+
+// WithDeleteGroupDryRun only computes the monitors, which would be deleted by
+// DeleteGroupRecursive, without deleting them.
+func WithDeleteGroupDryRun() DeleteGroupOption {
+	return func(d *deleteGroup) {
+		d.dryRun = true
+	}
+}
+
+// DeleteGroupRecursive deletes the group with the given ID together with all
+// its descendants. The children are deleted before their parent group.
+//
+// The IDs of the deleted monitors are returned in the order of deletion. In
+// dry-run mode, see WithDeleteGroupDryRun, the IDs of the monitors, which
+// would be deleted, are returned. If the deletion of a monitor fails, the
+// deletion is stopped and the IDs of the monitors deleted so far are
+// returned together with the error.
+func (c *Client) DeleteGroupRecursive(
+	ctx context.Context,
+	groupID int64,
+	opts ...DeleteGroupOption,
+) ([]int64, error) {
+	d := deleteGroup{}
+	for _, opt := range opts {
+		opt(&d)
+	}
+
+	tree, err := c.MonitorTree(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("delete group %d: %w", groupID, err)
+	}
+
+	group, ok := tree.Node(groupID)
+	if !ok {
+		return nil, fmt.Errorf("delete group %d: %w", groupID, ErrNotFound)
+	}
+
+	var ids []int64
+	for node := range group.PostOrder() {
+		ids = append(ids, node.Monitor.ID)
+	}
+
+	if d.dryRun {
+		return ids, nil
+	}
+
+	for i, id := range ids {
+		err = c.DeleteMonitor(ctx, id)
+		if err != nil {
+			return ids[:i], fmt.Errorf("delete group %d: %w", groupID, err)
+		}
+	}
+
+	return ids, nil
+}
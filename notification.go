@@ -12,8 +12,10 @@ func (c *Client) GetNotifications(_ context.Context) []notification.Base {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	notifications := make([]notification.Base, len(c.state.notifications))
-	copy(notifications, c.state.notifications)
+	cached := c.notificationsLocked()
+
+	notifications := make([]notification.Base, len(cached))
+	copy(notifications, cached)
 
 	return notifications
 }
@@ -23,7 +25,7 @@ func (c *Client) GetNotification(_ context.Context, id int64) (notification.Base
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	for _, notif := range c.state.notifications {
+	for _, notif := range c.notificationsLocked() {
 		if notif.GetID() == id {
 			return notif, nil
 		}
@@ -48,7 +50,21 @@ func (c *Client) GetNotificationAs(ctx context.Context, id int64, target any) er
 }
 
 // CreateNotification creates a new notification.
+// If the notification implements notification.Validator, it is validated
+// before it is sent to the server and a *notification.ValidationError is
+// returned for invalid configurations.
+// Secret references are resolved, if a SecretResolver is configured.
 func (c *Client) CreateNotification(ctx context.Context, notif notification.Notification) (int64, error) {
+	err := validateNotification(notif)
+	if err != nil {
+		return 0, fmt.Errorf("create notification: %w", err)
+	}
+
+	notif, err = resolveSecrets(ctx, c.secretResolver, notif)
+	if err != nil {
+		return 0, fmt.Errorf("create notification: %w", err)
+	}
+
 	response, err := c.syncEmitWithUpdateEvent(ctx, "addNotification", "notificationList", notif, nil)
 	if err != nil {
 		return 0, err
@@ -58,13 +74,41 @@ func (c *Client) CreateNotification(ctx context.Context, notif notification.Noti
 }
 
 // UpdateNotification updates an existing notification.
+// The notification is validated and secret references are resolved in the
+// same way as for CreateNotification.
 func (c *Client) UpdateNotification(ctx context.Context, notif notification.Notification) error {
-	_, err := c.syncEmitWithUpdateEvent(ctx, "addNotification", "notificationList", notif, notif.GetID())
+	err := validateNotification(notif)
+	if err != nil {
+		return fmt.Errorf("update notification %d: %w", notif.GetID(), err)
+	}
+
+	notif, err = resolveSecrets(ctx, c.secretResolver, notif)
+	if err != nil {
+		return fmt.Errorf("update notification %d: %w", notif.GetID(), err)
+	}
+
+	_, err = c.syncEmitWithUpdateEvent(ctx, "addNotification", "notificationList", notif, notif.GetID())
 	return err
 }
 
-// DeleteNotification deletes a notification by ID.
-func (c *Client) DeleteNotification(ctx context.Context, id int64) error {
-	_, err := c.syncEmitWithUpdateEvent(ctx, "deleteNotification", "notificationList", id)
+// DeleteNotification deletes a notification by ID. The options control,
+// how monitors referencing the notification are handled, see DeleteOption.
+func (c *Client) DeleteNotification(ctx context.Context, id int64, opts ...DeleteOption) error {
+	err := c.handleDependents(ctx, ResourceNotification, id, opts)
+	if err != nil {
+		return fmt.Errorf("delete notification %d: %w", id, err)
+	}
+
+	_, err = c.syncEmitWithUpdateEvent(ctx, "deleteNotification", "notificationList", id)
 	return err
 }
+
+// validateNotification validates notif, if it implements notification.Validator.
+func validateNotification(notif notification.Notification) error {
+	validator, ok := notif.(notification.Validator)
+	if !ok {
+		return nil
+	}
+
+	return validator.Validate()
+}
@@ -8,6 +8,8 @@ import (
 	"reflect"
 	"slices"
 	"strings"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // formatNotification formats a notification instance as a string representation.
@@ -47,6 +49,9 @@ func formatNotification(s any, includeType bool) string {
 
 		var valueStr string
 		switch {
+		case secret.IsSecret(field) && secret.IsMasked(value):
+			valueStr = fmt.Sprintf("%q", secret.Mask)
+
 		case value.Kind() == reflect.Pointer && value.IsNil():
 			valueStr = "<nil>"
 
@@ -61,6 +66,9 @@ func formatNotification(s any, includeType bool) string {
 		case value.Kind() == reflect.String:
 			valueStr = fmt.Sprintf("%q", value.String())
 
+		case value.Kind() == reflect.Struct:
+			valueStr = fmt.Sprintf("%v", secret.Redact(value.Interface()))
+
 		default:
 			valueStr = fmt.Sprintf("%v", value.Interface())
 		}
@@ -127,6 +135,45 @@ func marshalJSON(base Base, details interface{ Type() string }) ([]byte, error)
 	return data, nil
 }
 
+// secretJSONNames returns the JSON names of the secret fields of all known
+// notification types, see Types. It is used to redact raw notification data, whose
+// type is not known at compile time.
+func secretJSONNames() map[string]bool {
+	types := Types()
+
+	values := make([]any, 0, len(types))
+	for _, t := range types {
+		values = append(values, t)
+	}
+
+	return secret.JSONNames(values...)
+}
+
+// redactRaw returns a copy of the raw JSON object data, where the values of
+// all secret fields are masked. If data is not a valid JSON object, nil is
+// returned.
+func redactRaw(data []byte) []byte {
+	if data == nil {
+		return nil
+	}
+
+	raw := map[string]any{}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil
+	}
+
+	secret.RedactMap(raw, secretJSONNames())
+
+	redacted, err := json.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+
+	return redacted
+}
+
 // orderedByKey returns an iterator over a map's key-value pairs in sorted order.
 // Keys must be of a comparable ordered type.
 func orderedByKey[K cmp.Ordered, E any](m map[K]E) iter.Seq2[K, E] {
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // FortySixElks represents a fortysixelks notification.
@@ -13,7 +15,7 @@ type FortySixElks struct {
 // FortySixElksDetails contains fortysixelks-specific notification configuration.
 type FortySixElksDetails struct {
 	Username   string `json:"elksUsername"`
-	AuthToken  string `json:"elksAuthToken"`
+	AuthToken  string `json:"elksAuthToken" kuma:"secret"`
 	FromNumber string `json:"elksFromNumber"`
 	ToNumber   string `json:"elksToNumber"`
 }
@@ -33,6 +35,13 @@ func (f FortySixElks) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(f.Base, false), formatNotification(f.FortySixElksDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (f FortySixElks) Redacted() FortySixElks {
+	f.Base = f.Base.Redacted()
+
+	return secret.Redact(f)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (f *FortySixElks) UnmarshalJSON(data []byte) error {
 	detail := FortySixElksDetails{}
@@ -53,3 +62,14 @@ func (f *FortySixElks) UnmarshalJSON(data []byte) error {
 func (f FortySixElks) MarshalJSON() ([]byte, error) {
 	return marshalJSON(f.Base, &f.FortySixElksDetails)
 }
+
+// Validate checks the FortySixElks notification configuration.
+func (f FortySixElks) Validate() error {
+	check := newValidator(f.Base, f.Type())
+	check.required("elksUsername", f.Username)
+	check.required("elksAuthToken", f.AuthToken)
+	check.required("elksFromNumber", f.FromNumber)
+	check.requiredPhone("elksToNumber", f.ToNumber)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Alerta represents a alerta notification.
@@ -13,7 +15,7 @@ type Alerta struct {
 // AlertaDetails contains alerta-specific notification configuration.
 type AlertaDetails struct {
 	APIEndpoint  string `json:"alertaApiEndpoint"`
-	APIKey       string `json:"alertaApiKey"`
+	APIKey       string `json:"alertaApiKey" kuma:"secret"`
 	Environment  string `json:"alertaEnvironment"`
 	AlertState   string `json:"alertaAlertState"`
 	RecoverState string `json:"alertaRecoverState"`
@@ -34,6 +36,13 @@ func (a Alerta) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(a.Base, false), formatNotification(a.AlertaDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (a Alerta) Redacted() Alerta {
+	a.Base = a.Base.Redacted()
+
+	return secret.Redact(a)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (a *Alerta) UnmarshalJSON(data []byte) error {
 	detail := AlertaDetails{}
@@ -54,3 +63,13 @@ func (a *Alerta) UnmarshalJSON(data []byte) error {
 func (a Alerta) MarshalJSON() ([]byte, error) {
 	return marshalJSON(a.Base, &a.AlertaDetails)
 }
+
+// Validate checks the Alerta notification configuration.
+func (a Alerta) Validate() error {
+	check := newValidator(a.Base, a.Type())
+	check.requiredURL("alertaApiEndpoint", a.APIEndpoint)
+	check.required("alertaApiKey", a.APIKey)
+	check.required("alertaEnvironment", a.Environment)
+
+	return check.err()
+}
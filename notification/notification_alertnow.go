@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // AlertNow represents a alertnow notification.
@@ -30,6 +32,13 @@ func (a AlertNow) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(a.Base, false), formatNotification(a.AlertNowDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (a AlertNow) Redacted() AlertNow {
+	a.Base = a.Base.Redacted()
+
+	return secret.Redact(a)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (a *AlertNow) UnmarshalJSON(data []byte) error {
 	detail := AlertNowDetails{}
@@ -50,3 +59,11 @@ func (a *AlertNow) UnmarshalJSON(data []byte) error {
 func (a AlertNow) MarshalJSON() ([]byte, error) {
 	return marshalJSON(a.Base, &a.AlertNowDetails)
 }
+
+// Validate checks the AlertNow notification configuration.
+func (a AlertNow) Validate() error {
+	check := newValidator(a.Base, a.Type())
+	check.requiredURL("alertNowWebhookURL", a.WebhookURL)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // AliyunSMS is a notification provider for Aliyun SMS service.
@@ -13,7 +15,7 @@ type AliyunSMS struct {
 // AliyunSMSDetails contains the configuration fields for Aliyun SMS notifications.
 type AliyunSMSDetails struct {
 	AccessKeyID        string `json:"accessKeyId"`
-	SecretAccessKey    string `json:"secretAccessKey"`
+	SecretAccessKey    string `json:"secretAccessKey" kuma:"secret"`
 	PhoneNumber        string `json:"phonenumber"`
 	SignName           string `json:"signName"`
 	TemplateCode       string `json:"templateCode"`
@@ -35,6 +37,13 @@ func (a AliyunSMS) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(a.Base, false), formatNotification(a.AliyunSMSDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (a AliyunSMS) Redacted() AliyunSMS {
+	a.Base = a.Base.Redacted()
+
+	return secret.Redact(a)
+}
+
 // UnmarshalJSON unmarshals JSON data into an AliyunSMS notification.
 func (a *AliyunSMS) UnmarshalJSON(data []byte) error {
 	detail := AliyunSMSDetails{}
@@ -55,3 +64,15 @@ func (a *AliyunSMS) UnmarshalJSON(data []byte) error {
 func (a AliyunSMS) MarshalJSON() ([]byte, error) {
 	return marshalJSON(a.Base, a.AliyunSMSDetails)
 }
+
+// Validate checks the AliyunSMS notification configuration.
+func (a AliyunSMS) Validate() error {
+	check := newValidator(a.Base, a.Type())
+	check.required("accessKeyId", a.AccessKeyID)
+	check.required("secretAccessKey", a.SecretAccessKey)
+	check.requiredPhone("phonenumber", a.PhoneNumber)
+	check.required("signName", a.SignName)
+	check.required("templateCode", a.TemplateCode)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Apprise represents an Apprise notification provider.
@@ -32,6 +34,13 @@ func (a Apprise) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(a.Base, false), formatNotification(a.AppriseDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (a Apprise) Redacted() Apprise {
+	a.Base = a.Base.Redacted()
+
+	return secret.Redact(a)
+}
+
 // UnmarshalJSON deserializes an Apprise notification from JSON.
 func (a *Apprise) UnmarshalJSON(data []byte) error {
 	detail := AppriseDetails{}
@@ -52,3 +61,11 @@ func (a *Apprise) UnmarshalJSON(data []byte) error {
 func (a Apprise) MarshalJSON() ([]byte, error) {
 	return marshalJSON(a.Base, a.AppriseDetails)
 }
+
+// Validate checks the Apprise notification configuration.
+func (a Apprise) Validate() error {
+	check := newValidator(a.Base, a.Type())
+	check.required("appriseURL", a.AppriseURL)
+
+	return check.err()
+}
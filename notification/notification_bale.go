@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Bale represents a Bale messenger notification.
@@ -12,7 +14,7 @@ type Bale struct {
 
 // BaleDetails contains bale-specific notification configuration.
 type BaleDetails struct {
-	BotToken string `json:"baleBotToken"`
+	BotToken string `json:"baleBotToken" kuma:"secret"`
 	ChatID   string `json:"baleChatID"`
 }
 
@@ -31,6 +33,13 @@ func (b Bale) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(b.Base, false), formatNotification(b.BaleDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (b Bale) Redacted() Bale {
+	b.Base = b.Base.Redacted()
+
+	return secret.Redact(b)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (b *Bale) UnmarshalJSON(data []byte) error {
 	detail := BaleDetails{}
@@ -51,3 +60,12 @@ func (b *Bale) UnmarshalJSON(data []byte) error {
 func (b Bale) MarshalJSON() ([]byte, error) {
 	return marshalJSON(b.Base, &b.BaleDetails)
 }
+
+// Validate checks the Bale notification configuration.
+func (b Bale) Validate() error {
+	check := newValidator(b.Base, b.Type())
+	check.required("baleBotToken", b.BotToken)
+	check.required("baleChatID", b.ChatID)
+
+	return check.err()
+}
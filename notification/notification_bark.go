@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Bark represents a Bark notification provider.
@@ -38,6 +40,13 @@ func (b Bark) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(b.Base, false), formatNotification(b.BarkDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (b Bark) Redacted() Bark {
+	b.Base = b.Base.Redacted()
+
+	return secret.Redact(b)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Bark notification.
 func (b *Bark) UnmarshalJSON(data []byte) error {
 	detail := BarkDetails{}
@@ -58,3 +67,12 @@ func (b *Bark) UnmarshalJSON(data []byte) error {
 func (b Bark) MarshalJSON() ([]byte, error) {
 	return marshalJSON(b.Base, b.BarkDetails)
 }
+
+// Validate checks the Bark notification configuration.
+func (b Bark) Validate() error {
+	check := newValidator(b.Base, b.Type())
+	check.requiredURL("barkEndpoint", b.Endpoint)
+	check.oneOf("apiVersion", b.APIVersion, "", "v1", "v2")
+
+	return check.err()
+}
@@ -34,6 +34,38 @@ func (b Base) String() string {
 	return formatNotification(b, true)
 }
 
+// Redacted returns a copy of the notification, where all secret values are
+// masked. This includes the secret values contained in the raw notification
+// configuration.
+func (b Base) Redacted() Base {
+	if b.configStr != "" {
+		b.configStr = string(redactRaw([]byte(b.configStr)))
+	}
+
+	if b.raw == nil {
+		return b
+	}
+
+	// The raw notification data contains the configuration as string, which
+	// needs to be replaced with the redacted one.
+	raw := map[string]any{}
+
+	err := json.Unmarshal(b.raw, &raw)
+	if err != nil {
+		b.raw = nil
+		return b
+	}
+
+	raw["config"] = b.configStr
+
+	b.raw, err = json.Marshal(raw)
+	if err != nil {
+		b.raw = nil
+	}
+
+	return b
+}
+
 // UnmarshalJSON unmarshals a notification from JSON data.
 func (b *Base) UnmarshalJSON(data []byte) error {
 	raw := struct {
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Bitrix24 represents a Bitrix24 notification provider.
@@ -34,6 +36,13 @@ func (b Bitrix24) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(b.Base, false), formatNotification(b.Bitrix24Details, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (b Bitrix24) Redacted() Bitrix24 {
+	b.Base = b.Base.Redacted()
+
+	return secret.Redact(b)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Bitrix24 notification.
 func (b *Bitrix24) UnmarshalJSON(data []byte) error {
 	detail := Bitrix24Details{}
@@ -54,3 +63,12 @@ func (b *Bitrix24) UnmarshalJSON(data []byte) error {
 func (b Bitrix24) MarshalJSON() ([]byte, error) {
 	return marshalJSON(b.Base, b.Bitrix24Details)
 }
+
+// Validate checks the Bitrix24 notification configuration.
+func (b Bitrix24) Validate() error {
+	check := newValidator(b.Base, b.Type())
+	check.requiredURL("bitrix24WebhookURL", b.WebhookURL)
+	check.required("bitrix24UserID", b.NotificationUserID)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Brevo represents a Brevo (formerly Sendinblue) notification provider.
@@ -14,7 +16,7 @@ type Brevo struct {
 // BrevoDetails contains the configuration fields for Brevo notifications.
 type BrevoDetails struct {
 	// APIKey is the Brevo API key for authentication.
-	APIKey string `json:"brevoApiKey"`
+	APIKey string `json:"brevoApiKey" kuma:"secret"`
 	// ToEmail is the recipient email address.
 	ToEmail string `json:"brevoToEmail"`
 	// FromEmail is the sender email address.
@@ -44,6 +46,13 @@ func (b Brevo) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(b.Base, false), formatNotification(b.BrevoDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (b Brevo) Redacted() Brevo {
+	b.Base = b.Base.Redacted()
+
+	return secret.Redact(b)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Brevo notification.
 func (b *Brevo) UnmarshalJSON(data []byte) error {
 	detail := BrevoDetails{}
@@ -64,3 +73,16 @@ func (b *Brevo) UnmarshalJSON(data []byte) error {
 func (b Brevo) MarshalJSON() ([]byte, error) {
 	return marshalJSON(b.Base, b.BrevoDetails)
 }
+
+// Validate checks the Brevo notification configuration.
+func (b Brevo) Validate() error {
+	check := newValidator(b.Base, b.Type())
+	check.required("brevoApiKey", b.APIKey)
+	check.required("brevoToEmail", b.ToEmail)
+	check.emailList("brevoToEmail", b.ToEmail)
+	check.requiredEmail("brevoFromEmail", b.FromEmail)
+	check.emailList("brevoCcEmail", b.CCEmail)
+	check.emailList("brevoBccEmail", b.BCCEmail)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // CallMeBot represents a CallMeBot notification provider.
@@ -32,6 +34,13 @@ func (c CallMeBot) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(c.Base, false), formatNotification(c.CallMeBotDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (c CallMeBot) Redacted() CallMeBot {
+	c.Base = c.Base.Redacted()
+
+	return secret.Redact(c)
+}
+
 // UnmarshalJSON unmarshals JSON data into a CallMeBot notification.
 func (c *CallMeBot) UnmarshalJSON(data []byte) error {
 	detail := CallMeBotDetails{}
@@ -52,3 +61,11 @@ func (c *CallMeBot) UnmarshalJSON(data []byte) error {
 func (c CallMeBot) MarshalJSON() ([]byte, error) {
 	return marshalJSON(c.Base, c.CallMeBotDetails)
 }
+
+// Validate checks the CallMeBot notification configuration.
+func (c CallMeBot) Validate() error {
+	check := newValidator(c.Base, c.Type())
+	check.requiredURL("callMeBotEndpoint", c.Endpoint)
+
+	return check.err()
+}
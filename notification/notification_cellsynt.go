@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Cellsynt represents a Cellsynt SMS notification provider.
@@ -16,7 +18,7 @@ type CellsyntDetails struct {
 	// Login is the Cellsynt account username.
 	Login string `json:"cellsyntLogin"`
 	// Password is the Cellsynt account password.
-	Password string `json:"cellsyntPassword"`
+	Password string `json:"cellsyntPassword" kuma:"secret"`
 	// Destination is the recipient phone number.
 	Destination string `json:"cellsyntDestination"`
 	// Originator is the sender name or phone number.
@@ -42,6 +44,13 @@ func (c Cellsynt) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(c.Base, false), formatNotification(c.CellsyntDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (c Cellsynt) Redacted() Cellsynt {
+	c.Base = c.Base.Redacted()
+
+	return secret.Redact(c)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Cellsynt notification.
 func (c *Cellsynt) UnmarshalJSON(data []byte) error {
 	detail := CellsyntDetails{}
@@ -62,3 +71,15 @@ func (c *Cellsynt) UnmarshalJSON(data []byte) error {
 func (c Cellsynt) MarshalJSON() ([]byte, error) {
 	return marshalJSON(c.Base, c.CellsyntDetails)
 }
+
+// Validate checks the Cellsynt notification configuration.
+func (c Cellsynt) Validate() error {
+	check := newValidator(c.Base, c.Type())
+	check.required("cellsyntLogin", c.Login)
+	check.required("cellsyntPassword", c.Password)
+	check.required("cellsyntDestination", c.Destination)
+	check.required("cellsyntOriginator", c.Originator)
+	check.oneOf("cellsyntOriginatortype", c.OriginatorType, "", "Numeric", "Alphanumeric")
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // ClickSendSMS represents a ClickSend SMS notification provider.
@@ -16,7 +18,7 @@ type ClickSendSMSDetails struct {
 	// Login is the ClickSend account username.
 	Login string `json:"clicksendsmsLogin"`
 	// Password is the ClickSend API key.
-	Password string `json:"clicksendsmsPassword"`
+	Password string `json:"clicksendsmsPassword" kuma:"secret"`
 	// ToNumber is the recipient phone number.
 	ToNumber string `json:"clicksendsmsToNumber"`
 	// SenderName is the sender name or phone number.
@@ -38,6 +40,13 @@ func (c ClickSendSMS) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(c.Base, false), formatNotification(c.ClickSendSMSDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (c ClickSendSMS) Redacted() ClickSendSMS {
+	c.Base = c.Base.Redacted()
+
+	return secret.Redact(c)
+}
+
 // UnmarshalJSON unmarshals JSON data into a ClickSendSMS notification.
 func (c *ClickSendSMS) UnmarshalJSON(data []byte) error {
 	detail := ClickSendSMSDetails{}
@@ -58,3 +67,13 @@ func (c *ClickSendSMS) UnmarshalJSON(data []byte) error {
 func (c ClickSendSMS) MarshalJSON() ([]byte, error) {
 	return marshalJSON(c.Base, c.ClickSendSMSDetails)
 }
+
+// Validate checks the ClickSendSMS notification configuration.
+func (c ClickSendSMS) Validate() error {
+	check := newValidator(c.Base, c.Type())
+	check.required("clicksendsmsLogin", c.Login)
+	check.required("clicksendsmsPassword", c.Password)
+	check.requiredPhone("clicksendsmsToNumber", c.ToNumber)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // DingDing represents a DingDing (钉钉) notification provider.
@@ -14,7 +16,7 @@ type DingDing struct {
 // DingDingDetails contains the DingDing-specific configuration.
 type DingDingDetails struct {
 	WebHookURL string `json:"webHookUrl"`
-	SecretKey  string `json:"secretKey"`
+	SecretKey  string `json:"secretKey" kuma:"secret"`
 	Mentioning string `json:"mentioning"`
 }
 
@@ -33,6 +35,13 @@ func (d DingDing) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(d.Base, false), formatNotification(d.DingDingDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (d DingDing) Redacted() DingDing {
+	d.Base = d.Base.Redacted()
+
+	return secret.Redact(d)
+}
+
 // UnmarshalJSON deserializes a DingDing notification from JSON.
 func (d *DingDing) UnmarshalJSON(data []byte) error {
 	detail := DingDingDetails{}
@@ -53,3 +62,12 @@ func (d *DingDing) UnmarshalJSON(data []byte) error {
 func (d DingDing) MarshalJSON() ([]byte, error) {
 	return marshalJSON(d.Base, d.DingDingDetails)
 }
+
+// Validate checks the DingDing notification configuration.
+func (d DingDing) Validate() error {
+	check := newValidator(d.Base, d.Type())
+	check.requiredURL("webHookUrl", d.WebHookURL)
+	check.required("secretKey", d.SecretKey)
+
+	return check.err()
+}
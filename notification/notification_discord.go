@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Discord represents a discord notification.
@@ -40,6 +42,13 @@ func (d Discord) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(d.Base, false), formatNotification(d.DiscordDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (d Discord) Redacted() Discord {
+	d.Base = d.Base.Redacted()
+
+	return secret.Redact(d)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (d *Discord) UnmarshalJSON(data []byte) error {
 	detail := DiscordDetails{}
@@ -60,3 +69,12 @@ func (d *Discord) UnmarshalJSON(data []byte) error {
 func (d Discord) MarshalJSON() ([]byte, error) {
 	return marshalJSON(d.Base, &d.DiscordDetails)
 }
+
+// Validate checks the Discord notification configuration.
+func (d Discord) Validate() error {
+	check := newValidator(d.Base, d.Type())
+	check.requiredURL("discordWebhookUrl", d.WebhookURL)
+	check.oneOf("discordChannelType", d.ChannelType, "", "channel", "createNewForumPost", "postToThread")
+
+	return check.err()
+}
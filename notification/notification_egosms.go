@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // EgoSMS represents an EgoSMS notification.
@@ -13,7 +15,7 @@ type EgoSMS struct {
 // EgoSMSDetails contains EgoSMS-specific notification configuration.
 type EgoSMSDetails struct {
 	Username    string  `json:"egosmsUsername"`
-	Password    string  `json:"egosmsPassword"`
+	Password    string  `json:"egosmsPassword" kuma:"secret"`
 	Sender      *string `json:"egosmsSender,omitempty"`
 	PhoneNumber string  `json:"egosmsPhoneNumber"`
 }
@@ -33,6 +35,13 @@ func (e EgoSMS) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(e.Base, false), formatNotification(e.EgoSMSDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (e EgoSMS) Redacted() EgoSMS {
+	e.Base = e.Base.Redacted()
+
+	return secret.Redact(e)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (e *EgoSMS) UnmarshalJSON(data []byte) error {
 	detail := EgoSMSDetails{}
@@ -53,3 +62,13 @@ func (e *EgoSMS) UnmarshalJSON(data []byte) error {
 func (e EgoSMS) MarshalJSON() ([]byte, error) {
 	return marshalJSON(e.Base, &e.EgoSMSDetails)
 }
+
+// Validate checks the EgoSMS notification configuration.
+func (e EgoSMS) Validate() error {
+	check := newValidator(e.Base, e.Type())
+	check.required("egosmsUsername", e.Username)
+	check.required("egosmsPassword", e.Password)
+	check.requiredPhone("egosmsPhoneNumber", e.PhoneNumber)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Evolution represents an Evolution API notification provider.
@@ -18,7 +20,7 @@ type EvolutionDetails struct {
 	// InstanceName is the Evolution API instance name.
 	InstanceName string `json:"evolutionInstanceName"`
 	// AuthToken is the Evolution API authentication token.
-	AuthToken string `json:"evolutionAuthToken"`
+	AuthToken string `json:"evolutionAuthToken" kuma:"secret"`
 	// Recipient is the recipient phone number for WhatsApp messages.
 	Recipient string `json:"evolutionRecipient"`
 	// UseCustomMessage enables the use of a custom message template.
@@ -42,6 +44,13 @@ func (e Evolution) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(e.Base, false), formatNotification(e.EvolutionDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (e Evolution) Redacted() Evolution {
+	e.Base = e.Base.Redacted()
+
+	return secret.Redact(e)
+}
+
 // UnmarshalJSON unmarshals JSON data into an Evolution notification.
 func (e *Evolution) UnmarshalJSON(data []byte) error {
 	detail := EvolutionDetails{}
@@ -62,3 +71,14 @@ func (e *Evolution) UnmarshalJSON(data []byte) error {
 func (e Evolution) MarshalJSON() ([]byte, error) {
 	return marshalJSON(e.Base, e.EvolutionDetails)
 }
+
+// Validate checks the Evolution notification configuration.
+func (e Evolution) Validate() error {
+	check := newValidator(e.Base, e.Type())
+	check.requiredURL("evolutionApiUrl", e.APIURL)
+	check.required("evolutionInstanceName", e.InstanceName)
+	check.required("evolutionAuthToken", e.AuthToken)
+	check.required("evolutionRecipient", e.Recipient)
+
+	return check.err()
+}
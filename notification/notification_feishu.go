@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Feishu represents a Feishu notification provider.
@@ -31,6 +33,13 @@ func (f Feishu) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(f.Base, false), formatNotification(f.FeishuDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (f Feishu) Redacted() Feishu {
+	f.Base = f.Base.Redacted()
+
+	return secret.Redact(f)
+}
+
 // UnmarshalJSON deserializes a Feishu notification from JSON.
 func (f *Feishu) UnmarshalJSON(data []byte) error {
 	detail := FeishuDetails{}
@@ -51,3 +60,11 @@ func (f *Feishu) UnmarshalJSON(data []byte) error {
 func (f Feishu) MarshalJSON() ([]byte, error) {
 	return marshalJSON(f.Base, f.FeishuDetails)
 }
+
+// Validate checks the Feishu notification configuration.
+func (f Feishu) Validate() error {
+	check := newValidator(f.Base, f.Type())
+	check.requiredURL("feishuWebHookUrl", f.WebHookURL)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // FlashDuty represents a FlashDuty notification provider.
@@ -14,7 +16,7 @@ type FlashDuty struct {
 // FlashDutyDetails contains the configuration fields for FlashDuty notifications.
 type FlashDutyDetails struct {
 	// IntegrationKey is the FlashDuty integration key or full webhook URL.
-	IntegrationKey string `json:"flashdutyIntegrationKey"`
+	IntegrationKey string `json:"flashdutyIntegrationKey" kuma:"secret"`
 	// Severity is the alert severity level (Info, Warning, Critical, Ok).
 	Severity string `json:"flashdutySeverity"`
 }
@@ -34,6 +36,13 @@ func (f FlashDuty) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(f.Base, false), formatNotification(f.FlashDutyDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (f FlashDuty) Redacted() FlashDuty {
+	f.Base = f.Base.Redacted()
+
+	return secret.Redact(f)
+}
+
 // UnmarshalJSON unmarshals JSON data into a FlashDuty notification.
 func (f *FlashDuty) UnmarshalJSON(data []byte) error {
 	detail := FlashDutyDetails{}
@@ -54,3 +63,12 @@ func (f *FlashDuty) UnmarshalJSON(data []byte) error {
 func (f FlashDuty) MarshalJSON() ([]byte, error) {
 	return marshalJSON(f.Base, f.FlashDutyDetails)
 }
+
+// Validate checks the FlashDuty notification configuration.
+func (f FlashDuty) Validate() error {
+	check := newValidator(f.Base, f.Type())
+	check.required("flashdutyIntegrationKey", f.IntegrationKey)
+	check.oneOf("flashdutySeverity", f.Severity, "", "Info", "Warning", "Critical", "Ok")
+
+	return check.err()
+}
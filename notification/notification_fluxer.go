@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Fluxer represents a Fluxer notification.
@@ -36,6 +38,13 @@ func (f Fluxer) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(f.Base, false), formatNotification(f.FluxerDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (f Fluxer) Redacted() Fluxer {
+	f.Base = f.Base.Redacted()
+
+	return secret.Redact(f)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (f *Fluxer) UnmarshalJSON(data []byte) error {
 	detail := FluxerDetails{}
@@ -56,3 +65,11 @@ func (f *Fluxer) UnmarshalJSON(data []byte) error {
 func (f Fluxer) MarshalJSON() ([]byte, error) {
 	return marshalJSON(f.Base, &f.FluxerDetails)
 }
+
+// Validate checks the Fluxer notification configuration.
+func (f Fluxer) Validate() error {
+	check := newValidator(f.Base, f.Type())
+	check.requiredURL("fluxerWebhookUrl", f.WebhookURL)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // FreeMobile represents a Free Mobile SMS notification provider.
@@ -16,7 +18,7 @@ type FreeMobileDetails struct {
 	// User is the Free Mobile user ID.
 	User string `json:"freemobileUser"`
 	// Pass is the Free Mobile API key.
-	Pass string `json:"freemobilePass"`
+	Pass string `json:"freemobilePass" kuma:"secret"`
 }
 
 // Type returns the notification type identifier for FreeMobile.
@@ -34,6 +36,13 @@ func (f FreeMobile) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(f.Base, false), formatNotification(f.FreeMobileDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (f FreeMobile) Redacted() FreeMobile {
+	f.Base = f.Base.Redacted()
+
+	return secret.Redact(f)
+}
+
 // UnmarshalJSON unmarshals JSON data into a FreeMobile notification.
 func (f *FreeMobile) UnmarshalJSON(data []byte) error {
 	detail := FreeMobileDetails{}
@@ -54,3 +63,12 @@ func (f *FreeMobile) UnmarshalJSON(data []byte) error {
 func (f FreeMobile) MarshalJSON() ([]byte, error) {
 	return marshalJSON(f.Base, f.FreeMobileDetails)
 }
+
+// Validate checks the FreeMobile notification configuration.
+func (f FreeMobile) Validate() error {
+	check := newValidator(f.Base, f.Type())
+	check.required("freemobileUser", f.User)
+	check.required("freemobilePass", f.Pass)
+
+	return check.err()
+}
@@ -1,10 +1,13 @@
 package notification
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"maps"
 	"strings"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Generic represents a generic notification.
@@ -25,7 +28,10 @@ func (n Generic) String() string {
 	buf := strings.Builder{}
 	fmt.Fprintf(&buf, "%s: %q", "type", n.TypeName)
 
-	for k, v := range orderedByKey(n.GenericDetails) {
+	details := maps.Clone(n.GenericDetails)
+	secret.RedactMap(details, secretJSONNames())
+
+	for k, v := range orderedByKey(details) {
 		if k == "type" {
 			continue
 		}
@@ -43,6 +49,18 @@ func (n Generic) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(n.Base, false), buf.String())
 }
 
+// Redacted returns a copy of the notification, where all secret values are
+// masked. As the configuration of a generic notification is untyped, secret
+// values are identified by the names of the secret fields of all known
+// notification types.
+func (n Generic) Redacted() Generic {
+	n.Base = n.Base.Redacted()
+	n.GenericDetails = maps.Clone(n.GenericDetails)
+	secret.RedactMap(n.GenericDetails, secretJSONNames())
+
+	return n
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (n *Generic) UnmarshalJSON(data []byte) error {
 	details := GenericDetails{}
@@ -81,11 +99,59 @@ func (n *Generic) UnmarshalJSON(data []byte) error {
 // MarshalJSON marshals a notification into a JSON byte slice.
 func (n Generic) MarshalJSON() ([]byte, error) {
 	details := maps.Clone(n.GenericDetails)
+	if details == nil {
+		details = GenericDetails{}
+	}
+
 	details["type"] = n.TypeName
 
 	return marshalJSON(n.Base, details)
 }
 
+// Validate checks the notification configuration. If the type is known,
+// the configuration is validated by the typed notification, otherwise only
+// the name and the type are checked.
+func (n Generic) Validate() error {
+	typed, ok := New(n.TypeName)
+	if !ok {
+		check := newValidator(n.Base, n.Type())
+		check.required("type", n.TypeName)
+
+		return check.err()
+	}
+
+	config, err := n.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("validate %s notification: %w", n.TypeName, err)
+	}
+
+	// The typed notification is unmarshaled from the format of the server,
+	// where the configuration is stored as JSON string.
+	data, err := json.Marshal(map[string]any{
+		"id":        n.ID,
+		"name":      n.Name,
+		"active":    n.IsActive,
+		"userId":    n.UserID,
+		"isDefault": n.IsDefault,
+		"config":    string(config),
+	})
+	if err != nil {
+		return fmt.Errorf("validate %s notification: %w", n.TypeName, err)
+	}
+
+	err = json.Unmarshal(data, typed)
+	if err != nil {
+		return fmt.Errorf("validate %s notification: %w", n.TypeName, err)
+	}
+
+	validator, ok := typed.(Validator)
+	if !ok {
+		return newValidator(n.Base, n.Type()).err()
+	}
+
+	return validator.Validate()
+}
+
 // GenericDetails represents generic notification configuration details.
 type GenericDetails map[string]any
 
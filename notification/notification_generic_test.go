@@ -19,7 +19,7 @@ func TestNotificationGeneric_String(t *testing.T) {
 		{
 			name: "success",
 
-			wantString: `id: 1, name: "My Ntfy Alert", active: true, isDefault: true, applyExisting: true, userId: 1, type: "ntfy", ntfyAuthenticationMethod: "usernamePassword", ntfyIcon: "http://symbol.url", ntfyPriority: 5, ntfypassword: "password", ntfyserverurl: "https://ntfy.sh", ntfytopic: "topic", ntfyusername: "user"`,
+			wantString: `id: 1, name: "My Ntfy Alert", active: true, isDefault: true, applyExisting: true, userId: 1, type: "ntfy", ntfyAuthenticationMethod: "usernamePassword", ntfyIcon: "http://symbol.url", ntfyPriority: 5, ntfypassword: "***", ntfyserverurl: "https://ntfy.sh", ntfytopic: "topic", ntfyusername: "user"`,
 			wantJSON:   `{"active":true,"applyExisting":true,"id":1,"isDefault":true,"name":"My Ntfy Alert","ntfyAuthenticationMethod":"usernamePassword","ntfyIcon":"http://symbol.url","ntfyPriority":5,"ntfypassword":"password","ntfyserverurl":"https://ntfy.sh","ntfytopic":"topic","ntfyusername":"user","type":"ntfy","userId":1}`,
 		},
 	}
@@ -56,3 +56,33 @@ func TestNotificationGeneric_String(t *testing.T) {
 		})
 	}
 }
+
+func TestNotificationGeneric_Redacted(t *testing.T) {
+	data := []byte(`{"id":1,"name":"My Telegram Alert","active":true,"userId":1,"isDefault":false,"config":"{\"name\":\"My Telegram Alert\",\"type\":\"telegram\",\"telegramBotToken\":\"bot-token\",\"telegramChatID\":\"123\"}"}`)
+
+	noti := notification.Generic{}
+	err := json.Unmarshal(data, &noti)
+	require.NoError(t, err)
+
+	require.Contains(t, noti.String(), `telegramBotToken: "***"`)
+	require.Contains(t, noti.String(), `telegramChatID: "123"`)
+
+	redacted := noti.Redacted()
+	require.Equal(t, "***", redacted.GenericDetails["telegramBotToken"])
+	require.Equal(t, "bot-token", noti.GenericDetails["telegramBotToken"])
+
+	redactedJSON, err := json.Marshal(redacted)
+	require.NoError(t, err)
+	require.NotContains(t, string(redactedJSON), "bot-token")
+
+	// The raw notification data of the base is redacted as well.
+	baseJSON, err := json.Marshal(redacted.Base)
+	require.NoError(t, err)
+	require.NotContains(t, string(baseJSON), "bot-token")
+
+	telegram := notification.Telegram{}
+	err = redacted.As(&telegram)
+	require.NoError(t, err)
+	require.Equal(t, "***", telegram.BotToken)
+	require.Equal(t, "123", telegram.ChatID)
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // GoAlert represents a GoAlert notification provider.
@@ -16,7 +18,7 @@ type GoAlertDetails struct {
 	// BaseURL is the GoAlert base URL.
 	BaseURL string `json:"goAlertBaseURL"`
 	// Token is the GoAlert integration token.
-	Token string `json:"goAlertToken"`
+	Token string `json:"goAlertToken" kuma:"secret"`
 }
 
 // Type returns the notification type identifier for GoAlert.
@@ -34,6 +36,13 @@ func (g GoAlert) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(g.Base, false), formatNotification(g.GoAlertDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (g GoAlert) Redacted() GoAlert {
+	g.Base = g.Base.Redacted()
+
+	return secret.Redact(g)
+}
+
 // UnmarshalJSON unmarshals JSON data into a GoAlert notification.
 func (g *GoAlert) UnmarshalJSON(data []byte) error {
 	detail := GoAlertDetails{}
@@ -54,3 +63,12 @@ func (g *GoAlert) UnmarshalJSON(data []byte) error {
 func (g GoAlert) MarshalJSON() ([]byte, error) {
 	return marshalJSON(g.Base, g.GoAlertDetails)
 }
+
+// Validate checks the GoAlert notification configuration.
+func (g GoAlert) Validate() error {
+	check := newValidator(g.Base, g.Type())
+	check.requiredURL("goAlertBaseURL", g.BaseURL)
+	check.required("goAlertToken", g.Token)
+
+	return check.err()
+}
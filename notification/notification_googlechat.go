@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // GoogleChat represents a Google Chat notification provider.
@@ -33,6 +35,13 @@ func (g GoogleChat) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(g.Base, false), formatNotification(g.GoogleChatDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (g GoogleChat) Redacted() GoogleChat {
+	g.Base = g.Base.Redacted()
+
+	return secret.Redact(g)
+}
+
 // UnmarshalJSON deserializes a Google Chat notification from JSON.
 func (g *GoogleChat) UnmarshalJSON(data []byte) error {
 	detail := GoogleChatDetails{}
@@ -53,3 +62,11 @@ func (g *GoogleChat) UnmarshalJSON(data []byte) error {
 func (g GoogleChat) MarshalJSON() ([]byte, error) {
 	return marshalJSON(g.Base, g.GoogleChatDetails)
 }
+
+// Validate checks the GoogleChat notification configuration.
+func (g GoogleChat) Validate() error {
+	check := newValidator(g.Base, g.Type())
+	check.requiredURL("googleChatWebhookURL", g.WebhookURL)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // GoogleSheets represents a Google Sheets notification provider.
@@ -35,6 +37,13 @@ func (g GoogleSheets) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(g.Base, false), formatNotification(g.GoogleSheetsDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (g GoogleSheets) Redacted() GoogleSheets {
+	g.Base = g.Base.Redacted()
+
+	return secret.Redact(g)
+}
+
 // UnmarshalJSON deserializes a Google Sheets notification from JSON.
 func (g *GoogleSheets) UnmarshalJSON(data []byte) error {
 	detail := GoogleSheetsDetails{}
@@ -55,3 +64,11 @@ func (g *GoogleSheets) UnmarshalJSON(data []byte) error {
 func (g GoogleSheets) MarshalJSON() ([]byte, error) {
 	return marshalJSON(g.Base, g.GoogleSheetsDetails)
 }
+
+// Validate checks the GoogleSheets notification configuration.
+func (g GoogleSheets) Validate() error {
+	check := newValidator(g.Base, g.Type())
+	check.requiredURL("googleSheetsWebhookUrl", g.WebhookURL)
+
+	return check.err()
+}
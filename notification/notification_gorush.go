@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Gorush represents a Gorush push notification provider.
@@ -44,6 +46,13 @@ func (g Gorush) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(g.Base, false), formatNotification(g.GorushDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (g Gorush) Redacted() Gorush {
+	g.Base = g.Base.Redacted()
+
+	return secret.Redact(g)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Gorush notification.
 func (g *Gorush) UnmarshalJSON(data []byte) error {
 	detail := GorushDetails{}
@@ -64,3 +73,13 @@ func (g *Gorush) UnmarshalJSON(data []byte) error {
 func (g Gorush) MarshalJSON() ([]byte, error) {
 	return marshalJSON(g.Base, g.GorushDetails)
 }
+
+// Validate checks the Gorush notification configuration.
+func (g Gorush) Validate() error {
+	check := newValidator(g.Base, g.Type())
+	check.requiredURL("gorushServerURL", g.ServerURL)
+	check.required("gorushDeviceToken", g.DeviceToken)
+	check.oneOf("gorushPlatform", g.Platform, "ios", "android", "huawei")
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Gotify represents a gotify notification.
@@ -13,7 +15,7 @@ type Gotify struct {
 // GotifyDetails contains gotify-specific notification configuration.
 type GotifyDetails struct {
 	ServerURL        string `json:"gotifyserverurl"`
-	ApplicationToken string `json:"gotifyapplicationToken"`
+	ApplicationToken string `json:"gotifyapplicationToken" kuma:"secret"`
 	Priority         int    `json:"gotifyPriority"`
 }
 
@@ -32,6 +34,13 @@ func (g Gotify) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(g.Base, false), formatNotification(g.GotifyDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (g Gotify) Redacted() Gotify {
+	g.Base = g.Base.Redacted()
+
+	return secret.Redact(g)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (g *Gotify) UnmarshalJSON(data []byte) error {
 	detail := GotifyDetails{}
@@ -52,3 +61,13 @@ func (g *Gotify) UnmarshalJSON(data []byte) error {
 func (g Gotify) MarshalJSON() ([]byte, error) {
 	return marshalJSON(g.Base, &g.GotifyDetails)
 }
+
+// Validate checks the Gotify notification configuration.
+func (g Gotify) Validate() error {
+	check := newValidator(g.Base, g.Type())
+	check.requiredURL("gotifyserverurl", g.ServerURL)
+	check.required("gotifyapplicationToken", g.ApplicationToken)
+	check.intRange("gotifyPriority", int64(g.Priority), 0, 10)
+
+	return check.err()
+}
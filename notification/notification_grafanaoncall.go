@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // GrafanaOncall represents a grafanaoncall notification.
@@ -30,6 +32,13 @@ func (g GrafanaOncall) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(g.Base, false), formatNotification(g.GrafanaOncallDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (g GrafanaOncall) Redacted() GrafanaOncall {
+	g.Base = g.Base.Redacted()
+
+	return secret.Redact(g)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (g *GrafanaOncall) UnmarshalJSON(data []byte) error {
 	detail := GrafanaOncallDetails{}
@@ -50,3 +59,11 @@ func (g *GrafanaOncall) UnmarshalJSON(data []byte) error {
 func (g GrafanaOncall) MarshalJSON() ([]byte, error) {
 	return marshalJSON(g.Base, &g.GrafanaOncallDetails)
 }
+
+// Validate checks the GrafanaOncall notification configuration.
+func (g GrafanaOncall) Validate() error {
+	check := newValidator(g.Base, g.Type())
+	check.requiredURL("GrafanaOncallURL", g.GrafanaOncallURL)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // GTXMessaging represents a GTX Messaging SMS notification provider.
@@ -14,7 +16,7 @@ type GTXMessaging struct {
 // GTXMessagingDetails contains the configuration fields for GTX Messaging SMS notifications.
 type GTXMessagingDetails struct {
 	// APIKey is the GTX Messaging API key.
-	APIKey string `json:"gtxMessagingApiKey"`
+	APIKey string `json:"gtxMessagingApiKey" kuma:"secret"`
 	// From is the sender ID.
 	From string `json:"gtxMessagingFrom"`
 	// To is the recipient phone number.
@@ -36,6 +38,13 @@ func (g GTXMessaging) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(g.Base, false), formatNotification(g.GTXMessagingDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (g GTXMessaging) Redacted() GTXMessaging {
+	g.Base = g.Base.Redacted()
+
+	return secret.Redact(g)
+}
+
 // UnmarshalJSON unmarshals JSON data into a GTXMessaging notification.
 func (g *GTXMessaging) UnmarshalJSON(data []byte) error {
 	detail := GTXMessagingDetails{}
@@ -56,3 +65,13 @@ func (g *GTXMessaging) UnmarshalJSON(data []byte) error {
 func (g GTXMessaging) MarshalJSON() ([]byte, error) {
 	return marshalJSON(g.Base, g.GTXMessagingDetails)
 }
+
+// Validate checks the GTXMessaging notification configuration.
+func (g GTXMessaging) Validate() error {
+	check := newValidator(g.Base, g.Type())
+	check.required("gtxMessagingApiKey", g.APIKey)
+	check.required("gtxMessagingFrom", g.From)
+	check.requiredPhone("gtxMessagingTo", g.To)
+
+	return check.err()
+}
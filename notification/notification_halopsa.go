@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // HaloPSA represents a HaloPSA notification.
@@ -14,7 +16,7 @@ type HaloPSA struct {
 type HaloPSADetails struct {
 	WebhookURL string `json:"halowebhookurl"`
 	Username   string `json:"haloUsername"`
-	Password   string `json:"haloPassword"`
+	Password   string `json:"haloPassword" kuma:"secret"`
 }
 
 // Type returns the notification type.
@@ -32,6 +34,13 @@ func (h HaloPSA) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(h.Base, false), formatNotification(h.HaloPSADetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (h HaloPSA) Redacted() HaloPSA {
+	h.Base = h.Base.Redacted()
+
+	return secret.Redact(h)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (h *HaloPSA) UnmarshalJSON(data []byte) error {
 	detail := HaloPSADetails{}
@@ -52,3 +61,11 @@ func (h *HaloPSA) UnmarshalJSON(data []byte) error {
 func (h HaloPSA) MarshalJSON() ([]byte, error) {
 	return marshalJSON(h.Base, &h.HaloPSADetails)
 }
+
+// Validate checks the HaloPSA notification configuration.
+func (h HaloPSA) Validate() error {
+	check := newValidator(h.Base, h.Type())
+	check.requiredURL("halowebhookurl", h.WebhookURL)
+
+	return check.err()
+}
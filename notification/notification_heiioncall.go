@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // HeiiOnCall represents a Heii On-Call notification provider.
@@ -14,7 +16,7 @@ type HeiiOnCall struct {
 // HeiiOnCallDetails contains the configuration fields for Heii On-Call notifications.
 type HeiiOnCallDetails struct {
 	// APIKey is the Heii On-Call API key for authentication.
-	APIKey string `json:"heiiOnCallApiKey"`
+	APIKey string `json:"heiiOnCallApiKey" kuma:"secret"`
 	// TriggerID is the Heii On-Call trigger ID.
 	TriggerID string `json:"heiiOnCallTriggerId"`
 }
@@ -34,6 +36,13 @@ func (h HeiiOnCall) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(h.Base, false), formatNotification(h.HeiiOnCallDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (h HeiiOnCall) Redacted() HeiiOnCall {
+	h.Base = h.Base.Redacted()
+
+	return secret.Redact(h)
+}
+
 // UnmarshalJSON unmarshals JSON data into a HeiiOnCall notification.
 func (h *HeiiOnCall) UnmarshalJSON(data []byte) error {
 	detail := HeiiOnCallDetails{}
@@ -54,3 +63,12 @@ func (h *HeiiOnCall) UnmarshalJSON(data []byte) error {
 func (h HeiiOnCall) MarshalJSON() ([]byte, error) {
 	return marshalJSON(h.Base, h.HeiiOnCallDetails)
 }
+
+// Validate checks the HeiiOnCall notification configuration.
+func (h HeiiOnCall) Validate() error {
+	check := newValidator(h.Base, h.Type())
+	check.required("heiiOnCallApiKey", h.APIKey)
+	check.required("heiiOnCallTriggerId", h.TriggerID)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // HomeAssistant represents a homeassistant notification.
@@ -13,7 +15,7 @@ type HomeAssistant struct {
 // HomeAssistantDetails contains homeassistant-specific notification configuration.
 type HomeAssistantDetails struct {
 	HomeAssistantURL     string `json:"homeAssistantUrl"`
-	LongLivedAccessToken string `json:"longLivedAccessToken"`
+	LongLivedAccessToken string `json:"longLivedAccessToken" kuma:"secret"`
 	NotificationService  string `json:"notificationService"`
 }
 
@@ -32,6 +34,13 @@ func (h HomeAssistant) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(h.Base, false), formatNotification(h.HomeAssistantDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (h HomeAssistant) Redacted() HomeAssistant {
+	h.Base = h.Base.Redacted()
+
+	return secret.Redact(h)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (h *HomeAssistant) UnmarshalJSON(data []byte) error {
 	detail := HomeAssistantDetails{}
@@ -52,3 +61,12 @@ func (h *HomeAssistant) UnmarshalJSON(data []byte) error {
 func (h HomeAssistant) MarshalJSON() ([]byte, error) {
 	return marshalJSON(h.Base, &h.HomeAssistantDetails)
 }
+
+// Validate checks the HomeAssistant notification configuration.
+func (h HomeAssistant) Validate() error {
+	check := newValidator(h.Base, h.Type())
+	check.requiredURL("homeAssistantUrl", h.HomeAssistantURL)
+	check.required("longLivedAccessToken", h.LongLivedAccessToken)
+
+	return check.err()
+}
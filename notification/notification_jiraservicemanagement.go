@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // JiraServiceManagement represents a Jira Service Management notification.
@@ -14,7 +16,7 @@ type JiraServiceManagement struct {
 type JiraServiceManagementDetails struct {
 	CloudID  string `json:"jsmCloudId"`
 	Email    string `json:"jsmEmail"`
-	APIToken string `json:"jsmApiToken"`
+	APIToken string `json:"jsmApiToken" kuma:"secret"`
 	Priority int    `json:"jsmPriority"`
 }
 
@@ -42,6 +44,13 @@ func (j JiraServiceManagement) String() string {
 	)
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (j JiraServiceManagement) Redacted() JiraServiceManagement {
+	j.Base = j.Base.Redacted()
+
+	return secret.Redact(j)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (j *JiraServiceManagement) UnmarshalJSON(data []byte) error {
 	detail := JiraServiceManagementDetails{}
@@ -62,3 +71,14 @@ func (j *JiraServiceManagement) UnmarshalJSON(data []byte) error {
 func (j JiraServiceManagement) MarshalJSON() ([]byte, error) {
 	return marshalJSON(j.Base, &j.JiraServiceManagementDetails)
 }
+
+// Validate checks the JiraServiceManagement notification configuration.
+func (j JiraServiceManagement) Validate() error {
+	check := newValidator(j.Base, j.Type())
+	check.required("jsmCloudId", j.CloudID)
+	check.requiredEmail("jsmEmail", j.Email)
+	check.required("jsmApiToken", j.APIToken)
+	check.intRange("jsmPriority", int64(j.Priority), 1, 5)
+
+	return check.err()
+}
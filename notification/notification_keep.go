@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Keep represents a Keep (Google) notification provider.
@@ -16,7 +18,7 @@ type KeepDetails struct {
 	// WebhookURL is the Keep webhook URL.
 	WebhookURL string `json:"webhookURL"`
 	// APIKey is the Keep API key for authentication.
-	APIKey string `json:"webhookAPIKey"`
+	APIKey string `json:"webhookAPIKey" kuma:"secret"`
 }
 
 // Type returns the notification type identifier for Keep.
@@ -34,6 +36,13 @@ func (k Keep) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(k.Base, false), formatNotification(k.KeepDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (k Keep) Redacted() Keep {
+	k.Base = k.Base.Redacted()
+
+	return secret.Redact(k)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Keep notification.
 func (k *Keep) UnmarshalJSON(data []byte) error {
 	detail := KeepDetails{}
@@ -54,3 +63,12 @@ func (k *Keep) UnmarshalJSON(data []byte) error {
 func (k Keep) MarshalJSON() ([]byte, error) {
 	return marshalJSON(k.Base, k.KeepDetails)
 }
+
+// Validate checks the Keep notification configuration.
+func (k Keep) Validate() error {
+	check := newValidator(k.Base, k.Type())
+	check.requiredURL("webhookURL", k.WebhookURL)
+	check.required("webhookAPIKey", k.APIKey)
+
+	return check.err()
+}
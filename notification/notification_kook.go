@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Kook represents a KOOK (formerly KaiHeiLa) notification provider.
@@ -14,7 +16,7 @@ type Kook struct {
 // KookDetails contains the configuration fields for KOOK notifications.
 type KookDetails struct {
 	// BotToken is the KOOK bot token for authentication.
-	BotToken string `json:"kookBotToken"`
+	BotToken string `json:"kookBotToken" kuma:"secret"`
 	// GuildID is the KOOK guild/server ID where messages will be sent.
 	GuildID string `json:"kookGuildID"`
 }
@@ -34,6 +36,13 @@ func (k Kook) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(k.Base, false), formatNotification(k.KookDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (k Kook) Redacted() Kook {
+	k.Base = k.Base.Redacted()
+
+	return secret.Redact(k)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Kook notification.
 func (k *Kook) UnmarshalJSON(data []byte) error {
 	detail := KookDetails{}
@@ -54,3 +63,12 @@ func (k *Kook) UnmarshalJSON(data []byte) error {
 func (k Kook) MarshalJSON() ([]byte, error) {
 	return marshalJSON(k.Base, k.KookDetails)
 }
+
+// Validate checks the Kook notification configuration.
+func (k Kook) Validate() error {
+	check := newValidator(k.Base, k.Type())
+	check.required("kookBotToken", k.BotToken)
+	check.required("kookGuildID", k.GuildID)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Line represents a LINE Messaging API notification provider.
@@ -14,7 +16,7 @@ type Line struct {
 // LineDetails contains the configuration fields for LINE notifications.
 type LineDetails struct {
 	// ChannelAccessToken is the LINE channel access token for authentication.
-	ChannelAccessToken string `json:"lineChannelAccessToken"`
+	ChannelAccessToken string `json:"lineChannelAccessToken" kuma:"secret"`
 	// UserID is the LINE user ID to send messages to.
 	UserID string `json:"lineUserID"`
 }
@@ -34,6 +36,13 @@ func (l Line) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(l.Base, false), formatNotification(l.LineDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (l Line) Redacted() Line {
+	l.Base = l.Base.Redacted()
+
+	return secret.Redact(l)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Line notification.
 func (l *Line) UnmarshalJSON(data []byte) error {
 	detail := LineDetails{}
@@ -54,3 +63,12 @@ func (l *Line) UnmarshalJSON(data []byte) error {
 func (l Line) MarshalJSON() ([]byte, error) {
 	return marshalJSON(l.Base, l.LineDetails)
 }
+
+// Validate checks the Line notification configuration.
+func (l Line) Validate() error {
+	check := newValidator(l.Base, l.Type())
+	check.required("lineChannelAccessToken", l.ChannelAccessToken)
+	check.required("lineUserID", l.LineDetails.UserID)
+
+	return check.err()
+}
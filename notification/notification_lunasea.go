@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // LunaSea represents a LunaSea notification provider.
@@ -36,6 +38,13 @@ func (l LunaSea) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(l.Base, false), formatNotification(l.LunaSeaDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (l LunaSea) Redacted() LunaSea {
+	l.Base = l.Base.Redacted()
+
+	return secret.Redact(l)
+}
+
 // UnmarshalJSON unmarshals JSON data into a LunaSea notification.
 func (l *LunaSea) UnmarshalJSON(data []byte) error {
 	detail := LunaSeaDetails{}
@@ -56,3 +65,19 @@ func (l *LunaSea) UnmarshalJSON(data []byte) error {
 func (l LunaSea) MarshalJSON() ([]byte, error) {
 	return marshalJSON(l.Base, l.LunaSeaDetails)
 }
+
+// Validate checks the LunaSea notification configuration.
+func (l LunaSea) Validate() error {
+	check := newValidator(l.Base, l.Type())
+	check.oneOf("lunaseaTarget", l.Target, "user", "device")
+
+	if l.Target == "user" {
+		check.required("lunaseaUserID", l.LunaSeaUserID)
+	}
+
+	if l.Target == "device" {
+		check.required("lunaseaDevice", l.Device)
+	}
+
+	return check.err()
+}
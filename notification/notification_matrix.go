@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Matrix represents a matrix notification.
@@ -14,7 +16,7 @@ type Matrix struct {
 type MatrixDetails struct {
 	HomeserverURL  string `json:"homeserverUrl"`
 	InternalRoomID string `json:"internalRoomId"`
-	AccessToken    string `json:"accessToken"`
+	AccessToken    string `json:"accessToken" kuma:"secret"`
 	UseTemplate    bool   `json:"matrixUseTemplate"`
 	Template       string `json:"matrixTemplate"`
 }
@@ -34,6 +36,13 @@ func (m Matrix) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(m.Base, false), formatNotification(m.MatrixDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (m Matrix) Redacted() Matrix {
+	m.Base = m.Base.Redacted()
+
+	return secret.Redact(m)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (m *Matrix) UnmarshalJSON(data []byte) error {
 	detail := MatrixDetails{}
@@ -54,3 +63,13 @@ func (m *Matrix) UnmarshalJSON(data []byte) error {
 func (m Matrix) MarshalJSON() ([]byte, error) {
 	return marshalJSON(m.Base, &m.MatrixDetails)
 }
+
+// Validate checks the Matrix notification configuration.
+func (m Matrix) Validate() error {
+	check := newValidator(m.Base, m.Type())
+	check.requiredURL("homeserverUrl", m.HomeserverURL)
+	check.required("internalRoomId", m.InternalRoomID)
+	check.required("accessToken", m.AccessToken)
+
+	return check.err()
+}
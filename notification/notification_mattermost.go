@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Mattermost represents a mattermost notification.
@@ -34,6 +36,13 @@ func (m Mattermost) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(m.Base, false), formatNotification(m.MattermostDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (m Mattermost) Redacted() Mattermost {
+	m.Base = m.Base.Redacted()
+
+	return secret.Redact(m)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (m *Mattermost) UnmarshalJSON(data []byte) error {
 	detail := MattermostDetails{}
@@ -54,3 +63,12 @@ func (m *Mattermost) UnmarshalJSON(data []byte) error {
 func (m Mattermost) MarshalJSON() ([]byte, error) {
 	return marshalJSON(m.Base, &m.MattermostDetails)
 }
+
+// Validate checks the Mattermost notification configuration.
+func (m Mattermost) Validate() error {
+	check := newValidator(m.Base, m.Type())
+	check.requiredURL("mattermostWebhookUrl", m.WebhookURL)
+	check.url("mattermosticonurl", m.IconURL)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Max represents a MAX messenger notification.
@@ -13,7 +15,7 @@ type Max struct {
 // MaxDetails contains MAX messenger-specific notification configuration.
 type MaxDetails struct {
 	APIURL         string `json:"maxApiUrl"`
-	BotToken       string `json:"maxBotToken"`
+	BotToken       string `json:"maxBotToken" kuma:"secret"`
 	ChatID         string `json:"maxChatID"`
 	UseTemplate    bool   `json:"maxUseTemplate"`
 	Template       string `json:"maxTemplate"`
@@ -35,6 +37,13 @@ func (m Max) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(m.Base, false), formatNotification(m.MaxDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (m Max) Redacted() Max {
+	m.Base = m.Base.Redacted()
+
+	return secret.Redact(m)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (m *Max) UnmarshalJSON(data []byte) error {
 	detail := MaxDetails{}
@@ -55,3 +64,13 @@ func (m *Max) UnmarshalJSON(data []byte) error {
 func (m Max) MarshalJSON() ([]byte, error) {
 	return marshalJSON(m.Base, &m.MaxDetails)
 }
+
+// Validate checks the Max notification configuration.
+func (m Max) Validate() error {
+	check := newValidator(m.Base, m.Type())
+	check.url("maxApiUrl", m.APIURL)
+	check.required("maxBotToken", m.BotToken)
+	check.required("maxChatID", m.ChatID)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // NextcloudTalk represents a Nextcloud Talk notification provider.
@@ -18,7 +20,7 @@ type NextcloudTalkDetails struct {
 	// ConversationToken is the conversation/room token for the target chat.
 	ConversationToken string `json:"conversationToken"`
 	// BotSecret is the bot secret for authentication and message signing.
-	BotSecret string `json:"botSecret"`
+	BotSecret string `json:"botSecret" kuma:"secret"`
 	// SendSilentUp determines if UP notifications should be sent silently.
 	SendSilentUp bool `json:"sendSilentUp"`
 	// SendSilentDown determines if DOWN notifications should be sent silently.
@@ -40,6 +42,13 @@ func (n NextcloudTalk) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(n.Base, false), formatNotification(n.NextcloudTalkDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (n NextcloudTalk) Redacted() NextcloudTalk {
+	n.Base = n.Base.Redacted()
+
+	return secret.Redact(n)
+}
+
 // UnmarshalJSON unmarshals JSON data into a NextcloudTalk notification.
 func (n *NextcloudTalk) UnmarshalJSON(data []byte) error {
 	detail := NextcloudTalkDetails{}
@@ -60,3 +69,13 @@ func (n *NextcloudTalk) UnmarshalJSON(data []byte) error {
 func (n NextcloudTalk) MarshalJSON() ([]byte, error) {
 	return marshalJSON(n.Base, n.NextcloudTalkDetails)
 }
+
+// Validate checks the NextcloudTalk notification configuration.
+func (n NextcloudTalk) Validate() error {
+	check := newValidator(n.Base, n.Type())
+	check.requiredURL("host", n.Host)
+	check.required("conversationToken", n.ConversationToken)
+	check.required("botSecret", n.BotSecret)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Nostr represents a Nostr notification provider.
@@ -14,7 +16,7 @@ type Nostr struct {
 // NostrDetails contains the configuration fields for Nostr notifications.
 type NostrDetails struct {
 	// Sender is the sender's private key in Nostr format (nsec encoded).
-	Sender string `json:"sender"`
+	Sender string `json:"sender" kuma:"secret"`
 	// Recipients is a newline-delimited list of recipient public keys (npub encoded).
 	Recipients string `json:"recipients"`
 	// Relays is a newline-delimited list of Nostr relay URLs.
@@ -36,6 +38,13 @@ func (n Nostr) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(n.Base, false), formatNotification(n.NostrDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (n Nostr) Redacted() Nostr {
+	n.Base = n.Base.Redacted()
+
+	return secret.Redact(n)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Nostr notification.
 func (n *Nostr) UnmarshalJSON(data []byte) error {
 	detail := NostrDetails{}
@@ -56,3 +65,13 @@ func (n *Nostr) UnmarshalJSON(data []byte) error {
 func (n Nostr) MarshalJSON() ([]byte, error) {
 	return marshalJSON(n.Base, n.NostrDetails)
 }
+
+// Validate checks the Nostr notification configuration.
+func (n Nostr) Validate() error {
+	check := newValidator(n.Base, n.Type())
+	check.required("sender", n.Sender)
+	check.required("recipients", n.Recipients)
+	check.required("relays", n.Relays)
+
+	return check.err()
+}
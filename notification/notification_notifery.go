@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Notifery represents a Notifery notification provider.
@@ -14,7 +16,7 @@ type Notifery struct {
 // NotiferyDetails contains the configuration fields for Notifery notifications.
 type NotiferyDetails struct {
 	// APIKey is the API key for authentication with Notifery service.
-	APIKey string `json:"notiferyApiKey"`
+	APIKey string `json:"notiferyApiKey" kuma:"secret"`
 	// Title is the title of the notification (default: "Uptime Kuma Alert").
 	Title string `json:"notiferyTitle"`
 	// Group is the notification group for organizing notifications.
@@ -36,6 +38,13 @@ func (n Notifery) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(n.Base, false), formatNotification(n.NotiferyDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (n Notifery) Redacted() Notifery {
+	n.Base = n.Base.Redacted()
+
+	return secret.Redact(n)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Notifery notification.
 func (n *Notifery) UnmarshalJSON(data []byte) error {
 	detail := NotiferyDetails{}
@@ -56,3 +65,11 @@ func (n *Notifery) UnmarshalJSON(data []byte) error {
 func (n Notifery) MarshalJSON() ([]byte, error) {
 	return marshalJSON(n.Base, n.NotiferyDetails)
 }
+
+// Validate checks the Notifery notification configuration.
+func (n Notifery) Validate() error {
+	check := newValidator(n.Base, n.Type())
+	check.required("notiferyApiKey", n.APIKey)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Ntfy represents a ntfy notification.
@@ -12,13 +14,13 @@ type Ntfy struct {
 
 // NtfyDetails contains ntfy-specific notification configuration.
 type NtfyDetails struct {
-	AccessToken          string  `json:"ntfyaccesstoken"`
+	AccessToken          string  `json:"ntfyaccesstoken" kuma:"secret"`
 	AuthenticationMethod string  `json:"ntfyAuthenticationMethod"`
 	Call                 *string `json:"ntfyCall,omitempty"`
 	CustomMessage        *string `json:"ntfyCustomMessage,omitempty"`
 	CustomTitle          *string `json:"ntfyCustomTitle,omitempty"`
 	Icon                 string  `json:"ntfyIcon"`
-	Password             string  `json:"ntfypassword"`
+	Password             string  `json:"ntfypassword" kuma:"secret"`
 	Priority             int64   `json:"ntfyPriority"`
 	PriorityDown         int64   `json:"ntfyPriorityDown,omitempty"`
 	ServerURL            string  `json:"ntfyserverurl"`
@@ -42,6 +44,13 @@ func (n Ntfy) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(n.Base, false), formatNotification(n.NtfyDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (n Ntfy) Redacted() Ntfy {
+	n.Base = n.Base.Redacted()
+
+	return secret.Redact(n)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (n *Ntfy) UnmarshalJSON(data []byte) error {
 	detail := NtfyDetails{}
@@ -62,3 +71,30 @@ func (n *Ntfy) UnmarshalJSON(data []byte) error {
 func (n Ntfy) MarshalJSON() ([]byte, error) {
 	return marshalJSON(n.Base, &n.NtfyDetails)
 }
+
+// Validate checks the Ntfy notification configuration.
+func (n Ntfy) Validate() error {
+	check := newValidator(n.Base, n.Type())
+	check.requiredURL("ntfyserverurl", n.ServerURL)
+	check.required("ntfytopic", n.Topic)
+	check.intRange("ntfyPriority", n.Priority, 1, 5)
+
+	if n.PriorityDown != 0 {
+		check.intRange("ntfyPriorityDown", n.PriorityDown, 1, 5)
+	}
+
+	check.oneOf("ntfyAuthenticationMethod", n.AuthenticationMethod, "", "none", "usernamePassword", "accessToken")
+
+	switch n.AuthenticationMethod {
+	case "usernamePassword":
+		check.required("ntfyusername", n.Username)
+		check.required("ntfypassword", n.Password)
+
+	case "accessToken":
+		check.required("ntfyaccesstoken", n.AccessToken)
+
+	default:
+	}
+
+	return check.err()
+}
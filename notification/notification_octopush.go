@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Octopush represents an Octopush SMS notification provider.
@@ -16,7 +18,7 @@ type OctopushDetails struct {
 	// Version is the Octopush API version: "1" or "2" (default: "2").
 	Version string `json:"octopushVersion"`
 	// APIKey is the API key for Octopush V2 API authentication.
-	APIKey string `json:"octopushAPIKey"`
+	APIKey string `json:"octopushAPIKey" kuma:"secret"`
 	// Login is the login/username for Octopush V2 API authentication.
 	Login string `json:"octopushLogin"`
 	// PhoneNumber is the recipient phone number for Octopush V2 API.
@@ -28,7 +30,7 @@ type OctopushDetails struct {
 	// DMLogin is the login/username for Octopush V1 (Direct Mail) API.
 	DMLogin string `json:"octopushDMLogin"`
 	// DMAPIKey is the API key for Octopush V1 (Direct Mail) API.
-	DMAPIKey string `json:"octopushDMAPIKey"`
+	DMAPIKey string `json:"octopushDMAPIKey" kuma:"secret"`
 	// DMPhoneNumber is the recipient phone number for Octopush V1 API.
 	DMPhoneNumber string `json:"octopushDMPhoneNumber"`
 	// DMSMSType is the SMS type for Octopush V1 API.
@@ -52,6 +54,13 @@ func (o Octopush) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(o.Base, false), formatNotification(o.OctopushDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (o Octopush) Redacted() Octopush {
+	o.Base = o.Base.Redacted()
+
+	return secret.Redact(o)
+}
+
 // UnmarshalJSON unmarshals JSON data into an Octopush notification.
 func (o *Octopush) UnmarshalJSON(data []byte) error {
 	detail := OctopushDetails{}
@@ -72,3 +81,21 @@ func (o *Octopush) UnmarshalJSON(data []byte) error {
 func (o Octopush) MarshalJSON() ([]byte, error) {
 	return marshalJSON(o.Base, o.OctopushDetails)
 }
+
+// Validate checks the Octopush notification configuration.
+func (o Octopush) Validate() error {
+	check := newValidator(o.Base, o.Type())
+	check.oneOf("octopushVersion", o.Version, "", "1", "2")
+
+	if o.Version == "1" {
+		check.required("octopushDMLogin", o.DMLogin)
+		check.required("octopushDMAPIKey", o.DMAPIKey)
+		check.requiredPhone("octopushDMPhoneNumber", o.DMPhoneNumber)
+	} else {
+		check.required("octopushLogin", o.Login)
+		check.required("octopushAPIKey", o.APIKey)
+		check.requiredPhone("octopushPhoneNumber", o.PhoneNumber)
+	}
+
+	return check.err()
+}
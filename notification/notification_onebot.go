@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // OneBot represents a OneBot (QQ) notification provider.
@@ -16,7 +18,7 @@ type OneBotDetails struct {
 	// HTTPAddr is the HTTP address of the OneBot service (e.g., "http://localhost:5700").
 	HTTPAddr string `json:"httpAddr"`
 	// AccessToken is the access token for authentication with the OneBot service.
-	AccessToken string `json:"accessToken"`
+	AccessToken string `json:"accessToken" kuma:"secret"`
 	// MsgType is the message type: "group" for group messages or "private" for private messages.
 	MsgType string `json:"msgType"`
 	// ReceiverID is the QQ group ID (when MsgType is "group") or user ID (when MsgType is "private").
@@ -38,6 +40,13 @@ func (o OneBot) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(o.Base, false), formatNotification(o.OneBotDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (o OneBot) Redacted() OneBot {
+	o.Base = o.Base.Redacted()
+
+	return secret.Redact(o)
+}
+
 // UnmarshalJSON unmarshals JSON data into a OneBot notification.
 func (o *OneBot) UnmarshalJSON(data []byte) error {
 	detail := OneBotDetails{}
@@ -58,3 +67,13 @@ func (o *OneBot) UnmarshalJSON(data []byte) error {
 func (o OneBot) MarshalJSON() ([]byte, error) {
 	return marshalJSON(o.Base, o.OneBotDetails)
 }
+
+// Validate checks the OneBot notification configuration.
+func (o OneBot) Validate() error {
+	check := newValidator(o.Base, o.Type())
+	check.requiredURL("httpAddr", o.HTTPAddr)
+	check.oneOf("msgType", o.MsgType, "group", "private")
+	check.required("recieverId", o.ReceiverID)
+
+	return check.err()
+}
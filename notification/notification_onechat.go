@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // OneChat represents a OneChat notification provider.
@@ -14,7 +16,7 @@ type OneChat struct {
 // OneChatDetails contains the configuration fields for OneChat notifications.
 type OneChatDetails struct {
 	// AccessToken is the access token for authentication with OneChat API.
-	AccessToken string `json:"accessToken"`
+	AccessToken string `json:"accessToken" kuma:"secret"`
 	// ReceiverID is the recipient ID (user or group ID) in OneChat.
 	ReceiverID string `json:"recieverId"`
 	// BotID is the bot ID for sending messages through OneChat.
@@ -36,6 +38,13 @@ func (o OneChat) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(o.Base, false), formatNotification(o.OneChatDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (o OneChat) Redacted() OneChat {
+	o.Base = o.Base.Redacted()
+
+	return secret.Redact(o)
+}
+
 // UnmarshalJSON unmarshals JSON data into a OneChat notification.
 func (o *OneChat) UnmarshalJSON(data []byte) error {
 	detail := OneChatDetails{}
@@ -56,3 +65,13 @@ func (o *OneChat) UnmarshalJSON(data []byte) error {
 func (o OneChat) MarshalJSON() ([]byte, error) {
 	return marshalJSON(o.Base, o.OneChatDetails)
 }
+
+// Validate checks the OneChat notification configuration.
+func (o OneChat) Validate() error {
+	check := newValidator(o.Base, o.Type())
+	check.required("accessToken", o.AccessToken)
+	check.required("recieverId", o.ReceiverID)
+	check.required("botId", o.BotID)
+
+	return check.err()
+}
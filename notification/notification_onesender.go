@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // OneSender represents a OneSender WhatsApp notification provider.
@@ -16,7 +18,7 @@ type OneSenderDetails struct {
 	// URL is the OneSender API endpoint URL for sending messages.
 	URL string `json:"onesenderURL"`
 	// Token is the API token for authentication with OneSender service.
-	Token string `json:"onesenderToken"`
+	Token string `json:"onesenderToken" kuma:"secret"`
 	// Receiver is the recipient identifier (phone number or group ID).
 	Receiver string `json:"onesenderReceiver"`
 	// TypeReceiver is the type of receiver: "private" for individual or "group" for group.
@@ -38,6 +40,13 @@ func (o OneSender) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(o.Base, false), formatNotification(o.OneSenderDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (o OneSender) Redacted() OneSender {
+	o.Base = o.Base.Redacted()
+
+	return secret.Redact(o)
+}
+
 // UnmarshalJSON unmarshals JSON data into a OneSender notification.
 func (o *OneSender) UnmarshalJSON(data []byte) error {
 	detail := OneSenderDetails{}
@@ -58,3 +67,14 @@ func (o *OneSender) UnmarshalJSON(data []byte) error {
 func (o OneSender) MarshalJSON() ([]byte, error) {
 	return marshalJSON(o.Base, o.OneSenderDetails)
 }
+
+// Validate checks the OneSender notification configuration.
+func (o OneSender) Validate() error {
+	check := newValidator(o.Base, o.Type())
+	check.requiredURL("onesenderURL", o.URL)
+	check.required("onesenderToken", o.Token)
+	check.required("onesenderReceiver", o.Receiver)
+	check.oneOf("onesenderTypeReceiver", o.TypeReceiver, "private", "group")
+
+	return check.err()
+}
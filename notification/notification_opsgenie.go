@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Opsgenie represents a opsgenie notification.
@@ -12,7 +14,7 @@ type Opsgenie struct {
 
 // OpsgenieDetails contains opsgenie-specific notification configuration.
 type OpsgenieDetails struct {
-	APIKey   string `json:"opsgenieApiKey"`
+	APIKey   string `json:"opsgenieApiKey" kuma:"secret"`
 	Region   string `json:"opsgenieRegion"`
 	Priority int    `json:"opsgeniePriority"`
 }
@@ -32,6 +34,13 @@ func (o Opsgenie) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(o.Base, false), formatNotification(o.OpsgenieDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (o Opsgenie) Redacted() Opsgenie {
+	o.Base = o.Base.Redacted()
+
+	return secret.Redact(o)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (o *Opsgenie) UnmarshalJSON(data []byte) error {
 	detail := OpsgenieDetails{}
@@ -52,3 +61,16 @@ func (o *Opsgenie) UnmarshalJSON(data []byte) error {
 func (o Opsgenie) MarshalJSON() ([]byte, error) {
 	return marshalJSON(o.Base, &o.OpsgenieDetails)
 }
+
+// Validate checks the Opsgenie notification configuration.
+func (o Opsgenie) Validate() error {
+	check := newValidator(o.Base, o.Type())
+	check.required("opsgenieApiKey", o.APIKey)
+	check.oneOf("opsgenieRegion", o.Region, "us", "eu")
+
+	if o.Priority != 0 {
+		check.intRange("opsgeniePriority", int64(o.Priority), 1, 5)
+	}
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // PagerDuty represents a pagerduty notification.
@@ -13,7 +15,7 @@ type PagerDuty struct {
 // PagerDutyDetails contains pagerduty-specific notification configuration.
 type PagerDutyDetails struct {
 	IntegrationURL string `json:"pagerdutyIntegrationUrl"`
-	IntegrationKey string `json:"pagerdutyIntegrationKey"`
+	IntegrationKey string `json:"pagerdutyIntegrationKey" kuma:"secret"`
 	Priority       string `json:"pagerdutyPriority"`
 	AutoResolve    string `json:"pagerdutyAutoResolve"`
 }
@@ -33,6 +35,13 @@ func (p PagerDuty) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(p.Base, false), formatNotification(p.PagerDutyDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (p PagerDuty) Redacted() PagerDuty {
+	p.Base = p.Base.Redacted()
+
+	return secret.Redact(p)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (p *PagerDuty) UnmarshalJSON(data []byte) error {
 	detail := PagerDutyDetails{}
@@ -53,3 +62,13 @@ func (p *PagerDuty) UnmarshalJSON(data []byte) error {
 func (p PagerDuty) MarshalJSON() ([]byte, error) {
 	return marshalJSON(p.Base, &p.PagerDutyDetails)
 }
+
+// Validate checks the PagerDuty notification configuration.
+func (p PagerDuty) Validate() error {
+	check := newValidator(p.Base, p.Type())
+	check.requiredURL("pagerdutyIntegrationUrl", p.IntegrationURL)
+	check.required("pagerdutyIntegrationKey", p.IntegrationKey)
+	check.oneOf("pagerdutyPriority", p.Priority, "", "info", "warning", "error", "critical")
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // PagerTree represents a PagerTree notification provider.
@@ -37,6 +39,13 @@ func (p PagerTree) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(p.Base, false), formatNotification(p.PagerTreeDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (p PagerTree) Redacted() PagerTree {
+	p.Base = p.Base.Redacted()
+
+	return secret.Redact(p)
+}
+
 // UnmarshalJSON unmarshals JSON data into a PagerTree notification.
 func (p *PagerTree) UnmarshalJSON(data []byte) error {
 	detail := PagerTreeDetails{}
@@ -57,3 +66,13 @@ func (p *PagerTree) UnmarshalJSON(data []byte) error {
 func (p PagerTree) MarshalJSON() ([]byte, error) {
 	return marshalJSON(p.Base, p.PagerTreeDetails)
 }
+
+// Validate checks the PagerTree notification configuration.
+func (p PagerTree) Validate() error {
+	check := newValidator(p.Base, p.Type())
+	check.requiredURL("pagertreeIntegrationUrl", p.IntegrationURL)
+	check.oneOf("pagertreeUrgency", p.Urgency, "", "silent", "low", "medium", "high", "critical")
+	check.oneOf("pagertreeAutoResolve", p.AutoResolve, "", "resolve")
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // PromoSMS represents a PromoSMS notification provider.
@@ -16,7 +18,7 @@ type PromoSMSDetails struct {
 	// Login is the PromoSMS login/username for authentication.
 	Login string `json:"promosmsLogin"`
 	// Password is the PromoSMS password for authentication.
-	Password string `json:"promosmsPassword"`
+	Password string `json:"promosmsPassword" kuma:"secret"`
 	// PhoneNumber is the recipient phone number for SMS messages.
 	PhoneNumber string `json:"promosmsPhoneNumber"`
 	// SenderName is the sender name/ID displayed in the SMS.
@@ -43,6 +45,13 @@ func (p PromoSMS) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(p.Base, false), formatNotification(p.PromoSMSDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (p PromoSMS) Redacted() PromoSMS {
+	p.Base = p.Base.Redacted()
+
+	return secret.Redact(p)
+}
+
 // UnmarshalJSON unmarshals JSON data into a PromoSMS notification.
 func (p *PromoSMS) UnmarshalJSON(data []byte) error {
 	detail := PromoSMSDetails{}
@@ -63,3 +72,14 @@ func (p *PromoSMS) UnmarshalJSON(data []byte) error {
 func (p PromoSMS) MarshalJSON() ([]byte, error) {
 	return marshalJSON(p.Base, p.PromoSMSDetails)
 }
+
+// Validate checks the PromoSMS notification configuration.
+func (p PromoSMS) Validate() error {
+	check := newValidator(p.Base, p.Type())
+	check.required("promosmsLogin", p.Login)
+	check.required("promosmsPassword", p.Password)
+	check.requiredPhone("promosmsPhoneNumber", p.PhoneNumber)
+	check.required("promosmsSenderName", p.SenderName)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Pumble represents a Pumble notification provider.
@@ -31,6 +33,13 @@ func (p Pumble) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(p.Base, false), formatNotification(p.PumbleDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (p Pumble) Redacted() Pumble {
+	p.Base = p.Base.Redacted()
+
+	return secret.Redact(p)
+}
+
 // UnmarshalJSON deserializes a Pumble notification from JSON.
 func (p *Pumble) UnmarshalJSON(data []byte) error {
 	detail := PumbleDetails{}
@@ -51,3 +60,11 @@ func (p *Pumble) UnmarshalJSON(data []byte) error {
 func (p Pumble) MarshalJSON() ([]byte, error) {
 	return marshalJSON(p.Base, p.PumbleDetails)
 }
+
+// Validate checks the Pumble notification configuration.
+func (p Pumble) Validate() error {
+	check := newValidator(p.Base, p.Type())
+	check.requiredURL("webhookURL", p.WebhookURL)
+
+	return check.err()
+}
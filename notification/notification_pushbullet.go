@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Pushbullet represents a pushbullet notification.
@@ -12,7 +14,7 @@ type Pushbullet struct {
 
 // PushbulletDetails contains pushbullet-specific notification configuration.
 type PushbulletDetails struct {
-	AccessToken string `json:"pushbulletAccessToken"`
+	AccessToken string `json:"pushbulletAccessToken" kuma:"secret"`
 }
 
 // Type returns the notification type.
@@ -30,6 +32,13 @@ func (p Pushbullet) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(p.Base, false), formatNotification(p.PushbulletDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (p Pushbullet) Redacted() Pushbullet {
+	p.Base = p.Base.Redacted()
+
+	return secret.Redact(p)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (p *Pushbullet) UnmarshalJSON(data []byte) error {
 	detail := PushbulletDetails{}
@@ -50,3 +59,11 @@ func (p *Pushbullet) UnmarshalJSON(data []byte) error {
 func (p Pushbullet) MarshalJSON() ([]byte, error) {
 	return marshalJSON(p.Base, &p.PushbulletDetails)
 }
+
+// Validate checks the Pushbullet notification configuration.
+func (p Pushbullet) Validate() error {
+	check := newValidator(p.Base, p.Type())
+	check.required("pushbulletAccessToken", p.AccessToken)
+
+	return check.err()
+}
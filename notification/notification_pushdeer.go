@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // PushDeer represents a PushDeer notification provider.
@@ -13,8 +15,8 @@ type PushDeer struct {
 
 // PushDeerDetails contains the PushDeer-specific configuration.
 type PushDeerDetails struct {
-	Key    string `json:"pushdeerKey"`    // PushDeer push key
-	Server string `json:"pushdeerServer"` // Custom server URL (optional, defaults to https://api2.pushdeer.com)
+	Key    string `json:"pushdeerKey" kuma:"secret"` // PushDeer push key
+	Server string `json:"pushdeerServer"`            // Custom server URL (optional, defaults to https://api2.pushdeer.com)
 }
 
 // Type returns the notification type identifier for PushDeer.
@@ -32,6 +34,13 @@ func (p PushDeer) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(p.Base, false), formatNotification(p.PushDeerDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (p PushDeer) Redacted() PushDeer {
+	p.Base = p.Base.Redacted()
+
+	return secret.Redact(p)
+}
+
 // UnmarshalJSON deserializes a PushDeer notification from JSON.
 func (p *PushDeer) UnmarshalJSON(data []byte) error {
 	detail := PushDeerDetails{}
@@ -52,3 +61,12 @@ func (p *PushDeer) UnmarshalJSON(data []byte) error {
 func (p PushDeer) MarshalJSON() ([]byte, error) {
 	return marshalJSON(p.Base, p.PushDeerDetails)
 }
+
+// Validate checks the PushDeer notification configuration.
+func (p PushDeer) Validate() error {
+	check := newValidator(p.Base, p.Type())
+	check.required("pushdeerKey", p.Key)
+	check.url("pushdeerServer", p.Server)
+
+	return check.err()
+}
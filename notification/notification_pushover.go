@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Pushover represents a pushover notification.
@@ -12,8 +14,8 @@ type Pushover struct {
 
 // PushoverDetails contains pushover-specific notification configuration.
 type PushoverDetails struct {
-	UserKey  string `json:"pushoveruserkey"`
-	AppToken string `json:"pushoverapptoken"`
+	UserKey  string `json:"pushoveruserkey" kuma:"secret"`
+	AppToken string `json:"pushoverapptoken" kuma:"secret"`
 	Sounds   string `json:"pushoversounds"`
 	SoundsUp string `json:"pushoversounds_up"`
 	Priority string `json:"pushoverpriority"`
@@ -37,6 +39,13 @@ func (p Pushover) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(p.Base, false), formatNotification(p.PushoverDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (p Pushover) Redacted() Pushover {
+	p.Base = p.Base.Redacted()
+
+	return secret.Redact(p)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (p *Pushover) UnmarshalJSON(data []byte) error {
 	detail := PushoverDetails{}
@@ -57,3 +66,13 @@ func (p *Pushover) UnmarshalJSON(data []byte) error {
 func (p Pushover) MarshalJSON() ([]byte, error) {
 	return marshalJSON(p.Base, &p.PushoverDetails)
 }
+
+// Validate checks the Pushover notification configuration.
+func (p Pushover) Validate() error {
+	check := newValidator(p.Base, p.Type())
+	check.required("pushoveruserkey", p.UserKey)
+	check.required("pushoverapptoken", p.AppToken)
+	check.oneOf("pushoverpriority", p.Priority, "", "-2", "-1", "0", "1", "2")
+
+	return check.err()
+}
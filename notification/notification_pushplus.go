@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // PushPlus represents a PushPlus notification provider.
@@ -13,7 +15,7 @@ type PushPlus struct {
 
 // PushPlusDetails contains the PushPlus-specific configuration.
 type PushPlusDetails struct {
-	SendKey string `json:"pushPlusSendKey"` // PushPlus send key for authentication
+	SendKey string `json:"pushPlusSendKey" kuma:"secret"` // PushPlus send key for authentication
 }
 
 // Type returns the notification type identifier for PushPlus.
@@ -31,6 +33,13 @@ func (p PushPlus) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(p.Base, false), formatNotification(p.PushPlusDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (p PushPlus) Redacted() PushPlus {
+	p.Base = p.Base.Redacted()
+
+	return secret.Redact(p)
+}
+
 // UnmarshalJSON deserializes a PushPlus notification from JSON.
 func (p *PushPlus) UnmarshalJSON(data []byte) error {
 	detail := PushPlusDetails{}
@@ -51,3 +60,11 @@ func (p *PushPlus) UnmarshalJSON(data []byte) error {
 func (p PushPlus) MarshalJSON() ([]byte, error) {
 	return marshalJSON(p.Base, p.PushPlusDetails)
 }
+
+// Validate checks the PushPlus notification configuration.
+func (p PushPlus) Validate() error {
+	check := newValidator(p.Base, p.Type())
+	check.required("pushPlusSendKey", p.SendKey)
+
+	return check.err()
+}
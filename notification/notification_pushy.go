@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Pushy represents a Pushy notification provider.
@@ -13,8 +15,8 @@ type Pushy struct {
 
 // PushyDetails contains the Pushy-specific configuration.
 type PushyDetails struct {
-	APIKey string `json:"pushyAPIKey"` // Pushy API secret key
-	Token  string `json:"pushyToken"`  // Device token
+	APIKey string `json:"pushyAPIKey" kuma:"secret"` // Pushy API secret key
+	Token  string `json:"pushyToken"`                // Device token
 }
 
 // Type returns the notification type identifier for Pushy.
@@ -32,6 +34,13 @@ func (p Pushy) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(p.Base, false), formatNotification(p.PushyDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (p Pushy) Redacted() Pushy {
+	p.Base = p.Base.Redacted()
+
+	return secret.Redact(p)
+}
+
 // UnmarshalJSON deserializes a Pushy notification from JSON.
 func (p *Pushy) UnmarshalJSON(data []byte) error {
 	detail := PushyDetails{}
@@ -52,3 +61,12 @@ func (p *Pushy) UnmarshalJSON(data []byte) error {
 func (p Pushy) MarshalJSON() ([]byte, error) {
 	return marshalJSON(p.Base, p.PushyDetails)
 }
+
+// Validate checks the Pushy notification configuration.
+func (p Pushy) Validate() error {
+	check := newValidator(p.Base, p.Type())
+	check.required("pushyAPIKey", p.APIKey)
+	check.required("pushyToken", p.Token)
+
+	return check.err()
+}
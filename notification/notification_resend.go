@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Resend represents a Resend notification.
@@ -12,7 +14,7 @@ type Resend struct {
 
 // ResendDetails contains Resend-specific notification configuration.
 type ResendDetails struct {
-	APIKey    string `json:"resendApiKey"`
+	APIKey    string `json:"resendApiKey" kuma:"secret"`
 	FromEmail string `json:"resendFromEmail"`
 	FromName  string `json:"resendFromName,omitempty"`
 	// ToEmail is a comma-separated list of recipient email addresses.
@@ -35,6 +37,13 @@ func (r Resend) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(r.Base, false), formatNotification(r.ResendDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (r Resend) Redacted() Resend {
+	r.Base = r.Base.Redacted()
+
+	return secret.Redact(r)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (r *Resend) UnmarshalJSON(data []byte) error {
 	detail := ResendDetails{}
@@ -55,3 +64,14 @@ func (r *Resend) UnmarshalJSON(data []byte) error {
 func (r Resend) MarshalJSON() ([]byte, error) {
 	return marshalJSON(r.Base, &r.ResendDetails)
 }
+
+// Validate checks the Resend notification configuration.
+func (r Resend) Validate() error {
+	check := newValidator(r.Base, r.Type())
+	check.required("resendApiKey", r.APIKey)
+	check.requiredEmail("resendFromEmail", r.FromEmail)
+	check.required("resendToEmail", r.ToEmail)
+	check.emailList("resendToEmail", r.ToEmail)
+
+	return check.err()
+}
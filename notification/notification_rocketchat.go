@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // RocketChat represents a rocketchat notification.
@@ -34,6 +36,13 @@ func (r RocketChat) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(r.Base, false), formatNotification(r.RocketChatDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (r RocketChat) Redacted() RocketChat {
+	r.Base = r.Base.Redacted()
+
+	return secret.Redact(r)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (r *RocketChat) UnmarshalJSON(data []byte) error {
 	detail := RocketChatDetails{}
@@ -54,3 +63,11 @@ func (r *RocketChat) UnmarshalJSON(data []byte) error {
 func (r RocketChat) MarshalJSON() ([]byte, error) {
 	return marshalJSON(r.Base, &r.RocketChatDetails)
 }
+
+// Validate checks the RocketChat notification configuration.
+func (r RocketChat) Validate() error {
+	check := newValidator(r.Base, r.Type())
+	check.requiredURL("rocketwebhookURL", r.WebhookURL)
+
+	return check.err()
+}
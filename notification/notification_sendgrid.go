@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SendGrid represents a SendGrid notification provider.
@@ -13,12 +15,12 @@ type SendGrid struct {
 
 // SendGridDetails contains the SendGrid-specific configuration.
 type SendGridDetails struct {
-	APIKey    string `json:"sendgridApiKey"`    // SendGrid API key
-	ToEmail   string `json:"sendgridToEmail"`   // Recipient email address
-	FromEmail string `json:"sendgridFromEmail"` // Sender email address
-	Subject   string `json:"sendgridSubject"`   // Email subject
-	CcEmail   string `json:"sendgridCcEmail"`   // CC email addresses
-	BccEmail  string `json:"sendgridBccEmail"`  // BCC email addresses
+	APIKey    string `json:"sendgridApiKey" kuma:"secret"` // SendGrid API key
+	ToEmail   string `json:"sendgridToEmail"`              // Recipient email address
+	FromEmail string `json:"sendgridFromEmail"`            // Sender email address
+	Subject   string `json:"sendgridSubject"`              // Email subject
+	CcEmail   string `json:"sendgridCcEmail"`              // CC email addresses
+	BccEmail  string `json:"sendgridBccEmail"`             // BCC email addresses
 }
 
 // Type returns the notification type identifier for SendGrid.
@@ -36,6 +38,13 @@ func (s SendGrid) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SendGridDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s SendGrid) Redacted() SendGrid {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON deserializes a SendGrid notification from JSON.
 func (s *SendGrid) UnmarshalJSON(data []byte) error {
 	detail := SendGridDetails{}
@@ -56,3 +65,16 @@ func (s *SendGrid) UnmarshalJSON(data []byte) error {
 func (s SendGrid) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.SendGridDetails)
 }
+
+// Validate checks the SendGrid notification configuration.
+func (s SendGrid) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.required("sendgridApiKey", s.APIKey)
+	check.required("sendgridToEmail", s.ToEmail)
+	check.emailList("sendgridToEmail", s.ToEmail)
+	check.requiredEmail("sendgridFromEmail", s.FromEmail)
+	check.emailList("sendgridCcEmail", s.CcEmail)
+	check.emailList("sendgridBccEmail", s.BccEmail)
+
+	return check.err()
+}
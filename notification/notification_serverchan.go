@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // ServerChan represents a ServerChan notification provider.
@@ -14,7 +16,7 @@ type ServerChan struct {
 // ServerChanDetails contains the configuration fields for ServerChan notifications.
 type ServerChanDetails struct {
 	// SendKey is the ServerChan send key for authentication.
-	SendKey string `json:"serverChanSendKey"`
+	SendKey string `json:"serverChanSendKey" kuma:"secret"`
 }
 
 // Type returns the notification type identifier for ServerChan.
@@ -32,6 +34,13 @@ func (s ServerChan) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.ServerChanDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s ServerChan) Redacted() ServerChan {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals JSON data into a ServerChan notification.
 func (s *ServerChan) UnmarshalJSON(data []byte) error {
 	detail := ServerChanDetails{}
@@ -52,3 +61,11 @@ func (s *ServerChan) UnmarshalJSON(data []byte) error {
 func (s ServerChan) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.ServerChanDetails)
 }
+
+// Validate checks the ServerChan notification configuration.
+func (s ServerChan) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.required("serverChanSendKey", s.SendKey)
+
+	return check.err()
+}
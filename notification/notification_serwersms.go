@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SerwerSMS represents a SerwerSMS notification provider.
@@ -16,7 +18,7 @@ type SerwerSMSDetails struct {
 	// Username is the SerwerSMS account username.
 	Username string `json:"serwersmsUsername"`
 	// Password is the SerwerSMS account password.
-	Password string `json:"serwersmsPassword"`
+	Password string `json:"serwersmsPassword" kuma:"secret"`
 	// PhoneNumber is the recipient phone number. Used when RecipientType is "number".
 	PhoneNumber string `json:"serwersmsPhoneNumber"`
 	// SenderName is the sender name or identifier.
@@ -43,6 +45,13 @@ func (s SerwerSMS) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SerwerSMSDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s SerwerSMS) Redacted() SerwerSMS {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals JSON data into a SerwerSMS notification.
 func (s *SerwerSMS) UnmarshalJSON(data []byte) error {
 	detail := SerwerSMSDetails{}
@@ -63,3 +72,20 @@ func (s *SerwerSMS) UnmarshalJSON(data []byte) error {
 func (s SerwerSMS) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.SerwerSMSDetails)
 }
+
+// Validate checks the SerwerSMS notification configuration.
+func (s SerwerSMS) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.required("serwersmsUsername", s.Username)
+	check.required("serwersmsPassword", s.Password)
+	check.required("serwersmsSenderName", s.SenderName)
+	check.oneOf("serwersmsRecipientType", s.RecipientType, "", "number", "group")
+
+	if s.RecipientType == "group" {
+		check.required("serwersmsGroupId", s.GroupID)
+	} else {
+		check.requiredPhone("serwersmsPhoneNumber", s.PhoneNumber)
+	}
+
+	return check.err()
+}
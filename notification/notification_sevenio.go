@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SevenIO represents a Seven.io SMS notification provider.
@@ -14,7 +16,7 @@ type SevenIO struct {
 // SevenIODetails contains the configuration fields for Seven.io SMS notifications.
 type SevenIODetails struct {
 	// APIKey is the Seven.io API key for authentication.
-	APIKey string `json:"sevenioApiKey"`
+	APIKey string `json:"sevenioApiKey" kuma:"secret"`
 	// Sender is the sender name or phone number.
 	Sender string `json:"sevenioSender"`
 	// To is the recipient phone number.
@@ -36,6 +38,13 @@ func (s SevenIO) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SevenIODetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s SevenIO) Redacted() SevenIO {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals JSON data into a SevenIO notification.
 func (s *SevenIO) UnmarshalJSON(data []byte) error {
 	detail := SevenIODetails{}
@@ -56,3 +65,12 @@ func (s *SevenIO) UnmarshalJSON(data []byte) error {
 func (s SevenIO) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.SevenIODetails)
 }
+
+// Validate checks the SevenIO notification configuration.
+func (s SevenIO) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.required("sevenioApiKey", s.APIKey)
+	check.requiredPhone("sevenioTo", s.To)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Signal represents a signal notification.
@@ -34,6 +36,13 @@ func (s Signal) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SignalDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s Signal) Redacted() Signal {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (s *Signal) UnmarshalJSON(data []byte) error {
 	detail := SignalDetails{}
@@ -54,3 +63,13 @@ func (s *Signal) UnmarshalJSON(data []byte) error {
 func (s Signal) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, &s.SignalDetails)
 }
+
+// Validate checks the Signal notification configuration.
+func (s Signal) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.requiredURL("signalURL", s.URL)
+	check.requiredPhone("signalNumber", s.Number)
+	check.required("signalRecipients", s.Recipients)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SIGNL4 represents a SIGNL4 notification provider.
@@ -32,6 +34,13 @@ func (s SIGNL4) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SIGNL4Details, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s SIGNL4) Redacted() SIGNL4 {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals JSON data into a SIGNL4 notification.
 func (s *SIGNL4) UnmarshalJSON(data []byte) error {
 	detail := SIGNL4Details{}
@@ -52,3 +61,11 @@ func (s *SIGNL4) UnmarshalJSON(data []byte) error {
 func (s SIGNL4) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.SIGNL4Details)
 }
+
+// Validate checks the SIGNL4 notification configuration.
+func (s SIGNL4) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.requiredURL("webhookURL", s.WebhookURL)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Slack represents a slack notification.
@@ -38,6 +40,13 @@ func (s Slack) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SlackDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s Slack) Redacted() Slack {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (s *Slack) UnmarshalJSON(data []byte) error {
 	detail := SlackDetails{}
@@ -58,3 +67,11 @@ func (s *Slack) UnmarshalJSON(data []byte) error {
 func (s Slack) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, &s.SlackDetails)
 }
+
+// Validate checks the Slack notification configuration.
+func (s Slack) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.requiredURL("slackwebhookURL", s.WebhookURL)
+
+	return check.err()
+}
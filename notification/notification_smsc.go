@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SMSC represents an SMSC SMS notification provider.
@@ -16,7 +18,7 @@ type SMSCDetails struct {
 	// Login is the SMSC account login/username.
 	Login string `json:"smscLogin"`
 	// Password is the SMSC account password.
-	Password string `json:"smscPassword"`
+	Password string `json:"smscPassword" kuma:"secret"`
 	// ToNumber is the recipient phone number.
 	ToNumber string `json:"smscToNumber"`
 	// SenderName is the optional sender name or identifier.
@@ -40,6 +42,13 @@ func (s SMSC) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SMSCDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s SMSC) Redacted() SMSC {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals JSON data into an SMSC notification.
 func (s *SMSC) UnmarshalJSON(data []byte) error {
 	detail := SMSCDetails{}
@@ -60,3 +69,14 @@ func (s *SMSC) UnmarshalJSON(data []byte) error {
 func (s SMSC) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.SMSCDetails)
 }
+
+// Validate checks the SMSC notification configuration.
+func (s SMSC) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.required("smscLogin", s.Login)
+	check.required("smscPassword", s.Password)
+	check.required("smscToNumber", s.ToNumber)
+	check.phoneList("smscToNumber", s.ToNumber)
+
+	return check.err()
+}
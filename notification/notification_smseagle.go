@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SMSEagle represents an SMSEagle notification provider.
@@ -19,7 +21,7 @@ type SMSEagleDetails struct {
 	// URL is the SMSEagle device URL (e.g., https://192.168.1.100 or https://smseagle.example.com).
 	URL string `json:"smseagleUrl"`
 	// Token is the API access token for authentication.
-	Token string `json:"smseagleToken"`
+	Token string `json:"smseagleToken" kuma:"secret"`
 	// RecipientType specifies the recipient type. Valid values: "smseagle-to", "smseagle-contact", "smseagle-group".
 	// "smseagle-to" uses phone number recipients, "smseagle-contact" uses contact IDs, "smseagle-group" uses group IDs.
 	RecipientType string `json:"smseagleRecipientType"`
@@ -64,6 +66,13 @@ func (s SMSEagle) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SMSEagleDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s SMSEagle) Redacted() SMSEagle {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals JSON data into an SMSEagle notification.
 func (s *SMSEagle) UnmarshalJSON(data []byte) error {
 	detail := SMSEagleDetails{}
@@ -84,3 +93,16 @@ func (s *SMSEagle) UnmarshalJSON(data []byte) error {
 func (s SMSEagle) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.SMSEagleDetails)
 }
+
+// Validate checks the SMSEagle notification configuration.
+func (s SMSEagle) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.requiredURL("smseagleUrl", s.URL)
+	check.required("smseagleToken", s.Token)
+	check.oneOf("smseagleApiType", s.APIType, "", "smseagle-apiv1", "smseagle-apiv2")
+	check.oneOf("smseagleRecipientType", s.RecipientType, "", "smseagle-to", "smseagle-contact", "smseagle-group")
+	check.oneOf("smseagleMsgType", s.MsgType, "", "smseagle-sms", "smseagle-ring", "smseagle-tts", "smseagle-tts-advanced")
+	check.intRange("smseaglePriority", int64(s.Priority), 0, 9)
+
+	return check.err()
+}
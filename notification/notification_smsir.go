@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SMSIR represents an SMS.ir notification provider.
@@ -14,7 +16,7 @@ type SMSIR struct {
 // SMSIRDetails contains the configuration fields for SMS.ir notifications.
 type SMSIRDetails struct {
 	// APIKey is the SMS.ir API key for authentication.
-	APIKey string `json:"smsirApiKey"`
+	APIKey string `json:"smsirApiKey" kuma:"secret"`
 	// Number is the comma separated list of recipient phone numbers.
 	Number string `json:"smsirNumber"`
 	// Template is the pre-approved template ID used for sending the message.
@@ -36,6 +38,13 @@ func (s SMSIR) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SMSIRDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s SMSIR) Redacted() SMSIR {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals JSON data into an SMSIR notification.
 func (s *SMSIR) UnmarshalJSON(data []byte) error {
 	detail := SMSIRDetails{}
@@ -56,3 +65,14 @@ func (s *SMSIR) UnmarshalJSON(data []byte) error {
 func (s SMSIR) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.SMSIRDetails)
 }
+
+// Validate checks the SMSIR notification configuration.
+func (s SMSIR) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.required("smsirApiKey", s.APIKey)
+	check.required("smsirNumber", s.Number)
+	check.phoneList("smsirNumber", s.Number)
+	check.required("smsirTemplate", s.Template)
+
+	return check.err()
+}
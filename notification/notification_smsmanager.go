@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SMSManager represents an SMS Manager notification provider.
@@ -14,7 +16,7 @@ type SMSManager struct {
 // SMSManagerDetails contains the configuration fields for SMS Manager notifications.
 type SMSManagerDetails struct {
 	// APIKey is the SMS Manager API key for authentication.
-	APIKey string `json:"smsmanagerApiKey"`
+	APIKey string `json:"smsmanagerApiKey" kuma:"secret"`
 	// Numbers is the recipient phone number.
 	Numbers string `json:"numbers"`
 	// MessageType is the message gateway type.
@@ -36,6 +38,13 @@ func (s SMSManager) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SMSManagerDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s SMSManager) Redacted() SMSManager {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals JSON data into an SMSManager notification.
 func (s *SMSManager) UnmarshalJSON(data []byte) error {
 	detail := SMSManagerDetails{}
@@ -56,3 +65,13 @@ func (s *SMSManager) UnmarshalJSON(data []byte) error {
 func (s SMSManager) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.SMSManagerDetails)
 }
+
+// Validate checks the SMSManager notification configuration.
+func (s SMSManager) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.required("smsmanagerApiKey", s.APIKey)
+	check.required("numbers", s.Numbers)
+	check.phoneList("numbers", s.Numbers)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SMSPartner represents an SMSPartner notification provider.
@@ -14,7 +16,7 @@ type SMSPartner struct {
 // SMSPartnerDetails contains the configuration fields for SMSPartner notifications.
 type SMSPartnerDetails struct {
 	// APIKey is the SMSPartner API key for authentication.
-	APIKey string `json:"smspartnerApikey"`
+	APIKey string `json:"smspartnerApikey" kuma:"secret"`
 	// PhoneNumber is the recipient phone number.
 	PhoneNumber string `json:"smspartnerPhoneNumber"`
 	// SenderName is the sender name or identifier.
@@ -36,6 +38,13 @@ func (s SMSPartner) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SMSPartnerDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s SMSPartner) Redacted() SMSPartner {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals JSON data into an SMSPartner notification.
 func (s *SMSPartner) UnmarshalJSON(data []byte) error {
 	detail := SMSPartnerDetails{}
@@ -56,3 +65,14 @@ func (s *SMSPartner) UnmarshalJSON(data []byte) error {
 func (s SMSPartner) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.SMSPartnerDetails)
 }
+
+// Validate checks the SMSPartner notification configuration.
+func (s SMSPartner) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.required("smspartnerApikey", s.APIKey)
+	check.required("smspartnerPhoneNumber", s.PhoneNumber)
+	check.phoneList("smspartnerPhoneNumber", s.PhoneNumber)
+	check.required("smspartnerSenderName", s.SenderName)
+
+	return check.err()
+}
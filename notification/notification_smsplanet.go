@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SMSPlanet represents an SMS Planet notification provider.
@@ -14,7 +16,7 @@ type SMSPlanet struct {
 // SMSPlanetDetails contains the configuration fields for SMS Planet notifications.
 type SMSPlanetDetails struct {
 	// APIToken is the SMS Planet API token for authentication.
-	APIToken string `json:"smsplanetApiToken"`
+	APIToken string `json:"smsplanetApiToken" kuma:"secret"`
 	// PhoneNumbers is the recipient phone numbers.
 	PhoneNumbers string `json:"smsplanetPhoneNumbers"`
 	// SenderName is the sender name or identifier.
@@ -36,6 +38,13 @@ func (s SMSPlanet) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SMSPlanetDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s SMSPlanet) Redacted() SMSPlanet {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals JSON data into an SMS Planet notification.
 func (s *SMSPlanet) UnmarshalJSON(data []byte) error {
 	detail := SMSPlanetDetails{}
@@ -56,3 +65,14 @@ func (s *SMSPlanet) UnmarshalJSON(data []byte) error {
 func (s SMSPlanet) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.SMSPlanetDetails)
 }
+
+// Validate checks the SMS Planet notification configuration.
+func (s SMSPlanet) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.required("smsplanetApiToken", s.APIToken)
+	check.required("smsplanetPhoneNumbers", s.PhoneNumbers)
+	check.phoneList("smsplanetPhoneNumbers", s.PhoneNumbers)
+	check.required("smsplanetSenderName", s.SenderName)
+
+	return check.err()
+}
@@ -2,6 +2,9 @@ package notification
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SMTP represents a smtp notification.
@@ -19,12 +22,12 @@ type SMTPDetails struct {
 	IgnoreTLSError       bool   `json:"smtpIgnoreTLSError"`
 	DkimDomain           string `json:"smtpDkimDomain"`
 	DkimKeySelector      string `json:"smtpDkimKeySelector"`
-	DkimPrivateKey       string `json:"smtpDkimPrivateKey"`
+	DkimPrivateKey       string `json:"smtpDkimPrivateKey" kuma:"secret"`
 	DkimHashAlgo         string `json:"smtpDkimHashAlgo"`
 	DkimHeaderFieldNames string `json:"smtpDkimheaderFieldNames"`
 	DkimSkipFields       string `json:"smtpDkimskipFields"`
 	Username             string `json:"smtpUsername"`
-	Password             string `json:"smtpPassword"`
+	Password             string `json:"smtpPassword" kuma:"secret"`
 	From                 string `json:"smtpFrom"`
 	CC                   string `json:"smtpCC"`
 	BCC                  string `json:"smtpBCC"`
@@ -49,6 +52,13 @@ func (s SMTP) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SMTPDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s SMTP) Redacted() SMTP {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (s *SMTP) UnmarshalJSON(data []byte) error {
 	detail := SMTPDetails{}
@@ -69,3 +79,20 @@ func (s *SMTP) UnmarshalJSON(data []byte) error {
 func (s SMTP) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, &s.SMTPDetails)
 }
+
+// Validate checks the SMTP notification configuration.
+func (s SMTP) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.required("smtpHost", s.Host)
+	check.intRange("smtpPort", int64(s.Port), 1, 65535)
+	check.requiredEmail("smtpFrom", s.From)
+	check.emailList("smtpTo", s.To)
+	check.emailList("smtpCC", s.CC)
+	check.emailList("smtpBCC", s.BCC)
+
+	if strings.TrimSpace(s.To) == "" && strings.TrimSpace(s.CC) == "" && strings.TrimSpace(s.BCC) == "" {
+		check.fail("smtpTo", "at least one recipient in smtpTo, smtpCC or smtpBCC is required")
+	}
+
+	return check.err()
+}
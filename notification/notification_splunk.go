@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Splunk represents a Splunk On-Call (formerly VictorOps) notification provider.
@@ -20,7 +22,7 @@ type SplunkDetails struct {
 	// AutoResolve is the action to take when a monitor recovers.
 	AutoResolve string `json:"splunkAutoResolve"`
 	// IntegrationKey is the Splunk On-Call routing key for alert routing.
-	IntegrationKey string `json:"pagerdutyIntegrationKey"`
+	IntegrationKey string `json:"pagerdutyIntegrationKey" kuma:"secret"`
 }
 
 // Type returns the notification type identifier for Splunk.
@@ -38,6 +40,13 @@ func (s Splunk) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SplunkDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s Splunk) Redacted() Splunk {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Splunk notification.
 func (s *Splunk) UnmarshalJSON(data []byte) error {
 	detail := SplunkDetails{}
@@ -58,3 +67,11 @@ func (s *Splunk) UnmarshalJSON(data []byte) error {
 func (s Splunk) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.SplunkDetails)
 }
+
+// Validate checks the Splunk notification configuration.
+func (s Splunk) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.requiredURL("splunkRestURL", s.RestURL)
+
+	return check.err()
+}
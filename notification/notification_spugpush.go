@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // SpugPush represents a SpugPush notification provider.
@@ -14,7 +16,7 @@ type SpugPush struct {
 // SpugPushDetails contains the configuration fields for SpugPush notifications.
 type SpugPushDetails struct {
 	// TemplateKey is the SpugPush template key for the notification.
-	TemplateKey string `json:"templateKey"`
+	TemplateKey string `json:"templateKey" kuma:"secret"`
 }
 
 // Type returns the notification type identifier for SpugPush.
@@ -32,6 +34,13 @@ func (s SpugPush) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SpugPushDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s SpugPush) Redacted() SpugPush {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals JSON data into a SpugPush notification.
 func (s *SpugPush) UnmarshalJSON(data []byte) error {
 	detail := SpugPushDetails{}
@@ -52,3 +61,11 @@ func (s *SpugPush) UnmarshalJSON(data []byte) error {
 func (s SpugPush) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.SpugPushDetails)
 }
+
+// Validate checks the SpugPush notification configuration.
+func (s SpugPush) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.required("templateKey", s.TemplateKey)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Squadcast represents a Squadcast notification provider.
@@ -32,6 +34,13 @@ func (s Squadcast) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.SquadcastDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s Squadcast) Redacted() Squadcast {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Squadcast notification.
 func (s *Squadcast) UnmarshalJSON(data []byte) error {
 	detail := SquadcastDetails{}
@@ -52,3 +61,11 @@ func (s *Squadcast) UnmarshalJSON(data []byte) error {
 func (s Squadcast) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.SquadcastDetails)
 }
+
+// Validate checks the Squadcast notification configuration.
+func (s Squadcast) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.requiredURL("squadcastWebhookURL", s.WebhookURL)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Stackfield represents a Stackfield notification provider.
@@ -32,6 +34,13 @@ func (s Stackfield) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(s.Base, false), formatNotification(s.StackfieldDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (s Stackfield) Redacted() Stackfield {
+	s.Base = s.Base.Redacted()
+
+	return secret.Redact(s)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Stackfield notification.
 func (s *Stackfield) UnmarshalJSON(data []byte) error {
 	detail := StackfieldDetails{}
@@ -52,3 +61,11 @@ func (s *Stackfield) UnmarshalJSON(data []byte) error {
 func (s Stackfield) MarshalJSON() ([]byte, error) {
 	return marshalJSON(s.Base, s.StackfieldDetails)
 }
+
+// Validate checks the Stackfield notification configuration.
+func (s Stackfield) Validate() error {
+	check := newValidator(s.Base, s.Type())
+	check.requiredURL("stackfieldwebhookURL", s.WebhookURL)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Teams represents a teams notification.
@@ -31,6 +33,13 @@ func (t Teams) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(t.Base, false), formatNotification(t.TeamsDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (t Teams) Redacted() Teams {
+	t.Base = t.Base.Redacted()
+
+	return secret.Redact(t)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (t *Teams) UnmarshalJSON(data []byte) error {
 	detail := TeamsDetails{}
@@ -51,3 +60,11 @@ func (t *Teams) UnmarshalJSON(data []byte) error {
 func (t Teams) MarshalJSON() ([]byte, error) {
 	return marshalJSON(t.Base, &t.TeamsDetails)
 }
+
+// Validate checks the Teams notification configuration.
+func (t Teams) Validate() error {
+	check := newValidator(t.Base, t.Type())
+	check.requiredURL("webhookUrl", t.WebhookURL)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // TechulusPush represents a Techulus Push notification provider.
@@ -14,7 +16,7 @@ type TechulusPush struct {
 // TechulusPushDetails contains the configuration fields for TechulusPush notifications.
 type TechulusPushDetails struct {
 	// APIKey is the Techulus Push API key for authentication.
-	APIKey string `json:"pushAPIKey"`
+	APIKey string `json:"pushAPIKey" kuma:"secret"`
 	// Title is the notification title.
 	Title string `json:"pushTitle"`
 	// Sound is the notification sound identifier.
@@ -40,6 +42,13 @@ func (t TechulusPush) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(t.Base, false), formatNotification(t.TechulusPushDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (t TechulusPush) Redacted() TechulusPush {
+	t.Base = t.Base.Redacted()
+
+	return secret.Redact(t)
+}
+
 // UnmarshalJSON unmarshals JSON data into a TechulusPush notification.
 func (t *TechulusPush) UnmarshalJSON(data []byte) error {
 	detail := TechulusPushDetails{}
@@ -60,3 +69,11 @@ func (t *TechulusPush) UnmarshalJSON(data []byte) error {
 func (t TechulusPush) MarshalJSON() ([]byte, error) {
 	return marshalJSON(t.Base, t.TechulusPushDetails)
 }
+
+// Validate checks the TechulusPush notification configuration.
+func (t TechulusPush) Validate() error {
+	check := newValidator(t.Base, t.Type())
+	check.required("pushAPIKey", t.APIKey)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Telegram represents a telegram notification.
@@ -12,7 +14,7 @@ type Telegram struct {
 
 // TelegramDetails contains telegram-specific notification configuration.
 type TelegramDetails struct {
-	BotToken          string `json:"telegramBotToken"`
+	BotToken          string `json:"telegramBotToken" kuma:"secret"`
 	ChatID            string `json:"telegramChatID"`
 	ServerURL         string `json:"telegramServerUrl"`
 	SendSilently      bool   `json:"telegramSendSilently"`
@@ -38,6 +40,13 @@ func (t Telegram) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(t.Base, false), formatNotification(t.TelegramDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (t Telegram) Redacted() Telegram {
+	t.Base = t.Base.Redacted()
+
+	return secret.Redact(t)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (t *Telegram) UnmarshalJSON(data []byte) error {
 	detail := TelegramDetails{}
@@ -58,3 +67,13 @@ func (t *Telegram) UnmarshalJSON(data []byte) error {
 func (t Telegram) MarshalJSON() ([]byte, error) {
 	return marshalJSON(t.Base, &t.TelegramDetails)
 }
+
+// Validate checks the Telegram notification configuration.
+func (t Telegram) Validate() error {
+	check := newValidator(t.Base, t.Type())
+	check.required("telegramBotToken", t.BotToken)
+	check.required("telegramChatID", t.ChatID)
+	check.url("telegramServerUrl", t.ServerURL)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Telnyx represents a telnyx notification.
@@ -12,7 +14,7 @@ type Telnyx struct {
 
 // TelnyxDetails contains telnyx-specific notification configuration.
 type TelnyxDetails struct {
-	APIKey             string  `json:"telnyxApiKey"`
+	APIKey             string  `json:"telnyxApiKey" kuma:"secret"`
 	MessagingProfileID *string `json:"telnyxMessagingProfileId,omitempty"`
 	PhoneNumber        string  `json:"telnyxPhoneNumber"`
 	ToNumber           string  `json:"telnyxToNumber"`
@@ -33,6 +35,13 @@ func (t Telnyx) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(t.Base, false), formatNotification(t.TelnyxDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (t Telnyx) Redacted() Telnyx {
+	t.Base = t.Base.Redacted()
+
+	return secret.Redact(t)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (t *Telnyx) UnmarshalJSON(data []byte) error {
 	detail := TelnyxDetails{}
@@ -53,3 +62,13 @@ func (t *Telnyx) UnmarshalJSON(data []byte) error {
 func (t Telnyx) MarshalJSON() ([]byte, error) {
 	return marshalJSON(t.Base, &t.TelnyxDetails)
 }
+
+// Validate checks the Telnyx notification configuration.
+func (t Telnyx) Validate() error {
+	check := newValidator(t.Base, t.Type())
+	check.required("telnyxApiKey", t.APIKey)
+	check.requiredPhone("telnyxPhoneNumber", t.PhoneNumber)
+	check.requiredPhone("telnyxToNumber", t.ToNumber)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Teltonika represents a Teltonika notification provider.
@@ -19,7 +21,7 @@ type TeltonikaDetails struct {
 	// Username is the router user used to authenticate against the Teltonika HTTP API.
 	Username string `json:"teltonikaUsername"`
 	// Password is the password for the router user.
-	Password string `json:"teltonikaPassword"`
+	Password string `json:"teltonikaPassword" kuma:"secret"`
 	// Modem is the modem identifier on the router (e.g., "1-1").
 	Modem string `json:"teltonikaModem"`
 	// PhoneNumber is the recipient phone number (e.g., "+336xxxxxxxx").
@@ -45,6 +47,13 @@ func (t Teltonika) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(t.Base, false), formatNotification(t.TeltonikaDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (t Teltonika) Redacted() Teltonika {
+	t.Base = t.Base.Redacted()
+
+	return secret.Redact(t)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Teltonika notification.
 func (t *Teltonika) UnmarshalJSON(data []byte) error {
 	detail := TeltonikaDetails{}
@@ -65,3 +74,14 @@ func (t *Teltonika) UnmarshalJSON(data []byte) error {
 func (t Teltonika) MarshalJSON() ([]byte, error) {
 	return marshalJSON(t.Base, t.TeltonikaDetails)
 }
+
+// Validate checks the Teltonika notification configuration.
+func (t Teltonika) Validate() error {
+	check := newValidator(t.Base, t.Type())
+	check.requiredURL("teltonikaUrl", t.URL)
+	check.required("teltonikaUsername", t.Username)
+	check.required("teltonikaPassword", t.Password)
+	check.requiredPhone("teltonikaPhoneNumber", t.PhoneNumber)
+
+	return check.err()
+}
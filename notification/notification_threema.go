@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Threema represents a Threema notification provider.
@@ -16,7 +18,7 @@ type ThreemaDetails struct {
 	// SenderIdentity is the Threema Gateway ID for the sender.
 	SenderIdentity string `json:"threemaSenderIdentity"`
 	// Secret is the Threema API secret for authentication.
-	Secret string `json:"threemaSecret"`
+	Secret string `json:"threemaSecret" kuma:"secret"`
 	// Recipient is the recipient identifier (ID, phone number, or email address).
 	Recipient string `json:"threemaRecipient"`
 	// RecipientType specifies the type of recipient (identity, phone, or email).
@@ -38,6 +40,13 @@ func (t Threema) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(t.Base, false), formatNotification(t.ThreemaDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (t Threema) Redacted() Threema {
+	t.Base = t.Base.Redacted()
+
+	return secret.Redact(t)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Threema notification.
 func (t *Threema) UnmarshalJSON(data []byte) error {
 	detail := ThreemaDetails{}
@@ -58,3 +67,14 @@ func (t *Threema) UnmarshalJSON(data []byte) error {
 func (t Threema) MarshalJSON() ([]byte, error) {
 	return marshalJSON(t.Base, t.ThreemaDetails)
 }
+
+// Validate checks the Threema notification configuration.
+func (t Threema) Validate() error {
+	check := newValidator(t.Base, t.Type())
+	check.required("threemaSenderIdentity", t.SenderIdentity)
+	check.required("threemaSecret", t.Secret)
+	check.required("threemaRecipient", t.Recipient)
+	check.oneOf("threemaRecipientType", t.RecipientType, "identity", "phone", "email")
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Twilio represents a twilio notification.
@@ -13,8 +15,8 @@ type Twilio struct {
 // TwilioDetails contains twilio-specific notification configuration.
 type TwilioDetails struct {
 	AccountSID string `json:"twilioAccountSID"`
-	APIKey     string `json:"twilioApiKey"`
-	AuthToken  string `json:"twilioAuthToken"`
+	APIKey     string `json:"twilioApiKey" kuma:"secret"`
+	AuthToken  string `json:"twilioAuthToken" kuma:"secret"`
 	ToNumber   string `json:"twilioToNumber"`
 	FromNumber string `json:"twilioFromNumber"`
 }
@@ -34,6 +36,13 @@ func (t Twilio) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(t.Base, false), formatNotification(t.TwilioDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (t Twilio) Redacted() Twilio {
+	t.Base = t.Base.Redacted()
+
+	return secret.Redact(t)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (t *Twilio) UnmarshalJSON(data []byte) error {
 	detail := TwilioDetails{}
@@ -54,3 +63,14 @@ func (t *Twilio) UnmarshalJSON(data []byte) error {
 func (t Twilio) MarshalJSON() ([]byte, error) {
 	return marshalJSON(t.Base, &t.TwilioDetails)
 }
+
+// Validate checks the Twilio notification configuration.
+func (t Twilio) Validate() error {
+	check := newValidator(t.Base, t.Type())
+	check.required("twilioAccountSID", t.AccountSID)
+	check.required("twilioAuthToken", t.AuthToken)
+	check.requiredPhone("twilioToNumber", t.ToNumber)
+	check.requiredPhone("twilioFromNumber", t.FromNumber)
+
+	return check.err()
+}
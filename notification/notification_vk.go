@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // VK represents a VK notification.
@@ -13,7 +15,7 @@ type VK struct {
 // VKDetails contains VK-specific notification configuration.
 type VKDetails struct {
 	// AccessToken is the VK API user or service access token.
-	AccessToken string `json:"vkAccessToken"`
+	AccessToken string `json:"vkAccessToken" kuma:"secret"`
 	// PeerID is the recipient. Must be a numeric string: positive for user
 	// IDs, negative for community IDs, or 2000000000+chat_id for group chats.
 	PeerID string `json:"vkPeerId"`
@@ -37,6 +39,13 @@ func (v VK) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(v.Base, false), formatNotification(v.VKDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (v VK) Redacted() VK {
+	v.Base = v.Base.Redacted()
+
+	return secret.Redact(v)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (v *VK) UnmarshalJSON(data []byte) error {
 	detail := VKDetails{}
@@ -57,3 +66,12 @@ func (v *VK) UnmarshalJSON(data []byte) error {
 func (v VK) MarshalJSON() ([]byte, error) {
 	return marshalJSON(v.Base, &v.VKDetails)
 }
+
+// Validate checks the VK notification configuration.
+func (v VK) Validate() error {
+	check := newValidator(v.Base, v.Type())
+	check.required("vkAccessToken", v.AccessToken)
+	check.required("vkPeerId", v.PeerID)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // VKTeams represents a VKTeams notification.
@@ -12,7 +14,7 @@ type VKTeams struct {
 
 // VKTeamsDetails contains VKTeams-specific notification configuration.
 type VKTeamsDetails struct {
-	BotToken       string `json:"vkteamsBotToken"`
+	BotToken       string `json:"vkteamsBotToken" kuma:"secret"`
 	ChatID         string `json:"vkteamsChatId"`
 	BaseURL        string `json:"vkteamsBaseUrl"`
 	UseTemplate    bool   `json:"vkteamsUseTemplate"`
@@ -35,6 +37,13 @@ func (v VKTeams) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(v.Base, false), formatNotification(v.VKTeamsDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (v VKTeams) Redacted() VKTeams {
+	v.Base = v.Base.Redacted()
+
+	return secret.Redact(v)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (v *VKTeams) UnmarshalJSON(data []byte) error {
 	detail := VKTeamsDetails{}
@@ -55,3 +64,13 @@ func (v *VKTeams) UnmarshalJSON(data []byte) error {
 func (v VKTeams) MarshalJSON() ([]byte, error) {
 	return marshalJSON(v.Base, &v.VKTeamsDetails)
 }
+
+// Validate checks the VKTeams notification configuration.
+func (v VKTeams) Validate() error {
+	check := newValidator(v.Base, v.Type())
+	check.required("vkteamsBotToken", v.BotToken)
+	check.required("vkteamsChatId", v.ChatID)
+	check.url("vkteamsBaseUrl", v.BaseURL)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // WAHA represents a WAHA (WhatsApp HTTP API) notification provider.
@@ -20,7 +22,7 @@ type WAHADetails struct {
 	// ChatID is the recipient chat ID (typically a phone number).
 	ChatID string `json:"wahaChatId"`
 	// APIKey is the WAHA API key for authentication (optional).
-	APIKey string `json:"wahaApiKey"`
+	APIKey string `json:"wahaApiKey" kuma:"secret"`
 }
 
 // Type returns the notification type identifier for WAHA.
@@ -38,6 +40,13 @@ func (w WAHA) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(w.Base, false), formatNotification(w.WAHADetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (w WAHA) Redacted() WAHA {
+	w.Base = w.Base.Redacted()
+
+	return secret.Redact(w)
+}
+
 // UnmarshalJSON unmarshals JSON data into a WAHA notification.
 func (w *WAHA) UnmarshalJSON(data []byte) error {
 	detail := WAHADetails{}
@@ -58,3 +67,13 @@ func (w *WAHA) UnmarshalJSON(data []byte) error {
 func (w WAHA) MarshalJSON() ([]byte, error) {
 	return marshalJSON(w.Base, w.WAHADetails)
 }
+
+// Validate checks the WAHA notification configuration.
+func (w WAHA) Validate() error {
+	check := newValidator(w.Base, w.Type())
+	check.requiredURL("wahaApiUrl", w.APIURL)
+	check.required("wahaSession", w.Session)
+	check.required("wahaChatId", w.ChatID)
+
+	return check.err()
+}
@@ -3,6 +3,8 @@ package notification
 import (
 	"encoding/json"
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Webhook represents a webhook notification provider.
@@ -40,6 +42,13 @@ func (w Webhook) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(w.Base, false), formatNotification(w.WebhookDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (w Webhook) Redacted() Webhook {
+	w.Base = w.Base.Redacted()
+
+	return secret.Redact(w)
+}
+
 // UnmarshalJSON deserializes a webhook notification from JSON.
 func (w *Webhook) UnmarshalJSON(data []byte) error {
 	detail := WebhookDetails{}
@@ -61,6 +70,19 @@ func (w Webhook) MarshalJSON() ([]byte, error) {
 	return marshalJSON(w.Base, w.WebhookDetails)
 }
 
+// Validate checks the Webhook notification configuration.
+func (w Webhook) Validate() error {
+	check := newValidator(w.Base, w.Type())
+	check.requiredURL("webhookURL", w.WebhookURL)
+	check.oneOf("webhookContentType", w.WebhookContentType, "json", "form-data", "custom")
+
+	if w.WebhookContentType == "custom" {
+		check.required("webhookCustomBody", w.WebhookCustomBody)
+	}
+
+	return check.err()
+}
+
 // MarshalJSON serializes the headers map to a JSON string.
 // Example: {"Authorization": "Bearer token"} becomes "{\"Authorization\":\"Bearer token\"}".
 func (h WebhookAdditionalHeaders) MarshalJSON() ([]byte, error) {
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Webpush represents a Web Push notification.
@@ -26,7 +28,7 @@ type WebpushSubscription struct {
 // WebpushSubscriptionKeys holds the encryption keys for a Web Push subscription.
 type WebpushSubscriptionKeys struct {
 	P256dh string `json:"p256dh"`
-	Auth   string `json:"auth"`
+	Auth   string `json:"auth" kuma:"secret"`
 }
 
 // Type returns the notification type.
@@ -44,6 +46,13 @@ func (w Webpush) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(w.Base, false), formatNotification(w.WebpushDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (w Webpush) Redacted() Webpush {
+	w.Base = w.Base.Redacted()
+
+	return secret.Redact(w)
+}
+
 // UnmarshalJSON unmarshals a JSON byte slice into a notification.
 func (w *Webpush) UnmarshalJSON(data []byte) error {
 	detail := WebpushDetails{}
@@ -64,3 +73,13 @@ func (w *Webpush) UnmarshalJSON(data []byte) error {
 func (w Webpush) MarshalJSON() ([]byte, error) {
 	return marshalJSON(w.Base, w.WebpushDetails)
 }
+
+// Validate checks the Webpush notification configuration.
+func (w Webpush) Validate() error {
+	check := newValidator(w.Base, w.Type())
+	check.requiredURL("subscription.endpoint", w.Subscription.Endpoint, "https")
+	check.required("subscription.keys.p256dh", w.Subscription.Keys.P256dh)
+	check.required("subscription.keys.auth", w.Subscription.Keys.Auth)
+
+	return check.err()
+}
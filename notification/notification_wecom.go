@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // WeCom represents a WeCom (WeChat Work) notification provider.
@@ -13,7 +15,7 @@ type WeCom struct {
 
 // WeComDetails contains the WeCom-specific configuration.
 type WeComDetails struct {
-	BotKey              string `json:"weComBotKey"`
+	BotKey              string `json:"weComBotKey" kuma:"secret"`
 	MentionedMobileList string `json:"weComMentionedMobileList,omitempty"`
 }
 
@@ -32,6 +34,13 @@ func (w WeCom) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(w.Base, false), formatNotification(w.WeComDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (w WeCom) Redacted() WeCom {
+	w.Base = w.Base.Redacted()
+
+	return secret.Redact(w)
+}
+
 // UnmarshalJSON deserializes a WeCom notification from JSON.
 func (w *WeCom) UnmarshalJSON(data []byte) error {
 	detail := WeComDetails{}
@@ -52,3 +61,11 @@ func (w *WeCom) UnmarshalJSON(data []byte) error {
 func (w WeCom) MarshalJSON() ([]byte, error) {
 	return marshalJSON(w.Base, w.WeComDetails)
 }
+
+// Validate checks the WeCom notification configuration.
+func (w WeCom) Validate() error {
+	check := newValidator(w.Base, w.Type())
+	check.required("weComBotKey", w.BotKey)
+
+	return check.err()
+}
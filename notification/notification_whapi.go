@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Whapi represents a Whapi (WhatsApp API) notification provider.
@@ -16,7 +18,7 @@ type WhapiDetails struct {
 	// APIURL is the Whapi API endpoint URL.
 	APIURL string `json:"whapiApiUrl"`
 	// AuthToken is the Whapi API authorization token.
-	AuthToken string `json:"whapiAuthToken"`
+	AuthToken string `json:"whapiAuthToken" kuma:"secret"`
 	// Recipient is the recipient phone number.
 	Recipient string `json:"whapiRecipient"`
 }
@@ -36,6 +38,13 @@ func (w Whapi) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(w.Base, false), formatNotification(w.WhapiDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (w Whapi) Redacted() Whapi {
+	w.Base = w.Base.Redacted()
+
+	return secret.Redact(w)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Whapi notification.
 func (w *Whapi) UnmarshalJSON(data []byte) error {
 	detail := WhapiDetails{}
@@ -56,3 +65,13 @@ func (w *Whapi) UnmarshalJSON(data []byte) error {
 func (w Whapi) MarshalJSON() ([]byte, error) {
 	return marshalJSON(w.Base, w.WhapiDetails)
 }
+
+// Validate checks the Whapi notification configuration.
+func (w Whapi) Validate() error {
+	check := newValidator(w.Base, w.Type())
+	check.url("whapiApiUrl", w.APIURL)
+	check.required("whapiAuthToken", w.AuthToken)
+	check.required("whapiRecipient", w.Recipient)
+
+	return check.err()
+}
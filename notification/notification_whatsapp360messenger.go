@@ -2,6 +2,9 @@ package notification
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Whatsapp360messenger represents a 360messenger WhatsApp notification provider.
@@ -14,7 +17,7 @@ type Whatsapp360messenger struct {
 // Whatsapp360messengerDetails contains the configuration fields for 360messenger notifications.
 type Whatsapp360messengerDetails struct {
 	// AuthToken is the Bearer authentication token.
-	AuthToken string `json:"Whatsapp360messengerAuthToken"`
+	AuthToken string `json:"Whatsapp360messengerAuthToken" kuma:"secret"`
 	// Recipient is a comma- or semicolon-separated list of phone numbers.
 	Recipient string `json:"Whatsapp360messengerRecipient"`
 	// GroupIDs is the list of WhatsApp group IDs (multi-select).
@@ -46,6 +49,13 @@ func (w Whatsapp360messenger) String() string {
 	)
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (w Whatsapp360messenger) Redacted() Whatsapp360messenger {
+	w.Base = w.Base.Redacted()
+
+	return secret.Redact(w)
+}
+
 // UnmarshalJSON unmarshals JSON data into a Whatsapp360messenger notification.
 func (w *Whatsapp360messenger) UnmarshalJSON(data []byte) error {
 	detail := Whatsapp360messengerDetails{}
@@ -66,3 +76,16 @@ func (w *Whatsapp360messenger) UnmarshalJSON(data []byte) error {
 func (w Whatsapp360messenger) MarshalJSON() ([]byte, error) {
 	return marshalJSON(w.Base, w.Whatsapp360messengerDetails)
 }
+
+// Validate checks the Whatsapp360messenger notification configuration.
+func (w Whatsapp360messenger) Validate() error {
+	check := newValidator(w.Base, w.Type())
+	check.required("Whatsapp360messengerAuthToken", w.AuthToken)
+	check.phoneList("Whatsapp360messengerRecipient", w.Recipient)
+
+	if strings.TrimSpace(w.Recipient) == "" && len(w.GroupIDs) == 0 && (w.GroupID == nil || *w.GroupID == "") {
+		check.fail("Whatsapp360messengerRecipient", "at least one recipient or group is required")
+	}
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // WPush represents a WPush notification provider.
@@ -14,7 +16,7 @@ type WPush struct {
 // WPushDetails contains the configuration fields for WPush notifications.
 type WPushDetails struct {
 	// APIKey is the WPush API key for authentication.
-	APIKey string `json:"wpushAPIkey"`
+	APIKey string `json:"wpushAPIkey" kuma:"secret"`
 	// Channel is the WPush push channel identifier.
 	Channel string `json:"wpushChannel"`
 }
@@ -34,6 +36,13 @@ func (w WPush) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(w.Base, false), formatNotification(w.WPushDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (w WPush) Redacted() WPush {
+	w.Base = w.Base.Redacted()
+
+	return secret.Redact(w)
+}
+
 // UnmarshalJSON unmarshals JSON data into a WPush notification.
 func (w *WPush) UnmarshalJSON(data []byte) error {
 	detail := WPushDetails{}
@@ -54,3 +63,12 @@ func (w *WPush) UnmarshalJSON(data []byte) error {
 func (w WPush) MarshalJSON() ([]byte, error) {
 	return marshalJSON(w.Base, w.WPushDetails)
 }
+
+// Validate checks the WPush notification configuration.
+func (w WPush) Validate() error {
+	check := newValidator(w.Base, w.Type())
+	check.required("wpushAPIkey", w.APIKey)
+	check.required("wpushChannel", w.Channel)
+
+	return check.err()
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // YZJ represents a YZJ (云之家/Yunzhijia) notification provider.
@@ -16,7 +18,7 @@ type YZJDetails struct {
 	// WebHookURL is the YZJ webhook URL endpoint.
 	WebHookURL string `json:"yzjWebHookUrl"`
 	// Token is the YZJ authentication token.
-	Token string `json:"yzjToken"`
+	Token string `json:"yzjToken" kuma:"secret"`
 }
 
 // Type returns the notification type identifier for YZJ.
@@ -34,6 +36,13 @@ func (y YZJ) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(y.Base, false), formatNotification(y.YZJDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (y YZJ) Redacted() YZJ {
+	y.Base = y.Base.Redacted()
+
+	return secret.Redact(y)
+}
+
 // UnmarshalJSON unmarshals JSON data into a YZJ notification.
 func (y *YZJ) UnmarshalJSON(data []byte) error {
 	detail := YZJDetails{}
@@ -54,3 +63,12 @@ func (y *YZJ) UnmarshalJSON(data []byte) error {
 func (y YZJ) MarshalJSON() ([]byte, error) {
 	return marshalJSON(y.Base, y.YZJDetails)
 }
+
+// Validate checks the YZJ notification configuration.
+func (y YZJ) Validate() error {
+	check := newValidator(y.Base, y.Type())
+	check.requiredURL("yzjWebHookUrl", y.WebHookURL)
+	check.required("yzjToken", y.Token)
+
+	return check.err()
+}
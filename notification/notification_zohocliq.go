@@ -2,6 +2,8 @@ package notification
 
 import (
 	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // ZohoCliq represents a Zoho Cliq notification provider.
@@ -32,6 +34,13 @@ func (z ZohoCliq) String() string {
 	return fmt.Sprintf("%s, %s", formatNotification(z.Base, false), formatNotification(z.ZohoCliqDetails, true))
 }
 
+// Redacted returns a copy of the notification, where all secret values are masked.
+func (z ZohoCliq) Redacted() ZohoCliq {
+	z.Base = z.Base.Redacted()
+
+	return secret.Redact(z)
+}
+
 // UnmarshalJSON unmarshals JSON data into a ZohoCliq notification.
 func (z *ZohoCliq) UnmarshalJSON(data []byte) error {
 	detail := ZohoCliqDetails{}
@@ -52,3 +61,11 @@ func (z *ZohoCliq) UnmarshalJSON(data []byte) error {
 func (z ZohoCliq) MarshalJSON() ([]byte, error) {
 	return marshalJSON(z.Base, z.ZohoCliqDetails)
 }
+
+// Validate checks the ZohoCliq notification configuration.
+func (z ZohoCliq) Validate() error {
+	check := newValidator(z.Base, z.Type())
+	check.requiredURL("webhookUrl", z.WebhookURL)
+
+	return check.err()
+}
@@ -0,0 +1,115 @@
+package notification
+
+// Types returns a zero value of every known notification type, e.g. &AlertNow{}.
+// The types are returned as pointers, such that they can be used as target
+// for unmarshaling.
+func Types() []Notification {
+	return []Notification{
+		&AlertNow{},
+		&Alerta{},
+		&AliyunSMS{},
+		&Apprise{},
+		&Bale{},
+		&Bark{},
+		&Bitrix24{},
+		&Brevo{},
+		&CallMeBot{},
+		&Cellsynt{},
+		&ClickSendSMS{},
+		&DingDing{},
+		&Discord{},
+		&EgoSMS{},
+		&Evolution{},
+		&Feishu{},
+		&FlashDuty{},
+		&Fluxer{},
+		&FortySixElks{},
+		&FreeMobile{},
+		&GTXMessaging{},
+		&GoAlert{},
+		&GoogleChat{},
+		&GoogleSheets{},
+		&Gorush{},
+		&Gotify{},
+		&GrafanaOncall{},
+		&HaloPSA{},
+		&HeiiOnCall{},
+		&HomeAssistant{},
+		&JiraServiceManagement{},
+		&Keep{},
+		&Kook{},
+		&Line{},
+		&LunaSea{},
+		&Matrix{},
+		&Mattermost{},
+		&Max{},
+		&NextcloudTalk{},
+		&Nostr{},
+		&Notifery{},
+		&Ntfy{},
+		&Octopush{},
+		&OneBot{},
+		&OneChat{},
+		&OneSender{},
+		&Opsgenie{},
+		&PagerDuty{},
+		&PagerTree{},
+		&PromoSMS{},
+		&Pumble{},
+		&PushDeer{},
+		&PushPlus{},
+		&Pushbullet{},
+		&Pushover{},
+		&Pushy{},
+		&Resend{},
+		&RocketChat{},
+		&SIGNL4{},
+		&SMSC{},
+		&SMSEagle{},
+		&SMSIR{},
+		&SMSManager{},
+		&SMSPartner{},
+		&SMSPlanet{},
+		&SMTP{},
+		&SendGrid{},
+		&ServerChan{},
+		&SerwerSMS{},
+		&SevenIO{},
+		&Signal{},
+		&Slack{},
+		&Splunk{},
+		&SpugPush{},
+		&Squadcast{},
+		&Stackfield{},
+		&Teams{},
+		&TechulusPush{},
+		&Telegram{},
+		&Telnyx{},
+		&Teltonika{},
+		&Threema{},
+		&Twilio{},
+		&VK{},
+		&VKTeams{},
+		&WAHA{},
+		&WPush{},
+		&WeCom{},
+		&Webhook{},
+		&Webpush{},
+		&Whapi{},
+		&Whatsapp360messenger{},
+		&YZJ{},
+		&ZohoCliq{},
+	}
+}
+
+// New returns a zero value of the notification type with the given type name,
+// e.g. "discord". False is returned, if the type is not known.
+func New(typ string) (Notification, bool) {
+	for _, t := range Types() {
+		if t.Type() == typ {
+			return t, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,30 @@
+package notification_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/notification"
+)
+
+func TestTypes(t *testing.T) {
+	seen := map[string]bool{}
+	for _, typ := range notification.Types() {
+		require.NotEmpty(t, typ.Type(), "%T", typ)
+		require.False(t, seen[typ.Type()], "duplicate type %q", typ.Type())
+
+		seen[typ.Type()] = true
+
+		n, ok := notification.New(typ.Type())
+		require.True(t, ok)
+		require.IsType(t, typ, n)
+	}
+
+	n, ok := notification.New("discord")
+	require.True(t, ok)
+	require.Equal(t, "discord", n.Type())
+
+	_, ok = notification.New("unknown")
+	require.False(t, ok)
+}
@@ -0,0 +1,244 @@
+package notification
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Validator is the interface implemented by notification types, which are
+// able to check their configuration before it is sent to the server.
+type Validator interface {
+	// Validate returns a *ValidationError if the configuration is invalid.
+	Validate() error
+}
+
+// FieldError describes a single invalid field of a notification.
+type FieldError struct {
+	// Field is the JSON name of the invalid field.
+	Field string
+	// Message describes why the field is invalid.
+	Message string
+}
+
+func (e FieldError) String() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationError is returned by Validate if one or more fields of a
+// notification are invalid. It lists every invalid field.
+type ValidationError struct {
+	// Type is the notification type, which failed the validation.
+	Type string
+	// Fields contains one entry for each invalid field.
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		fields = append(fields, f.String())
+	}
+
+	return fmt.Sprintf("invalid %s notification: %s", e.Type, strings.Join(fields, "; "))
+}
+
+// Validate checks the fields common to all notification types.
+func (b Base) Validate() error {
+	return newValidator(b, b.Type()).err()
+}
+
+// validator collects field errors while validating a notification.
+type validator struct {
+	typ    string
+	fields []FieldError
+}
+
+// newValidator returns a validator for a notification of the given type,
+// which already contains the validation results of the common base fields.
+func newValidator(base Base, typ string) *validator {
+	v := &validator{
+		typ: typ,
+	}
+
+	v.required("name", base.Name)
+
+	return v
+}
+
+// fail records a field error.
+func (v *validator) fail(field string, format string, args ...any) {
+	v.fields = append(v.fields, FieldError{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// required checks, that value is not empty.
+func (v *validator) required(field string, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.fail(field, "is required")
+	}
+}
+
+// url checks, that value is an absolute URL with one of the given schemes.
+// If no schemes are given, http and https are accepted.
+// Empty values are accepted, use requiredURL for mandatory fields.
+func (v *validator) url(field string, value string, schemes ...string) {
+	if value == "" {
+		return
+	}
+
+	if len(schemes) == 0 {
+		schemes = []string{"http", "https"}
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" || !slices.Contains(schemes, strings.ToLower(u.Scheme)) {
+		v.fail(field, "must be a valid %s URL", strings.Join(schemes, " or "))
+	}
+}
+
+// requiredURL checks, that value is not empty and is a valid URL.
+func (v *validator) requiredURL(field string, value string, schemes ...string) {
+	if strings.TrimSpace(value) == "" {
+		v.fail(field, "is required")
+		return
+	}
+
+	v.url(field, value, schemes...)
+}
+
+// phone checks, that value looks like a phone number. Besides digits, an
+// optional leading plus sign as well as spaces, dashes, dots and parentheses
+// are accepted as separators. Empty values are accepted, use requiredPhone
+// for mandatory fields.
+func (v *validator) phone(field string, value string) {
+	if value == "" {
+		return
+	}
+
+	if !isPhoneNumber(value) {
+		v.fail(field, "must be a valid phone number")
+	}
+}
+
+// requiredPhone checks, that value is not empty and is a valid phone number.
+func (v *validator) requiredPhone(field string, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.fail(field, "is required")
+		return
+	}
+
+	v.phone(field, value)
+}
+
+// phoneList checks, that value is a list of phone numbers separated by
+// commas, semicolons or newlines.
+func (v *validator) phoneList(field string, value string) {
+	for number := range strings.FieldsFuncSeq(value, isListSeparator) {
+		if !isPhoneNumber(strings.TrimSpace(number)) {
+			v.fail(field, "contains invalid phone number %q", strings.TrimSpace(number))
+		}
+	}
+}
+
+// email checks, that value is a valid email address. Empty values are
+// accepted, use requiredEmail for mandatory fields.
+func (v *validator) email(field string, value string) {
+	if value == "" {
+		return
+	}
+
+	_, err := mail.ParseAddress(value)
+	if err != nil {
+		v.fail(field, "must be a valid email address")
+	}
+}
+
+// requiredEmail checks, that value is not empty and is a valid email address.
+func (v *validator) requiredEmail(field string, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.fail(field, "is required")
+		return
+	}
+
+	v.email(field, value)
+}
+
+// emailList checks, that value is a list of email addresses separated by
+// commas, semicolons or newlines.
+func (v *validator) emailList(field string, value string) {
+	for address := range strings.FieldsFuncSeq(value, isListSeparator) {
+		address = strings.TrimSpace(address)
+		if address == "" {
+			continue
+		}
+
+		_, err := mail.ParseAddress(address)
+		if err != nil {
+			v.fail(field, "contains invalid email address %q", address)
+		}
+	}
+}
+
+// oneOf checks, that value is one of the allowed values. Empty values are
+// accepted, if the empty string is part of the allowed values.
+func (v *validator) oneOf(field string, value string, allowed ...string) {
+	if slices.Contains(allowed, value) {
+		return
+	}
+
+	quoted := make([]string, 0, len(allowed))
+	for _, a := range allowed {
+		quoted = append(quoted, strconv.Quote(a))
+	}
+
+	v.fail(field, "must be one of %s", strings.Join(quoted, ", "))
+}
+
+// intRange checks, that value is within the inclusive range [minimum, maximum].
+func (v *validator) intRange(field string, value int64, minimum int64, maximum int64) {
+	if value < minimum || value > maximum {
+		v.fail(field, "must be between %d and %d", minimum, maximum)
+	}
+}
+
+// err returns a *ValidationError, if any field errors have been collected.
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+
+	return &ValidationError{
+		Type:   v.typ,
+		Fields: v.fields,
+	}
+}
+
+// isPhoneNumber reports whether s looks like a phone number.
+func isPhoneNumber(s string) bool {
+	digits := 0
+	for i, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+
+		case r == '+' && i == 0:
+		case r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+		default:
+			return false
+		}
+	}
+
+	return digits >= 3 && digits <= 15
+}
+
+// isListSeparator reports whether r separates entries in a list of phone
+// numbers or email addresses.
+func isListSeparator(r rune) bool {
+	return r == ',' || r == ';' || r == '\n' || r == '\r'
+}
@@ -0,0 +1,218 @@
+package notification_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/internal/ptr"
+	"github.com/breml/go-uptime-kuma-client/notification"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		notif notification.Validator
+
+		wantFields []notification.FieldError
+	}{
+		{
+			name: "twilio valid",
+			notif: notification.Twilio{
+				Base: notification.Base{Name: "Twilio"},
+				TwilioDetails: notification.TwilioDetails{
+					AccountSID: "AC123",
+					AuthToken:  "token",
+					ToNumber:   "+41 79 123 45 67",
+					FromNumber: "+15551234567",
+				},
+			},
+		},
+		{
+			name: "twilio missing fields and invalid phone number",
+			notif: notification.Twilio{
+				TwilioDetails: notification.TwilioDetails{
+					AccountSID: "AC123",
+					ToNumber:   "call me maybe",
+				},
+			},
+
+			wantFields: []notification.FieldError{
+				{Field: "name", Message: "is required"},
+				{Field: "twilioAuthToken", Message: "is required"},
+				{Field: "twilioToNumber", Message: "must be a valid phone number"},
+				{Field: "twilioFromNumber", Message: "is required"},
+			},
+		},
+		{
+			name: "smtp valid",
+			notif: notification.SMTP{
+				Base: notification.Base{Name: "SMTP"},
+				SMTPDetails: notification.SMTPDetails{
+					Host: "smtp.example.com",
+					Port: 587,
+					From: "Uptime Kuma <kuma@example.com>",
+					To:   "ops@example.com, oncall@example.com",
+				},
+			},
+		},
+		{
+			name: "smtp invalid port, sender and no recipients",
+			notif: notification.SMTP{
+				Base: notification.Base{Name: "SMTP"},
+				SMTPDetails: notification.SMTPDetails{
+					Host: "smtp.example.com",
+					Port: 70000,
+					From: "not an email",
+				},
+			},
+
+			wantFields: []notification.FieldError{
+				{Field: "smtpPort", Message: "must be between 1 and 65535"},
+				{Field: "smtpFrom", Message: "must be a valid email address"},
+				{Field: "smtpTo", Message: "at least one recipient in smtpTo, smtpCC or smtpBCC is required"},
+			},
+		},
+		{
+			name: "pagerduty valid",
+			notif: notification.PagerDuty{
+				Base: notification.Base{Name: "PagerDuty"},
+				PagerDutyDetails: notification.PagerDutyDetails{
+					IntegrationURL: "https://events.pagerduty.com/v2/enqueue",
+					IntegrationKey: "key",
+					Priority:       "critical",
+				},
+			},
+		},
+		{
+			name: "pagerduty invalid url and priority",
+			notif: notification.PagerDuty{
+				Base: notification.Base{Name: "PagerDuty"},
+				PagerDutyDetails: notification.PagerDutyDetails{
+					IntegrationURL: "events.pagerduty.com",
+					IntegrationKey: "key",
+					Priority:       "urgent",
+				},
+			},
+
+			wantFields: []notification.FieldError{
+				{Field: "pagerdutyIntegrationUrl", Message: "must be a valid http or https URL"},
+				{
+					Field:   "pagerdutyPriority",
+					Message: `must be one of "", "info", "warning", "error", "critical"`,
+				},
+			},
+		},
+		{
+			name: "ntfy access token required for token authentication",
+			notif: notification.Ntfy{
+				Base: notification.Base{Name: "Ntfy"},
+				NtfyDetails: notification.NtfyDetails{
+					ServerURL:            "https://ntfy.sh",
+					Topic:                "alerts",
+					Priority:             6,
+					AuthenticationMethod: "accessToken",
+					Call:                 ptr.To("+12223334444"),
+				},
+			},
+
+			wantFields: []notification.FieldError{
+				{Field: "ntfyPriority", Message: "must be between 1 and 5"},
+				{Field: "ntfyaccesstoken", Message: "is required"},
+			},
+		},
+		{
+			name: "webpush requires https endpoint",
+			notif: notification.Webpush{
+				Base: notification.Base{Name: "Webpush"},
+				WebpushDetails: notification.WebpushDetails{
+					Subscription: notification.WebpushSubscription{
+						Endpoint: "http://push.example.com/abc",
+						Keys: notification.WebpushSubscriptionKeys{
+							P256dh: "p256dh",
+							Auth:   "auth",
+						},
+					},
+				},
+			},
+
+			wantFields: []notification.FieldError{
+				{Field: "subscription.endpoint", Message: "must be a valid https URL"},
+			},
+		},
+		{
+			name: "smsmanager phone number list",
+			notif: notification.SMSManager{
+				Base: notification.Base{Name: "SMSManager"},
+				SMSManagerDetails: notification.SMSManagerDetails{
+					APIKey:  "key",
+					Numbers: "420999888777, +420111222333;invalid",
+				},
+			},
+
+			wantFields: []notification.FieldError{
+				{Field: "numbers", Message: `contains invalid phone number "invalid"`},
+			},
+		},
+		{
+			name: "generic requires type",
+			notif: notification.Generic{
+				Base: notification.Base{Name: "Generic"},
+			},
+
+			wantFields: []notification.FieldError{
+				{Field: "type", Message: "is required"},
+			},
+		},
+		{
+			name: "generic unknown type",
+			notif: notification.Generic{
+				Base:     notification.Base{Name: "Generic"},
+				TypeName: "unknown",
+			},
+		},
+		{
+			name: "generic validated as twilio",
+			notif: notification.Generic{
+				Base:     notification.Base{Name: "Generic"},
+				TypeName: "twilio",
+				GenericDetails: notification.GenericDetails{
+					"twilioAccountSID": "AC123",
+					"twilioToNumber":   "call me maybe",
+				},
+			},
+
+			wantFields: []notification.FieldError{
+				{Field: "twilioAuthToken", Message: "is required"},
+				{Field: "twilioToNumber", Message: "must be a valid phone number"},
+				{Field: "twilioFromNumber", Message: "is required"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.notif.Validate()
+			if len(tc.wantFields) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var validationErr *notification.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			require.Equal(t, tc.wantFields, validationErr.Fields)
+		})
+	}
+}
+
+func TestValidationError_Error(t *testing.T) {
+	err := &notification.ValidationError{
+		Type: "twilio",
+		Fields: []notification.FieldError{
+			{Field: "name", Message: "is required"},
+			{Field: "twilioToNumber", Message: "must be a valid phone number"},
+		},
+	}
+
+	require.EqualError(t, err, "invalid twilio notification: name: is required; twilioToNumber: must be a valid phone number")
+}
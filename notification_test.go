@@ -4413,7 +4413,7 @@ func TestNotificationCRUD(t *testing.T) {
 					Username:    "admin",
 					Password:    "secret-password",
 					Modem:       "1-1",
-					PhoneNumber: "+336xxxxxxxx",
+					PhoneNumber: "+33612345678",
 					UnsafeTLS:   false,
 				},
 			},
@@ -4424,7 +4424,7 @@ func TestNotificationCRUD(t *testing.T) {
 				}
 
 				teltonika.Name = "Test Teltonika Updated"
-				teltonika.PhoneNumber = "+496xxxxxxxx"
+				teltonika.PhoneNumber = "+4961234567"
 				teltonika.UnsafeTLS = true
 			},
 			verifyCreatedFunc: func(t *testing.T, actual notification.Notification, expected notification.Notification, id int64) {
@@ -0,0 +1,31 @@
+// Package otelkuma instruments the Uptime Kuma client with OpenTelemetry.
+//
+// NewInterceptor returns an interceptor for kuma.WithInterceptor, which
+// creates a span for every socket command sent by the client, e.g. add,
+// editMonitor or saveStatusPage. The spans are children of the span in the
+// context passed to the client methods, such that the calls to Uptime Kuma
+// show up in the traces of the caller. Each command is counted and its
+// latency is recorded in a histogram. ObserveCache reports the sizes of the
+// local state cache of the client as gauge.
+//
+// Example usage:
+//
+//	interceptor, err := otelkuma.NewInterceptor()
+//	if err != nil {
+//	    return err
+//	}
+//
+//	client, err := kuma.New(ctx, url, username, password, kuma.WithInterceptor(interceptor))
+//	if err != nil {
+//	    return err
+//	}
+//
+//	registration, err := otelkuma.ObserveCache(client)
+//	if err != nil {
+//	    return err
+//	}
+//	defer registration.Unregister()
+//
+// By default, the global tracer and meter providers are used, see
+// WithTracerProvider and WithMeterProvider.
+package otelkuma
@@ -0,0 +1,205 @@
+package otelkuma
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+)
+
+// ScopeName is the instrumentation scope name of the tracer and the meter.
+const ScopeName = "github.com/breml/go-uptime-kuma-client/otelkuma"
+
+// Attribute keys of the spans and metrics.
+const (
+	// CommandKey is the name of the socket command, e.g. editMonitor.
+	CommandKey = attribute.Key("kuma.command")
+
+	// EntityIDKey is the ID of the entity, the command refers to, e.g. the
+	// ID of the monitor for editMonitor.
+	EntityIDKey = attribute.Key("kuma.entity.id")
+
+	// AckOKKey reports, whether the command has been acknowledged with ok
+	// by the server.
+	AckOKKey = attribute.Key("kuma.ack.ok")
+
+	// AckMsgKey is the message of the acknowledgement of the server.
+	AckMsgKey = attribute.Key("kuma.ack.msg")
+
+	// CacheKey is the name of the cache, e.g. monitors.
+	CacheKey = attribute.Key("kuma.cache")
+)
+
+// Option is a functional option for configuring the instrumentation.
+type Option func(c *config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// WithTracerProvider sets the tracer provider. By default, the global
+// tracer provider is used.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		if provider != nil {
+			c.tracerProvider = provider
+		}
+	}
+}
+
+// WithMeterProvider sets the meter provider. By default, the global meter
+// provider is used.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		if provider != nil {
+			c.meterProvider = provider
+		}
+	}
+}
+
+func newConfig(opts []Option) config {
+	c := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
+
+// NewInterceptor returns an interceptor, which traces the socket commands
+// and records their count and latency.
+func NewInterceptor(opts ...Option) (kuma.Interceptor, error) {
+	c := newConfig(opts)
+
+	tracer := c.tracerProvider.Tracer(ScopeName)
+	meter := c.meterProvider.Meter(ScopeName)
+
+	commands, err := meter.Int64Counter(
+		"kuma.client.commands",
+		metric.WithDescription("Number of socket commands sent to Uptime Kuma."),
+		metric.WithUnit("{command}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create commands counter: %w", err)
+	}
+
+	duration, err := meter.Float64Histogram(
+		"kuma.client.command.duration",
+		metric.WithDescription("Duration of socket commands sent to Uptime Kuma until they are acknowledged."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create duration histogram: %w", err)
+	}
+
+	return func(
+		ctx context.Context,
+		command string,
+		args []any,
+		next kuma.Invoker,
+	) (kuma.AckResponse, error) {
+		ctx, span := tracer.Start(ctx, "kuma "+command,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(CommandKey.String(command)),
+		)
+		defer span.End()
+
+		start := time.Now()
+		response, err := next(ctx, command, args)
+		elapsed := time.Since(start)
+
+		if id, ok := entityID(command, args, response); ok {
+			span.SetAttributes(EntityIDKey.Int64(id))
+		}
+
+		span.SetAttributes(AckOKKey.Bool(err == nil))
+
+		if response.Msg != "" {
+			span.SetAttributes(AckMsgKey.String(response.Msg))
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		attrs := metric.WithAttributes(CommandKey.String(command), AckOKKey.Bool(err == nil))
+		commands.Add(ctx, 1, attrs)
+		duration.Record(ctx, elapsed.Seconds(), attrs)
+
+		return response, err
+	}, nil
+}
+
+// entityID returns the ID of the entity, the command refers to. The ID is
+// taken from the arguments, e.g. the monitor ID of deleteMonitor or
+// addMonitorTag, see kuma.CommandTarget, or from the acknowledgement for
+// commands, which create an entity.
+func entityID(command string, args []any, response kuma.AckResponse) (int64, bool) {
+	_, id, _ := kuma.CommandTarget(command, args)
+	if id != 0 {
+		return id, true
+	}
+
+	for _, id := range []int64{response.MonitorID, response.MaintenanceID, response.ID} {
+		if id != 0 {
+			return id, true
+		}
+	}
+
+	return 0, false
+}
+
+// ObserveCache registers a gauge, which reports the number of entries in the
+// local state cache of the client. The registration must be unregistered,
+// if the client is disconnected.
+func ObserveCache(client *kuma.Client, opts ...Option) (metric.Registration, error) {
+	c := newConfig(opts)
+
+	meter := c.meterProvider.Meter(ScopeName)
+
+	size, err := meter.Int64ObservableGauge(
+		"kuma.client.cache.size",
+		metric.WithDescription("Number of entries in the local state cache of the Uptime Kuma client."),
+		metric.WithUnit("{entry}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create cache size gauge: %w", err)
+	}
+
+	registration, err := meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		stats := client.CacheStats()
+
+		for name, value := range map[string]int{
+			"monitors":        stats.Monitors,
+			"notifications":   stats.Notifications,
+			"status_pages":    stats.StatusPages,
+			"maintenances":    stats.Maintenances,
+			"proxies":         stats.Proxies,
+			"docker_hosts":    stats.DockerHosts,
+			"remote_browsers": stats.RemoteBrowsers,
+			"heartbeats":      stats.Heartbeats,
+		} {
+			o.ObserveInt64(size, int64(value), metric.WithAttributes(CacheKey.String(name)))
+		}
+
+		return nil
+	}, size)
+	if err != nil {
+		return nil, fmt.Errorf("register cache size callback: %w", err)
+	}
+
+	return registration, nil
+}
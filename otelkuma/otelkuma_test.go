@@ -0,0 +1,119 @@
+package otelkuma_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/otelkuma"
+)
+
+func TestNewInterceptor(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	interceptor, err := otelkuma.NewInterceptor(
+		otelkuma.WithTracerProvider(tracerProvider),
+		otelkuma.WithMeterProvider(meterProvider),
+	)
+	require.NoError(t, err)
+
+	ctx, parent := tracerProvider.Tracer("test").Start(t.Context(), "reconcile")
+
+	var nextSpan trace.SpanContext
+
+	_, err = interceptor(ctx, "editMonitor", []any{map[string]any{"id": float64(42), "name": "website"}},
+		func(ctx context.Context, _ string, _ []any) (kuma.AckResponse, error) {
+			nextSpan = trace.SpanContextFromContext(ctx)
+
+			return kuma.AckResponse{OK: true, Msg: "Saved."}, nil
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = interceptor(ctx, "add", []any{map[string]any{"name": "website"}},
+		func(context.Context, string, []any) (kuma.AckResponse, error) {
+			return kuma.AckResponse{OK: true, MonitorID: 7}, nil
+		},
+	)
+	require.NoError(t, err)
+
+	errFailed := errors.New("deleteMonitor: monitor not found")
+	_, err = interceptor(ctx, "deleteMonitor", []any{int64(13)},
+		func(context.Context, string, []any) (kuma.AckResponse, error) {
+			return kuma.AckResponse{}, errFailed
+		},
+	)
+	require.ErrorIs(t, err, errFailed)
+
+	// The entity of addMonitorTag is the monitor, not the tag.
+	_, err = interceptor(ctx, "addMonitorTag", []any{int64(5), int64(9), "prod"},
+		func(context.Context, string, []any) (kuma.AckResponse, error) {
+			return kuma.AckResponse{OK: true}, nil
+		},
+	)
+	require.NoError(t, err)
+
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 5)
+
+	edit := spans[0]
+	require.Equal(t, "kuma editMonitor", edit.Name())
+	require.Equal(t, trace.SpanKindClient, edit.SpanKind())
+	require.Equal(t, parent.SpanContext().SpanID(), edit.Parent().SpanID())
+	require.Equal(t, edit.SpanContext(), nextSpan)
+	require.ElementsMatch(t, []attribute.KeyValue{
+		otelkuma.CommandKey.String("editMonitor"),
+		otelkuma.EntityIDKey.Int64(42),
+		otelkuma.AckOKKey.Bool(true),
+		otelkuma.AckMsgKey.String("Saved."),
+	}, edit.Attributes())
+
+	add := spans[1]
+	require.Contains(t, add.Attributes(), otelkuma.EntityIDKey.Int64(7))
+
+	del := spans[2]
+	require.Contains(t, del.Attributes(), otelkuma.EntityIDKey.Int64(13))
+	require.Contains(t, del.Attributes(), otelkuma.AckOKKey.Bool(false))
+	require.Equal(t, codes.Error, del.Status().Code)
+	require.Len(t, del.Events(), 1)
+
+	monitorTag := spans[3]
+	require.Contains(t, monitorTag.Attributes(), otelkuma.EntityIDKey.Int64(9))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Equal(t, otelkuma.ScopeName, rm.ScopeMetrics[0].Scope.Name)
+
+	metrics := map[string]metricdata.Metrics{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m
+	}
+
+	commands, ok := metrics["kuma.client.commands"].Data.(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, commands.DataPoints, 4)
+
+	for _, dp := range commands.DataPoints {
+		require.Equal(t, int64(1), dp.Value)
+	}
+
+	duration, ok := metrics["kuma.client.command.duration"].Data.(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, duration.DataPoints, 4)
+}
@@ -13,8 +13,10 @@ func (c *Client) GetProxyList(_ context.Context) []proxy.Proxy {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	proxies := make([]proxy.Proxy, len(c.state.proxies))
-	copy(proxies, c.state.proxies)
+	cached := c.proxiesLocked()
+
+	proxies := make([]proxy.Proxy, len(cached))
+	copy(proxies, cached)
 
 	return proxies
 }
@@ -24,7 +26,7 @@ func (c *Client) GetProxy(_ context.Context, id int64) (*proxy.Proxy, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	for _, p := range c.state.proxies {
+	for _, p := range c.proxiesLocked() {
 		if p.GetID() == id {
 			return &p, nil
 		}
@@ -34,7 +36,13 @@ func (c *Client) GetProxy(_ context.Context, id int64) (*proxy.Proxy, error) {
 }
 
 // CreateProxy creates a new proxy.
+// Secret references are resolved, if a SecretResolver is configured.
 func (c *Client) CreateProxy(ctx context.Context, config proxy.Config) (int64, error) {
+	config, err := resolveSecrets(ctx, c.secretResolver, config)
+	if err != nil {
+		return 0, fmt.Errorf("create proxy: %w", err)
+	}
+
 	response, err := c.syncEmitWithUpdateEvent(ctx, "addProxy", "proxyList", config, nil)
 	if err != nil {
 		return 0, fmt.Errorf("create proxy: %w", err)
@@ -44,12 +52,18 @@ func (c *Client) CreateProxy(ctx context.Context, config proxy.Config) (int64, e
 }
 
 // UpdateProxy updates an existing proxy.
+// Secret references are resolved, if a SecretResolver is configured.
 func (c *Client) UpdateProxy(ctx context.Context, config proxy.Config) error {
 	if config.ID == 0 {
 		return errors.New("update proxy: config must have ID set")
 	}
 
-	_, err := c.syncEmitWithUpdateEvent(ctx, "addProxy", "proxyList", config, config.ID)
+	config, err := resolveSecrets(ctx, c.secretResolver, config)
+	if err != nil {
+		return fmt.Errorf("update proxy: %w", err)
+	}
+
+	_, err = c.syncEmitWithUpdateEvent(ctx, "addProxy", "proxyList", config, config.ID)
 	if err != nil {
 		return fmt.Errorf("update proxy: %w", err)
 	}
@@ -57,9 +71,15 @@ func (c *Client) UpdateProxy(ctx context.Context, config proxy.Config) error {
 	return nil
 }
 
-// DeleteProxy deletes a proxy by ID.
-func (c *Client) DeleteProxy(ctx context.Context, id int64) error {
-	_, err := c.syncEmitWithUpdateEvent(ctx, "deleteProxy", "proxyList", id)
+// DeleteProxy deletes a proxy by ID. The options control, how monitors
+// referencing the proxy are handled, see DeleteOption.
+func (c *Client) DeleteProxy(ctx context.Context, id int64, opts ...DeleteOption) error {
+	err := c.handleDependents(ctx, ResourceProxy, id, opts)
+	if err != nil {
+		return fmt.Errorf("delete proxy %d: %w", id, err)
+	}
+
+	_, err = c.syncEmitWithUpdateEvent(ctx, "deleteProxy", "proxyList", id)
 	if err != nil {
 		return fmt.Errorf("delete proxy %d: %w", id, err)
 	}
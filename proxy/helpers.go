@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // formatProxy formats a Proxy instance as a string representation.
-// It masks the password field for security purposes before including in the output.
+// It masks secret fields like the password for security purposes before including in the output.
 // The function uses reflection to iterate through exported fields and builds a string representation.
 func formatProxy(p Proxy) string {
 	buf := strings.Builder{}
@@ -28,8 +30,8 @@ func formatProxy(p Proxy) string {
 
 		var valueStr string
 		switch {
-		case field.Name == "Password" && value.Kind() == reflect.String && value.String() != "":
-			valueStr = "\"***\""
+		case secret.IsSecret(field) && secret.IsMasked(value):
+			valueStr = fmt.Sprintf("%q", secret.Mask)
 
 		case value.Kind() == reflect.String:
 			valueStr = fmt.Sprintf("%q", value.String())
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
 )
 
 // Proxy represents a proxy server configuration in Uptime Kuma.
@@ -16,7 +18,7 @@ type Proxy struct {
 	Port        int       `json:"port"`
 	Auth        bool      `json:"auth"`
 	Username    string    `json:"username"`
-	Password    string    `json:"password"`
+	Password    string    `json:"password" kuma:"secret"`
 	Active      bool      `json:"active"`
 	Default     bool      `json:"default"`
 	CreatedDate time.Time `json:"-"`
@@ -31,6 +33,12 @@ func (p Proxy) String() string {
 	return formatProxy(p)
 }
 
+// Redacted returns a copy of the proxy, where all secret values like the
+// password are masked.
+func (p Proxy) Redacted() Proxy {
+	return secret.Redact(p)
+}
+
 // UnmarshalJSON unmarshals a proxy from JSON data.
 func (p *Proxy) UnmarshalJSON(data []byte) error {
 	aux := &struct {
@@ -152,7 +160,7 @@ type Config struct {
 	Port          int    `json:"port"`
 	Auth          bool   `json:"auth"`
 	Username      string `json:"username,omitempty"`
-	Password      string `json:"password,omitempty"`
+	Password      string `json:"password,omitempty" kuma:"secret"`
 	Active        bool   `json:"active"`
 	Default       bool   `json:"default"`
 	ApplyExisting bool   `json:"applyExisting"`
@@ -119,3 +119,27 @@ func TestProxy_GetID(t *testing.T) {
 	p := proxy.Proxy{ID: 42}
 	require.Equal(t, int64(42), p.GetID())
 }
+
+func TestProxy_Redacted(t *testing.T) {
+	p := proxy.Proxy{
+		ID:       1,
+		Protocol: "http",
+		Host:     "proxy.example.com",
+		Port:     8080,
+		Auth:     true,
+		Username: "user",
+		Password: "secret",
+	}
+
+	require.Contains(t, p.String(), `password: "***"`)
+	require.NotContains(t, p.String(), "secret")
+
+	redacted := p.Redacted()
+	require.Equal(t, "***", redacted.Password)
+	require.Equal(t, "user", redacted.Username)
+	require.Equal(t, "secret", p.Password)
+
+	p.Password = ""
+	require.Contains(t, p.String(), `password: ""`)
+	require.Empty(t, p.Redacted().Password)
+}
@@ -0,0 +1,94 @@
+package kuma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/breml/go-uptime-kuma-client/remotebrowser"
+)
+
+// GetRemoteBrowserList returns all remote browsers for the authenticated user.
+func (c *Client) GetRemoteBrowserList(_ context.Context) []remotebrowser.RemoteBrowser {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached := c.remoteBrowsersLocked()
+
+	browsers := make([]remotebrowser.RemoteBrowser, len(cached))
+	copy(browsers, cached)
+
+	return browsers
+}
+
+// GetRemoteBrowser returns a specific remote browser by ID.
+func (c *Client) GetRemoteBrowser(_ context.Context, id int64) (*remotebrowser.RemoteBrowser, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, b := range c.remoteBrowsersLocked() {
+		if b.GetID() == id {
+			return &b, nil
+		}
+	}
+
+	return nil, fmt.Errorf("get remote browser: %w", ErrNotFound)
+}
+
+// CreateRemoteBrowser creates a new remote browser and returns its ID.
+// Secret references are resolved, if a SecretResolver is configured.
+func (c *Client) CreateRemoteBrowser(ctx context.Context, config remotebrowser.Config) (int64, error) {
+	config, err := resolveSecrets(ctx, c.secretResolver, config)
+	if err != nil {
+		return 0, fmt.Errorf("create remote browser: %w", err)
+	}
+
+	response, err := c.syncEmitWithUpdateEvent(ctx, "addRemoteBrowser", "remoteBrowserList", config, nil)
+	if err != nil {
+		return 0, fmt.Errorf("create remote browser: %w", err)
+	}
+
+	return response.ID, nil
+}
+
+// UpdateRemoteBrowser updates an existing remote browser.
+// Secret references are resolved, if a SecretResolver is configured.
+func (c *Client) UpdateRemoteBrowser(ctx context.Context, config remotebrowser.Config) error {
+	if config.ID == 0 {
+		return errors.New("update remote browser: config must have ID set")
+	}
+
+	config, err := resolveSecrets(ctx, c.secretResolver, config)
+	if err != nil {
+		return fmt.Errorf("update remote browser: %w", err)
+	}
+
+	_, err = c.syncEmitWithUpdateEvent(ctx, "addRemoteBrowser", "remoteBrowserList", config, config.ID)
+	if err != nil {
+		return fmt.Errorf("update remote browser: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRemoteBrowser deletes a remote browser by ID.
+func (c *Client) DeleteRemoteBrowser(ctx context.Context, id int64) error {
+	_, err := c.syncEmitWithUpdateEvent(ctx, "deleteRemoteBrowser", "remoteBrowserList", id)
+	if err != nil {
+		return fmt.Errorf("delete remote browser %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// TestRemoteBrowser tests the connection from the Uptime Kuma server to the
+// remote browser at the given URL without creating the remote browser.
+// An error is returned, if the server is not able to connect to the browser.
+func (c *Client) TestRemoteBrowser(ctx context.Context, url string) error {
+	_, err := c.syncEmit(ctx, "testRemoteBrowser", url)
+	if err != nil {
+		return fmt.Errorf("test remote browser: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package remotebrowser
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
+)
+
+// formatRemoteBrowser formats a RemoteBrowser instance as a string representation.
+// It masks secret fields like the URL, which usually contains a token.
+// It uses reflection to iterate through exported fields and builds a
+// comma-separated string of field names and values for display.
+func formatRemoteBrowser(r RemoteBrowser) string {
+	buf := strings.Builder{}
+
+	val := reflect.ValueOf(r)
+	typ := reflect.TypeFor[RemoteBrowser]()
+
+	first := true
+	for i := range val.NumField() {
+		field := typ.Field(i)
+		value := val.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		var valueStr string
+		switch {
+		case secret.IsSecret(field) && secret.IsMasked(value):
+			valueStr = fmt.Sprintf("%q", secret.Mask)
+
+		case value.Kind() == reflect.String:
+			valueStr = fmt.Sprintf("%q", value.String())
+
+		default:
+			valueStr = fmt.Sprintf("%v", value.Interface())
+		}
+
+		if !first {
+			buf.WriteString(", ")
+		}
+
+		first = false
+
+		_, _ = fmt.Fprintf(&buf, "%s: %s", name, valueStr)
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,37 @@
+package remotebrowser
+
+import "github.com/breml/go-uptime-kuma-client/internal/secret"
+
+// RemoteBrowser represents a remote browser configuration in Uptime Kuma.
+// Remote browsers are Chromium instances reachable over the Chrome DevTools
+// Protocol, which can be used by real browser monitors instead of the
+// browser bundled with Uptime Kuma.
+type RemoteBrowser struct {
+	ID     int64  `json:"id"`
+	UserID int64  `json:"userId"`
+	Name   string `json:"name"`
+	URL    string `json:"url" kuma:"secret"` // WebSocket URL (e.g., "ws://chrome:3000/?token=secret")
+}
+
+// GetID returns the remote browser's unique identifier.
+func (r RemoteBrowser) GetID() int64 {
+	return r.ID
+}
+
+func (r RemoteBrowser) String() string {
+	return formatRemoteBrowser(r)
+}
+
+// Redacted returns a copy of the remote browser, where the URL, which
+// usually contains a token, is masked.
+func (r RemoteBrowser) Redacted() RemoteBrowser {
+	return secret.Redact(r)
+}
+
+// Config represents the configuration for creating or updating a remote
+// browser. It includes an optional ID field for updates.
+type Config struct {
+	ID   int64  `json:"id,omitempty"`
+	Name string `json:"name"`
+	URL  string `json:"url" kuma:"secret"`
+}
@@ -0,0 +1,98 @@
+package remotebrowser_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/remotebrowser"
+)
+
+func TestRemoteBrowser_GetID(t *testing.T) {
+	r := remotebrowser.RemoteBrowser{
+		ID: 42,
+	}
+
+	require.Equal(t, int64(42), r.GetID())
+}
+
+func TestRemoteBrowser_String(t *testing.T) {
+	r := remotebrowser.RemoteBrowser{
+		ID:     1,
+		UserID: 100,
+		Name:   "Browserless",
+		URL:    "ws://browserless:3000/?token=secret",
+	}
+
+	got := r.String()
+	require.Contains(t, got, "id: 1")
+	require.Contains(t, got, `name: "Browserless"`)
+	require.Contains(t, got, `url: "***"`)
+	require.NotContains(t, got, "token=secret")
+}
+
+func TestRemoteBrowser_Redacted(t *testing.T) {
+	r := remotebrowser.RemoteBrowser{
+		ID:   1,
+		Name: "Browserless",
+		URL:  "ws://browserless:3000/?token=secret",
+	}
+
+	redacted := r.Redacted()
+	require.Equal(t, "***", redacted.URL)
+	require.Equal(t, "Browserless", redacted.Name)
+	require.Equal(t, "ws://browserless:3000/?token=secret", r.URL)
+}
+
+func TestRemoteBrowser_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"id": 1,
+		"userId": 100,
+		"name": "Browserless",
+		"url": "ws://browserless:3000"
+	}`
+
+	var r remotebrowser.RemoteBrowser
+	err := json.Unmarshal([]byte(jsonStr), &r)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(1), r.ID)
+	require.Equal(t, int64(100), r.UserID)
+	require.Equal(t, "Browserless", r.Name)
+	require.Equal(t, "ws://browserless:3000", r.URL)
+}
+
+func TestConfig_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   remotebrowser.Config
+		wantJSON string
+	}{
+		{
+			name: "create",
+			config: remotebrowser.Config{
+				Name: "Browserless",
+				URL:  "ws://browserless:3000",
+			},
+			wantJSON: `{"name":"Browserless","url":"ws://browserless:3000"}`,
+		},
+		{
+			name: "update",
+			config: remotebrowser.Config{
+				ID:   3,
+				Name: "Browserless",
+				URL:  "ws://browserless:3000",
+			},
+			wantJSON: `{"id":3,"name":"Browserless","url":"ws://browserless:3000"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.config)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.wantJSON, string(data))
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package kuma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/remotebrowser"
+)
+
+func TestRemoteBrowserCRUD(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	defer cancel()
+
+	var err error
+
+	t.Run("initial_state", func(t *testing.T) {
+		browsers := client.GetRemoteBrowserList(ctx)
+		t.Logf("Initial remote browsers count: %d", len(browsers))
+	})
+
+	var browserID int64
+	t.Run("create_remote_browser", func(t *testing.T) {
+		initialBrowsers := client.GetRemoteBrowserList(ctx)
+		initialCount := len(initialBrowsers)
+
+		config := remotebrowser.Config{
+			Name: "Browserless",
+			URL:  "ws://browserless.example.com:3000",
+		}
+
+		browserID, err = client.CreateRemoteBrowser(ctx, config)
+		require.NoError(t, err)
+		require.Positive(t, browserID)
+
+		browsers := client.GetRemoteBrowserList(ctx)
+		require.Len(t, browsers, initialCount+1)
+
+		createdBrowser, err := client.GetRemoteBrowser(ctx, browserID)
+		require.NoError(t, err)
+		require.Equal(t, browserID, createdBrowser.GetID())
+		require.Equal(t, "Browserless", createdBrowser.Name)
+		require.Equal(t, "ws://browserless.example.com:3000", createdBrowser.URL)
+	})
+
+	t.Run("update_remote_browser", func(t *testing.T) {
+		config := remotebrowser.Config{
+			ID:   browserID,
+			Name: "Browserless Updated",
+			URL:  "ws://browserless.example.com:3001/?token=secret",
+		}
+
+		err = client.UpdateRemoteBrowser(ctx, config)
+		require.NoError(t, err)
+
+		updatedBrowser, err := client.GetRemoteBrowser(ctx, browserID)
+		require.NoError(t, err)
+		require.Equal(t, "Browserless Updated", updatedBrowser.Name)
+		require.Equal(t, "ws://browserless.example.com:3001/?token=secret", updatedBrowser.URL)
+	})
+
+	t.Run("update_remote_browser_without_id", func(t *testing.T) {
+		err := client.UpdateRemoteBrowser(ctx, remotebrowser.Config{Name: "No ID"})
+		require.Error(t, err)
+	})
+
+	t.Run("delete_remote_browser", func(t *testing.T) {
+		preDeleteBrowsers := client.GetRemoteBrowserList(ctx)
+		preDeleteCount := len(preDeleteBrowsers)
+
+		err := client.DeleteRemoteBrowser(ctx, browserID)
+		require.NoError(t, err)
+
+		browsers := client.GetRemoteBrowserList(ctx)
+		require.Len(t, browsers, preDeleteCount-1)
+
+		_, err = client.GetRemoteBrowser(ctx, browserID)
+		require.Error(t, err)
+		require.ErrorIs(t, err, kuma.ErrNotFound)
+	})
+}
+
+func TestRemoteBrowserTest(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	defer cancel()
+
+	t.Run("test_unreachable_remote_browser", func(t *testing.T) {
+		// The server returns an error for unreachable browsers.
+		err := client.TestRemoteBrowser(ctx, "ws://127.0.0.1:1")
+		require.Error(t, err)
+	})
+}
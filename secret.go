@@ -0,0 +1,117 @@
+package kuma
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
+)
+
+// SecretResolver resolves references to secrets in fields, which are marked
+// as secret, e.g. monitor.HTTPDetails.BasicAuthPass or
+// notification.TelegramDetails.BotToken.
+//
+// A SecretResolver is configured with WithSecretResolver. The client resolves
+// the secret fields of monitors, notifications and proxies right before they
+// are sent to the server. The structs passed by the caller are never
+// modified.
+type SecretResolver interface {
+	// ResolveSecret returns the secret referenced by value. Values, which are
+	// not a reference known to the resolver, are returned unchanged.
+	ResolveSecret(ctx context.Context, value string) (string, error)
+}
+
+// SecretResolverFunc resolves the reference part of a secret reference, that
+// is the part following "<scheme>:".
+type SecretResolverFunc func(ctx context.Context, ref string) (string, error)
+
+// SecretSchemes is a SecretResolver, which resolves references of the form
+// "<scheme>:<ref>" with the SecretResolverFunc registered for the scheme.
+// Values without a registered scheme are returned unchanged.
+type SecretSchemes map[string]SecretResolverFunc
+
+// ResolveSecret resolves value, if it starts with a registered scheme.
+func (s SecretSchemes) ResolveSecret(ctx context.Context, value string) (string, error) {
+	scheme, ref, ok := strings.Cut(value, ":")
+	if !ok {
+		return value, nil
+	}
+
+	resolve, ok := s[scheme]
+	if !ok || resolve == nil {
+		return value, nil
+	}
+
+	resolved, err := resolve(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("resolve %s secret %q: %w", scheme, ref, err)
+	}
+
+	return resolved, nil
+}
+
+// DefaultSecretSchemes returns SecretSchemes with support for the following
+// references:
+//
+//   - env:NAME resolves to the value of the environment variable NAME.
+//   - file:/path resolves to the content of the file at /path, with trailing
+//     newlines removed.
+//
+// Custom schemes can be added to the returned map.
+func DefaultSecretSchemes() SecretSchemes {
+	return SecretSchemes{
+		"env":  resolveEnvSecret,
+		"file": resolveFileSecret,
+	}
+}
+
+// WithSecretResolver configures the client to resolve secret references with
+// the given resolver, e.g. DefaultSecretSchemes().
+// The references are resolved by CreateMonitor, UpdateMonitor,
+// CreateNotification, UpdateNotification, CreateProxy, UpdateProxy,
+// CreateRemoteBrowser and UpdateRemoteBrowser.
+// Only typed fields, which are marked as secret, are resolved. The untyped
+// configuration of monitor.Base, notification.Base and notification.Generic
+// is sent unchanged.
+func WithSecretResolver(resolver SecretResolver) Option {
+	return func(c *Client) {
+		c.secretResolver = resolver
+	}
+}
+
+// resolveSecrets returns a copy of v, where all secret references in fields
+// marked as secret are resolved. v itself is never modified.
+func resolveSecrets[T any](ctx context.Context, resolver SecretResolver, v T) (T, error) {
+	if resolver == nil {
+		return v, nil
+	}
+
+	resolved, err := secret.Transform(v, func(value string) (string, error) {
+		return resolver.ResolveSecret(ctx, value)
+	})
+	if err != nil {
+		return v, fmt.Errorf("resolve secrets: %w", err)
+	}
+
+	return resolved, nil
+}
+
+func resolveEnvSecret(_ context.Context, name string) (string, error) {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("environment variable %q is not set", name)
+	}
+
+	return value, nil
+}
+
+func resolveFileSecret(_ context.Context, path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read secret file: %w", err)
+	}
+
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
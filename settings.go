@@ -3,6 +3,7 @@ package kuma
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/breml/go-uptime-kuma-client/settings"
 )
@@ -24,6 +25,11 @@ func (c *Client) GetSettings(ctx context.Context) (*settings.Settings, error) {
 }
 
 // SetSettings updates the server settings.
+//
+// All settings are replaced by s. Settings, which are not modeled by
+// settings.Settings, are preserved, if s has been retrieved with GetSettings.
+// The password of the current user is only required for disabling the
+// authentication, see PatchSettings for updating individual settings.
 func (c *Client) SetSettings(ctx context.Context, s settings.Settings, password string) error {
 	settingsMap, err := structToMap(s)
 	if err != nil {
@@ -37,3 +43,35 @@ func (c *Client) SetSettings(ctx context.Context, s settings.Settings, password
 
 	return nil
 }
+
+// PatchSettings updates individual server settings. The current settings are
+// retrieved from the server and passed to update, which modifies them in
+// place. The modified settings are written back, such that settings, which
+// are not changed by update, keep their current value, including the
+// settings, which are not modeled by settings.Settings.
+//
+// The password of the user, the client is logged in with, is only sent, if
+// the server requires it, that is, if update disables the authentication.
+func (c *Client) PatchSettings(ctx context.Context, update func(s *settings.Settings)) error {
+	current, err := c.GetSettings(ctx)
+	if err != nil {
+		return fmt.Errorf("patch settings: %w", err)
+	}
+
+	patched := *current
+	patched.TLSExpiryNotifyDays = slices.Clone(current.TLSExpiryNotifyDays)
+
+	update(&patched)
+
+	password := ""
+	if !current.DisableAuth && patched.DisableAuth {
+		password = c.password
+	}
+
+	err = c.SetSettings(ctx, patched, password)
+	if err != nil {
+		return fmt.Errorf("patch settings: %w", err)
+	}
+
+	return nil
+}
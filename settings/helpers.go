@@ -0,0 +1,70 @@
+package settings
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
+)
+
+// formatSettings formats a Settings instance as a string representation.
+// Secret fields like the Steam API key are masked.
+func formatSettings(s Settings) string {
+	buf := strings.Builder{}
+
+	val := reflect.ValueOf(s)
+	typ := reflect.TypeFor[Settings]()
+
+	first := true
+	for i := range val.NumField() {
+		field := typ.Field(i)
+		value := val.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		var valueStr string
+		switch {
+		case secret.IsSecret(field) && secret.IsMasked(value):
+			valueStr = fmt.Sprintf("%q", secret.Mask)
+
+		case value.Kind() == reflect.String:
+			valueStr = fmt.Sprintf("%q", value.String())
+
+		default:
+			valueStr = fmt.Sprintf("%v", value.Interface())
+		}
+
+		if !first {
+			buf.WriteString(", ")
+		}
+
+		first = false
+
+		_, _ = fmt.Fprintf(&buf, "%s: %s", name, valueStr)
+	}
+
+	return buf.String()
+}
+
+// knownNames returns the JSON names of the settings modeled by Settings.
+func knownNames() []string {
+	typ := reflect.TypeFor[Settings]()
+
+	names := make([]string, 0, typ.NumField())
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		names = append(names, name)
+	}
+
+	return names
+}
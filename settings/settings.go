@@ -1,16 +1,119 @@
 package settings
 
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/breml/go-uptime-kuma-client/internal/secret"
+)
+
 // Settings represents the general server settings of Uptime Kuma.
+//
+// Settings, which are not modeled by the struct, are preserved, if the
+// settings have been unmarshaled from the data sent by the server, such that
+// they are not lost, when the settings are written back.
 type Settings struct {
 	ServerTimezone      string `json:"serverTimezone"`
 	KeepDataPeriodDays  int    `json:"keepDataPeriodDays"`
 	CheckUpdate         bool   `json:"checkUpdate"`
+	CheckBeta           bool   `json:"checkBeta"`
 	SearchEngineIndex   bool   `json:"searchEngineIndex"`
 	EntryPage           string `json:"entryPage"`
 	NSCD                bool   `json:"nscd"`
 	TLSExpiryNotifyDays []int  `json:"tlsExpiryNotifyDays"`
 	TrustProxy          bool   `json:"trustProxy"`
 	PrimaryBaseURL      string `json:"primaryBaseURL"`
-	SteamAPIKey         string `json:"steamAPIKey"`
+	SteamAPIKey         string `json:"steamAPIKey" kuma:"secret"`
 	ChromeExecutable    string `json:"chromeExecutable"`
+
+	// DisableAuth disables the authentication of the web interface. Enabling
+	// it requires the password of the current user.
+	DisableAuth bool `json:"disableAuth"`
+
+	// unknown contains the settings, which are not modeled by the struct.
+	unknown map[string]json.RawMessage
+}
+
+// settingsJSON is used to marshal and unmarshal the modeled fields without
+// the custom JSON methods of Settings.
+type settingsJSON Settings
+
+func (s Settings) String() string {
+	return formatSettings(s)
+}
+
+// Redacted returns a copy of the settings, where all secret values like the
+// Steam API key are masked.
+func (s Settings) Redacted() Settings {
+	return secret.Redact(s)
+}
+
+// Unknown returns the settings, which are not modeled by the struct, e.g.
+// settings added by newer versions of Uptime Kuma, by their JSON name.
+func (s Settings) Unknown() map[string]json.RawMessage {
+	return maps.Clone(s.unknown)
+}
+
+// UnmarshalJSON unmarshals the settings from JSON data and keeps the
+// settings, which are not modeled by the struct.
+func (s *Settings) UnmarshalJSON(data []byte) error {
+	var known settingsJSON
+
+	err := json.Unmarshal(data, &known)
+	if err != nil {
+		return fmt.Errorf("unmarshal settings: %w", err)
+	}
+
+	var all map[string]json.RawMessage
+
+	err = json.Unmarshal(data, &all)
+	if err != nil {
+		return fmt.Errorf("unmarshal settings: %w", err)
+	}
+
+	*s = Settings(known)
+
+	names := knownNames()
+	for name, value := range all {
+		if slices.Contains(names, name) {
+			continue
+		}
+
+		if s.unknown == nil {
+			s.unknown = map[string]json.RawMessage{}
+		}
+
+		s.unknown[name] = value
+	}
+
+	return nil
+}
+
+// MarshalJSON marshals the settings to JSON data including the settings,
+// which are not modeled by the struct.
+func (s Settings) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(settingsJSON(s))
+	if err != nil {
+		return nil, fmt.Errorf("marshal settings: %w", err)
+	}
+
+	if len(s.unknown) == 0 {
+		return data, nil
+	}
+
+	all := maps.Clone(s.unknown)
+
+	err = json.Unmarshal(data, &all)
+	if err != nil {
+		return nil, fmt.Errorf("marshal settings: %w", err)
+	}
+
+	data, err = json.Marshal(all)
+	if err != nil {
+		return nil, fmt.Errorf("marshal settings: %w", err)
+	}
+
+	return data, nil
 }
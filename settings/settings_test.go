@@ -100,3 +100,57 @@ func TestSettings_RoundTrip(t *testing.T) {
 
 	require.Equal(t, original, roundTripped)
 }
+
+func TestSettings_Redacted(t *testing.T) {
+	s := settings.Settings{
+		ServerTimezone:      "Europe/Berlin",
+		TLSExpiryNotifyDays: []int{7, 14, 21},
+		SteamAPIKey:         "my-api-key",
+	}
+
+	require.Contains(t, s.String(), `serverTimezone: "Europe/Berlin"`)
+	require.Contains(t, s.String(), `tlsExpiryNotifyDays: [7 14 21]`)
+	require.Contains(t, s.String(), `steamAPIKey: "***"`)
+	require.NotContains(t, s.String(), "my-api-key")
+
+	redacted := s.Redacted()
+	require.Equal(t, "***", redacted.SteamAPIKey)
+	require.Equal(t, "Europe/Berlin", redacted.ServerTimezone)
+	require.Equal(t, "my-api-key", s.SteamAPIKey)
+}
+
+func TestSettings_UnknownSettings(t *testing.T) {
+	jsonStr := `{
+		"serverTimezone": "Europe/Berlin",
+		"keepDataPeriodDays": 7,
+		"disableAuth": true,
+		"trustedProxies": ["10.0.0.0/8"],
+		"dnsCache": false
+	}`
+
+	var s settings.Settings
+	err := json.Unmarshal([]byte(jsonStr), &s)
+	require.NoError(t, err)
+
+	require.True(t, s.DisableAuth)
+	require.Equal(t, map[string]json.RawMessage{
+		"trustedProxies": json.RawMessage(`["10.0.0.0/8"]`),
+		"dnsCache":       json.RawMessage(`false`),
+	}, s.Unknown())
+
+	s.KeepDataPeriodDays = 30
+
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+
+	var result map[string]any
+	err = json.Unmarshal(data, &result)
+	require.NoError(t, err)
+
+	require.InEpsilon(t, float64(30), result["keepDataPeriodDays"], 0)
+	require.Equal(t, true, result["disableAuth"])
+	require.Equal(t, []any{"10.0.0.0/8"}, result["trustedProxies"])
+	require.Equal(t, false, result["dnsCache"])
+
+	require.Nil(t, settings.Settings{}.Unknown())
+}
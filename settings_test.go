@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/breml/go-uptime-kuma-client/settings"
 )
 
 func TestSettingsGetAndSet(t *testing.T) {
@@ -103,4 +105,25 @@ func TestSettingsGetAndSet(t *testing.T) {
 		err = client.SetSettings(ctx, *original, "")
 		require.NoError(t, err)
 	})
+
+	t.Run("patch_settings", func(t *testing.T) {
+		original, err := client.GetSettings(ctx)
+		require.NoError(t, err)
+
+		err = client.PatchSettings(ctx, func(s *settings.Settings) {
+			s.PrimaryBaseURL = "https://status.example.com"
+		})
+		require.NoError(t, err)
+
+		updated, err := client.GetSettings(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "https://status.example.com", updated.PrimaryBaseURL)
+		require.Equal(t, original.ServerTimezone, updated.ServerTimezone)
+		require.Equal(t, original.KeepDataPeriodDays, updated.KeepDataPeriodDays)
+		require.Equal(t, original.Unknown(), updated.Unknown())
+
+		// Restore original
+		err = client.SetSettings(ctx, *original, "")
+		require.NoError(t, err)
+	})
 }
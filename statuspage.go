@@ -13,12 +13,13 @@ func (c *Client) GetStatusPages(_ context.Context) (map[int64]statuspage.StatusP
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.state.statusPages == nil {
+	cached := c.statusPagesLocked()
+	if cached == nil {
 		return map[int64]statuspage.StatusPage{}, nil
 	}
 
-	statusPages := make(map[int64]statuspage.StatusPage, len(c.state.statusPages))
-	maps.Copy(statusPages, c.state.statusPages)
+	statusPages := make(map[int64]statuspage.StatusPage, len(cached))
+	maps.Copy(statusPages, cached)
 
 	return statusPages, nil
 }
@@ -33,7 +34,7 @@ func (c *Client) GetStatusPage(ctx context.Context, slug string) (*statuspage.St
 	}
 
 	if response.Config == nil {
-		return nil, fmt.Errorf("get status page %s: config not found in response", slug)
+		return nil, fmt.Errorf("get status page %s: %w", slug, ErrNotFound)
 	}
 
 	var sp statuspage.StatusPage
@@ -79,12 +79,24 @@ func (c *Client) UpdateTag(ctx context.Context, t tag.Tag) error {
 
 // DeleteTag deletes a tag by ID.
 // This also removes all monitor-tag associations for this tag via cascade delete.
-func (c *Client) DeleteTag(ctx context.Context, tagID int64) error {
-	_, err := c.syncEmit(ctx, "deleteTag", tagID)
+// The options control, how monitors referencing the tag are handled, see
+// DeleteOption.
+func (c *Client) DeleteTag(ctx context.Context, tagID int64, opts ...DeleteOption) error {
+	err := c.handleDependents(ctx, ResourceTag, tagID, opts)
 	if err != nil {
 		return fmt.Errorf("delete tag %d: %w", tagID, err)
 	}
 
+	_, err = c.syncEmit(ctx, "deleteTag", tagID)
+	if err != nil {
+		return fmt.Errorf("delete tag %d: %w", tagID, err)
+	}
+
+	// In dry-run mode, the plan removes the tag from the monitors.
+	if c.plan != nil {
+		return nil
+	}
+
 	// Manually remove this tag from all monitors in the cache
 	// The server removes all monitor-tag associations when a tag is deleted
 	c.mu.Lock()
@@ -108,6 +120,11 @@ func (c *Client) DeleteTag(ctx context.Context, tagID int64) error {
 // This is used by tag operations since the server doesn't emit update events for them.
 // If the monitor is not found in the cache, it will be added.
 func (c *Client) refreshMonitorInCache(ctx context.Context, monitorID int64) error {
+	// In dry-run mode, the monitor is updated by the plan.
+	if c.plan != nil {
+		return nil
+	}
+
 	mon, err := c.GetMonitor(ctx, monitorID)
 	if err != nil {
 		return err
@@ -161,7 +178,7 @@ func (c *Client) AddMonitorTag(
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	for _, mon := range c.state.monitors {
+	for _, mon := range c.monitorsLocked() {
 		if mon.ID == monitorID {
 			for _, t := range mon.Tags {
 				if t.TagID == tagID && t.Value == value {
@@ -267,7 +284,7 @@ func (c *Client) GetMonitorTags(_ context.Context, monitorID int64) ([]tag.Monit
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	for _, mon := range c.state.monitors {
+	for _, mon := range c.monitorsLocked() {
 		if mon.ID == monitorID {
 			return mon.Tags, nil
 		}
@@ -285,7 +302,7 @@ func (c *Client) GetTagMonitors(_ context.Context, tagID int64) ([]int64, error)
 	defer c.mu.Unlock()
 
 	var monitorIDs []int64
-	for _, mon := range c.state.monitors {
+	for _, mon := range c.monitorsLocked() {
 		for _, t := range mon.Tags {
 			if t.TagID == tagID {
 				monitorIDs = append(monitorIDs, mon.ID)
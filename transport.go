@@ -0,0 +1,277 @@
+package kuma
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	engineio "github.com/maldikhan/go.socket.io/engine.io/v4/client"
+	polling "github.com/maldikhan/go.socket.io/engine.io/v4/client/transport/polling"
+	wstransport "github.com/maldikhan/go.socket.io/engine.io/v4/client/transport/websocket"
+	socketio "github.com/maldikhan/go.socket.io/socket.io/v5/client"
+	"golang.org/x/net/websocket"
+)
+
+// Dialer establishes the network connections to the server, e.g. a
+// *net.Dialer or a dialer of a SOCKS proxy.
+type Dialer interface {
+	DialContext(ctx context.Context, network string, address string) (net.Conn, error)
+}
+
+// WithHTTPClient sets the HTTP client, which is used for the HTTP requests to
+// the server, i.e. for the database setup and for the socket.io polling
+// transport. WithTLSConfig and WithDialer do not change the given HTTP
+// client, they only apply to the websocket transport in this case.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTLSConfig sets the TLS configuration for the connections to the server,
+// e.g. to trust a private CA or to present a client certificate.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(c *Client) {
+		c.tlsConfig = config
+	}
+}
+
+// WithHeaders sets additional headers, which are sent with every HTTP
+// request and with the websocket handshake, e.g. the access token for an
+// authenticating reverse proxy.
+func WithHeaders(headers http.Header) Option {
+	return func(c *Client) {
+		c.headers = headers.Clone()
+	}
+}
+
+// WithDialer sets the dialer, which establishes the network connections to
+// the server.
+func WithDialer(dialer Dialer) Option {
+	return func(c *Client) {
+		c.dialer = dialer
+	}
+}
+
+// newHTTPClient returns the HTTP client for the requests to the server,
+// which sends the configured headers with every request.
+func (c *Client) newHTTPClient() *http.Client {
+	httpClient := c.httpClient
+	if httpClient == nil {
+		transport := &http.Transport{}
+
+		defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+		if ok {
+			transport = defaultTransport.Clone()
+		}
+
+		if c.tlsConfig != nil {
+			transport.TLSClientConfig = c.tlsConfig.Clone()
+		}
+
+		if c.dialer != nil {
+			transport.DialContext = c.dialer.DialContext
+		}
+
+		httpClient = &http.Client{
+			Transport: transport,
+		}
+	}
+
+	if len(c.headers) == 0 {
+		return httpClient
+	}
+
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	// Copy the client to not modify the client passed with WithHTTPClient.
+	withHeaders := *httpClient
+	withHeaders.Transport = &headerRoundTripper{
+		headers: c.headers,
+		next:    next,
+	}
+
+	return &withHeaders
+}
+
+// newSocketIOClient returns the socket.io client, where the polling and the
+// websocket transport use the configured HTTP client, TLS configuration,
+// headers and dialer.
+func (c *Client) newSocketIOClient(baseURL string, httpClient *http.Client) (*socketio.Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse URL: %w", err)
+	}
+
+	if u.Path == "" {
+		u.Path = "/socket.io/"
+	}
+
+	pollingTransport, err := polling.NewTransport(
+		polling.WithHTTPClient(httpClient),
+		polling.WithLogger(c.socketioLogger),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create polling transport: %w", err)
+	}
+
+	websocketTransport, err := wstransport.NewTransport(
+		wstransport.WithWebSocket(&webSocketConn{
+			tlsConfig: c.tlsConfig,
+			headers:   c.headers,
+			dialer:    c.dialer,
+		}),
+		wstransport.WithLogger(c.socketioLogger),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create websocket transport: %w", err)
+	}
+
+	engineioClient, err := engineio.NewClient(
+		engineio.WithURL(u),
+		engineio.WithLogger(c.socketioLogger),
+		engineio.WithSupportedTransports([]engineio.Transport{websocketTransport, pollingTransport}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create engine.io client: %w", err)
+	}
+
+	client, err := socketio.NewClient(
+		socketio.WithEngineIOClient(engineioClient),
+		socketio.WithLogger(c.socketioLogger),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create socket.io client: %w", err)
+	}
+
+	return client, nil
+}
+
+// headerRoundTripper adds the headers to every request.
+type headerRoundTripper struct {
+	headers http.Header
+	next    http.RoundTripper
+}
+
+func (h *headerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A round tripper must not modify the request.
+	req = req.Clone(req.Context())
+	for key, values := range h.headers {
+		req.Header[key] = values
+	}
+
+	return h.next.RoundTrip(req) //nolint:wrapcheck // The error of the next round tripper is returned as is.
+}
+
+// webSocketConn is the websocket connection of the websocket transport,
+// which uses the configured TLS configuration, headers and dialer.
+type webSocketConn struct {
+	tlsConfig *tls.Config
+	headers   http.Header
+	dialer    Dialer
+
+	conn *websocket.Conn
+}
+
+func (w *webSocketConn) Dial(ctx context.Context, location *url.URL, origin *url.URL) error {
+	config, err := websocket.NewConfig(location.String(), origin.String())
+	if err != nil {
+		return fmt.Errorf("websocket config: %w", err)
+	}
+
+	if w.headers != nil {
+		config.Header = w.headers.Clone()
+	}
+
+	dialer := w.dialer
+	if dialer == nil {
+		dialer = &net.Dialer{}
+	}
+
+	address := location.Host
+	if location.Port() == "" {
+		port := "80"
+		if location.Scheme == "wss" {
+			port = "443"
+		}
+
+		address = net.JoinHostPort(location.Hostname(), port)
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("websocket dial: %w", err)
+	}
+
+	// Bound the TLS and the websocket handshake by the context, the deadline
+	// is reset after the handshake.
+	deadline, ok := ctx.Deadline()
+	if ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if location.Scheme == "wss" {
+		tlsConfig := &tls.Config{} //nolint:gosec // The minimum version is given by the defaults of crypto/tls.
+		if w.tlsConfig != nil {
+			tlsConfig = w.tlsConfig.Clone()
+		}
+
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = location.Hostname()
+		}
+
+		tlsConn := tls.Client(conn, tlsConfig)
+
+		err = tlsConn.HandshakeContext(ctx)
+		if err != nil {
+			_ = conn.Close()
+			return fmt.Errorf("websocket TLS handshake: %w", err)
+		}
+
+		conn = tlsConn
+	}
+
+	w.conn, err = websocket.NewClient(config, conn)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("websocket handshake: %w", err)
+	}
+
+	_ = conn.SetDeadline(time.Time{})
+
+	return nil
+}
+
+func (w *webSocketConn) Send(v []byte) error {
+	if w.conn == nil {
+		return errWebSocketNotConnected
+	}
+
+	return websocket.Message.Send(w.conn, string(v)) //nolint:wrapcheck // Errors are handled by the transport.
+}
+
+func (w *webSocketConn) Receive(v *[]byte) error {
+	if w.conn == nil {
+		return errWebSocketNotConnected
+	}
+
+	return websocket.Message.Receive(w.conn, v) //nolint:wrapcheck // Errors are handled by the transport.
+}
+
+func (w *webSocketConn) Close() error {
+	if w.conn == nil {
+		return nil
+	}
+
+	return w.conn.Close() //nolint:wrapcheck // Errors are handled by the transport.
+}
+
+var errWebSocketNotConnected = errors.New("websocket is not connected")
@@ -0,0 +1,158 @@
+package kuma_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+)
+
+// headerRecorder records the value of a header of every request, before the
+// request is passed to the next handler.
+type headerRecorder struct {
+	header string
+	next   http.Handler
+
+	mu         sync.Mutex
+	values     []string
+	transports []string
+}
+
+func (h *headerRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	h.values = append(h.values, r.Header.Get(h.header))
+	h.transports = append(h.transports, r.URL.Query().Get("transport"))
+	h.mu.Unlock()
+
+	h.next.ServeHTTP(w, r)
+}
+
+func (h *headerRecorder) recorded() ([]string, []string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]string{}, h.values...), append([]string{}, h.transports...)
+}
+
+// countingDialer counts the established connections.
+type countingDialer struct {
+	dials atomic.Int32
+}
+
+func (d *countingDialer) DialContext(ctx context.Context, network string, address string) (net.Conn, error) {
+	d.dials.Add(1)
+
+	return (&net.Dialer{}).DialContext(ctx, network, address)
+}
+
+// countingRoundTripper counts the requests.
+type countingRoundTripper struct {
+	requests atomic.Int32
+}
+
+func (rt *countingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.requests.Add(1)
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestTransportOptions_WebSocketUpgrade(t *testing.T) {
+	const timeout = 500 * time.Millisecond
+
+	recorder := &headerRecorder{
+		header: "X-Auth-Token",
+		next:   &fakeSocketIOServerWithWebSocket{},
+	}
+
+	server := httptest.NewTLSServer(recorder)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*timeout)
+	t.Cleanup(func() {
+		cancel()
+		server.CloseClientConnections()
+		server.Close()
+	})
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+
+	dialer := &countingDialer{}
+
+	_, err := kuma.New(
+		ctx,
+		server.URL,
+		"admin", "admin1",
+		kuma.WithConnectTimeout(timeout),
+		kuma.WithTLSConfig(&tls.Config{
+			RootCAs:    rootCAs,
+			MinVersion: tls.VersionTLS12,
+		}),
+		kuma.WithHeaders(http.Header{"X-Auth-Token": []string{"secret"}}),
+		kuma.WithDialer(dialer),
+	)
+
+	// The fake server never sends the ready events, so reaching this phase
+	// proves, that the login succeeded over the websocket.
+	require.ErrorContains(t, err, "missing events:")
+
+	values, transports := recorder.recorded()
+	require.NotEmpty(t, values)
+	require.Contains(t, transports, "websocket")
+
+	for i, value := range values {
+		require.Equal(t, "secret", value, "request %d with transport %q", i, transports[i])
+	}
+
+	require.Positive(t, dialer.dials.Load())
+}
+
+func TestTransportOptions_HTTPClient(t *testing.T) {
+	const timeout = 500 * time.Millisecond
+
+	recorder := &headerRecorder{
+		header: "X-Auth-Token",
+		next: &fakeSocketIOServer{
+			messages: make(chan []byte, 10),
+		},
+	}
+
+	server := httptest.NewServer(recorder)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*timeout)
+	t.Cleanup(func() {
+		cancel()
+		server.CloseClientConnections()
+		server.Close()
+	})
+
+	roundTripper := &countingRoundTripper{}
+
+	_, err := kuma.New(
+		ctx,
+		server.URL,
+		"admin", "admin1",
+		kuma.WithConnectTimeout(timeout),
+		kuma.WithHTTPClient(&http.Client{Transport: roundTripper}),
+		kuma.WithHeaders(http.Header{"X-Auth-Token": []string{"secret"}}),
+	)
+	require.ErrorContains(t, err, "missing events:")
+
+	values, _ := recorder.recorded()
+	require.NotEmpty(t, values)
+
+	for _, value := range values {
+		require.Equal(t, "secret", value)
+	}
+
+	require.GreaterOrEqual(t, roundTripper.requests.Load(), int32(len(values)))
+}